@@ -12,6 +12,7 @@ import (
 	"github.com/gruntwork-io/terragrunt/engine"
 	"github.com/gruntwork-io/terragrunt/internal/os/exec"
 	"github.com/gruntwork-io/terragrunt/internal/os/signal"
+	"github.com/gruntwork-io/terragrunt/shell"
 	"github.com/gruntwork-io/terragrunt/telemetry"
 	"github.com/gruntwork-io/terragrunt/tf"
 	"golang.org/x/sync/errgroup"
@@ -165,6 +166,10 @@ func WrapWithTelemetry(opts *options.TerragruntOptions) func(ctx *cli.Context, a
 				return err
 			}
 
+			if opts.ProfileConfig {
+				return runActionWithConfigProfile(ctx, opts, action) //nolint:contextcheck
+			}
+
 			// TODO: See if this lint should be ignored
 			return runAction(ctx, opts, action) //nolint:contextcheck
 		})
@@ -198,17 +203,20 @@ func runAction(cliCtx *cli.Context, opts *options.TerragruntOptions, action cli.
 			return err
 		}
 
-		ln, err := server.Listen()
-		if err != nil {
-			return err
-		}
-		defer ln.Close() //nolint:errcheck
-
 		cliCtx.Context = tf.ContextWithTerraformCommandHook(ctx, server.TerraformCommandHook)
 
-		errGroup.Go(func() error {
-			return server.Run(ctx, ln)
-		})
+		// The external server is already running in a separate `provider-cache serve` process.
+		if !opts.ProviderCacheExternalServer {
+			ln, err := server.Listen()
+			if err != nil {
+				return err
+			}
+			defer ln.Close() //nolint:errcheck
+
+			errGroup.Go(func() error {
+				return server.Run(ctx, ln)
+			})
+		}
 	}
 
 	// Run command action
@@ -384,6 +392,8 @@ func initialSetup(cliCtx *cli.Context, opts *options.TerragruntOptions) error {
 	opts.OriginalIAMRoleOptions = opts.IAMRoleOptions
 
 	opts.RunTerragrunt = runCmd.Run
+	opts.RunTerraformCommand = tf.RunCommand
+	opts.RunShellCommand = shell.RunCommand
 
 	exec.PrepareConsole(opts.Logger)
 
@@ -16,9 +16,14 @@ import (
 	awsproviderpatch "github.com/gruntwork-io/terragrunt/cli/commands/aws-provider-patch"
 	"github.com/gruntwork-io/terragrunt/cli/commands/catalog"
 	execCmd "github.com/gruntwork-io/terragrunt/cli/commands/exec"
+	"github.com/gruntwork-io/terragrunt/cli/commands/explain"
 	graphdependencies "github.com/gruntwork-io/terragrunt/cli/commands/graph-dependencies"
 	"github.com/gruntwork-io/terragrunt/cli/commands/hclfmt"
+	"github.com/gruntwork-io/terragrunt/cli/commands/lint"
+	"github.com/gruntwork-io/terragrunt/cli/commands/lsp"
 	outputmodulegroups "github.com/gruntwork-io/terragrunt/cli/commands/output-module-groups"
+	"github.com/gruntwork-io/terragrunt/cli/commands/policy"
+	providercache "github.com/gruntwork-io/terragrunt/cli/commands/provider-cache"
 	renderjson "github.com/gruntwork-io/terragrunt/cli/commands/render-json"
 	runCmd "github.com/gruntwork-io/terragrunt/cli/commands/run"
 	runall "github.com/gruntwork-io/terragrunt/cli/commands/run-all"
@@ -71,9 +76,14 @@ func New(opts *options.TerragruntOptions) cli.Commands {
 		validateinputs.NewCommand(opts),     // validate-inputs
 		hclvalidate.NewCommand(opts),        // hclvalidate
 		hclfmt.NewCommand(opts),             // hclfmt
+		lint.NewCommand(opts),               // lint
+		lsp.NewCommand(opts),                // lsp
+		policy.NewCommand(opts),             // policy
 		info.NewCommand(opts),               // info
 		terragruntinfo.NewCommand(opts),     // terragrunt-info
 		renderjson.NewCommand(opts),         // render-json
+		explain.NewCommand(opts),            // explain
+		providercache.NewCommand(opts),      // provider-cache
 		helpCmd.NewCommand(opts),            // help (hidden)
 		versionCmd.NewCommand(opts),         // version (hidden)
 		awsproviderpatch.NewCommand(opts),   // aws-provider-patch (hidden)
@@ -0,0 +1,217 @@
+package explain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+
+	"github.com/gruntwork-io/terragrunt/config"
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/internal/view/diagnostic"
+)
+
+const notSetValue = "(not set)"
+
+func Run(ctx context.Context, opts *Options, path string) error {
+	cfg, err := config.ReadTerragruntConfigWithProvenance(ctx, opts.TerragruntOptions, config.DefaultParserOptions(opts.TerragruntOptions))
+	if err != nil {
+		return err
+	}
+
+	explanation, err := cfg.Explain(path)
+	if err != nil {
+		return err
+	}
+
+	if opts.JSONOutput {
+		return writeJSON(opts.Writer, opts.WorkingDir, explanation)
+	}
+
+	return writeText(opts.Writer, opts.WorkingDir, explanation)
+}
+
+func writeText(w io.Writer, workingDir string, explanation *config.Explanation) error {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "%s = %s\n", explanation.Path, formatValue(explanation.Value, ""))
+
+	for _, origin := range explanation.Origins {
+		sb.WriteString("\n")
+		writeOrigin(&sb, workingDir, origin, "")
+	}
+
+	if _, err := io.WriteString(w, sb.String()); err != nil {
+		return errors.New(err)
+	}
+
+	return nil
+}
+
+func writeOrigin(sb *strings.Builder, workingDir string, origin *config.ValueOrigin, indent string) {
+	fmt.Fprintf(sb, "%s%s = %s\n", indent, origin.Path, formatValue(origin.Value, indent))
+	fmt.Fprintf(sb, "%s  set at %s\n", indent, relRange(workingDir, origin.Range))
+
+	for _, include := range origin.IncludeChain {
+		fmt.Fprintf(sb, "%s  included by include %q from %s, merge strategy %s\n", indent, include.Name, relPath(workingDir, include.Path), include.MergeStrategy)
+	}
+
+	if len(origin.Locals) > 0 {
+		fmt.Fprintf(sb, "%s  references:\n", indent)
+
+		for _, local := range origin.Locals {
+			writeOrigin(sb, workingDir, local, indent+"    ")
+		}
+	}
+
+	if len(origin.Overridden) > 0 {
+		fmt.Fprintf(sb, "%s  overrides:\n", indent)
+
+		for _, overridden := range origin.Overridden {
+			writeOrigin(sb, workingDir, overridden, indent+"    ")
+		}
+	}
+}
+
+// formatValue formats the value as an HCL expression, with its lines indented.
+func formatValue(value cty.Value, indent string) string {
+	if value == cty.NilVal {
+		return notSetValue
+	}
+
+	tokens := hclwrite.TokensForValue(value)
+
+	return strings.ReplaceAll(string(hclwrite.Format(tokens.Bytes())), "\n", "\n"+indent)
+}
+
+type explanationJSON struct {
+	Path    string             `json:"path"`
+	Value   json.RawMessage    `json:"value"`
+	Origins []*valueOriginJSON `json:"origins"`
+}
+
+type valueOriginJSON struct {
+	Path         string             `json:"path"`
+	Value        json.RawMessage    `json:"value"`
+	Range        diagnostic.Range   `json:"range"`
+	IncludeChain []*includeJSON     `json:"include_chain,omitempty"`
+	Locals       []*valueOriginJSON `json:"locals,omitempty"`
+	Overridden   []*valueOriginJSON `json:"overridden,omitempty"`
+}
+
+type includeJSON struct {
+	Name          string `json:"name"`
+	Path          string `json:"path"`
+	MergeStrategy string `json:"merge_strategy"`
+}
+
+func writeJSON(w io.Writer, workingDir string, explanation *config.Explanation) error {
+	value, err := valueJSON(explanation.Value)
+	if err != nil {
+		return err
+	}
+
+	output := &explanationJSON{
+		Path:    explanation.Path,
+		Value:   value,
+		Origins: make([]*valueOriginJSON, 0, len(explanation.Origins)),
+	}
+
+	for _, origin := range explanation.Origins {
+		originJSON, err := newValueOriginJSON(workingDir, origin)
+		if err != nil {
+			return err
+		}
+
+		output.Origins = append(output.Origins, originJSON)
+	}
+
+	jsonBytes, err := json.MarshalIndent(output, "", "  ")
+	if err != nil {
+		return errors.New(err)
+	}
+
+	if _, err := fmt.Fprintln(w, string(jsonBytes)); err != nil {
+		return errors.New(err)
+	}
+
+	return nil
+}
+
+func newValueOriginJSON(workingDir string, origin *config.ValueOrigin) (*valueOriginJSON, error) {
+	value, err := valueJSON(origin.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	originJSON := &valueOriginJSON{
+		Path:  origin.Path,
+		Value: value,
+		Range: relRange(workingDir, origin.Range),
+	}
+
+	for _, include := range origin.IncludeChain {
+		originJSON.IncludeChain = append(originJSON.IncludeChain, &includeJSON{
+			Name:          include.Name,
+			Path:          relPath(workingDir, include.Path),
+			MergeStrategy: string(include.MergeStrategy),
+		})
+	}
+
+	for _, local := range origin.Locals {
+		localJSON, err := newValueOriginJSON(workingDir, local)
+		if err != nil {
+			return nil, err
+		}
+
+		originJSON.Locals = append(originJSON.Locals, localJSON)
+	}
+
+	for _, overridden := range origin.Overridden {
+		overriddenJSON, err := newValueOriginJSON(workingDir, overridden)
+		if err != nil {
+			return nil, err
+		}
+
+		originJSON.Overridden = append(originJSON.Overridden, overriddenJSON)
+	}
+
+	return originJSON, nil
+}
+
+func valueJSON(value cty.Value) (json.RawMessage, error) {
+	if value == cty.NilVal {
+		return json.RawMessage("null"), nil
+	}
+
+	jsonBytes, err := ctyjson.SimpleJSONValue{Value: value}.MarshalJSON()
+	if err != nil {
+		return nil, errors.New(err)
+	}
+
+	return jsonBytes, nil
+}
+
+// relRange returns the range with its file relative to the working directory.
+func relRange(workingDir string, rng hcl.Range) diagnostic.Range {
+	return diagnostic.Range{
+		Filename: relPath(workingDir, rng.Filename),
+		Start:    diagnostic.Pos{Line: rng.Start.Line, Column: rng.Start.Column, Byte: rng.Start.Byte},
+		End:      diagnostic.Pos{Line: rng.End.Line, Column: rng.End.Column, Byte: rng.End.Byte},
+	}
+}
+
+func relPath(workingDir, path string) string {
+	if relPath, err := filepath.Rel(workingDir, path); err == nil {
+		return relPath
+	}
+
+	return path
+}
@@ -0,0 +1,60 @@
+// Package explain provides the `explain` command for Terragrunt.
+//
+// `explain <attribute path>` prints the final value of an attribute of the configuration, such as `inputs.vpc_cidr`,
+// and where it came from: the file and range that set it, the locals it references, the includes and merge strategies
+// that merged it in, and the values it overrode.
+package explain
+
+import (
+	"github.com/gruntwork-io/terragrunt/cli/commands/run"
+	"github.com/gruntwork-io/terragrunt/cli/flags"
+	"github.com/gruntwork-io/terragrunt/internal/cli"
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/options"
+)
+
+const (
+	CommandName = "explain"
+
+	JSONFlagName = "json"
+)
+
+func NewFlags(opts *Options, prefix flags.Prefix) cli.Flags {
+	tgPrefix := prefix.Prepend(flags.TgPrefix)
+
+	return cli.Flags{
+		flags.NewFlag(&cli.BoolFlag{
+			Name:        JSONFlagName,
+			EnvVars:     tgPrefix.EnvVars(JSONFlagName),
+			Destination: &opts.JSONOutput,
+			Usage:       "Output the explanation in JSON format.",
+		}),
+	}
+}
+
+func NewCommand(generalOpts *options.TerragruntOptions) *cli.Command {
+	opts := NewOptions(generalOpts)
+	prefix := flags.Prefix{CommandName}
+
+	return &cli.Command{
+		Name:        CommandName,
+		Usage:       "Explain where a value of the configuration came from.",
+		UsageText:   "terragrunt explain [options] <attribute path>",
+		Description: "Print the final value of an attribute of the configuration, such as inputs.vpc_cidr, with the file and range that set it, the locals it references, the include chain and merge strategies, and the values it overrode.",
+		Examples: []string{
+			"terragrunt explain inputs.vpc_cidr",
+			"terragrunt explain remote_state.config.bucket",
+		},
+		Flags: append(run.NewFlags(generalOpts, nil), NewFlags(opts, prefix)...).Sort(),
+		Action: func(ctx *cli.Context) error {
+			path := ctx.Args().First()
+			if path == "" {
+				return errors.New(MissingAttributePathError{})
+			}
+
+			opts.TerragruntOptions = generalOpts.OptionsFromContext(ctx)
+
+			return Run(ctx, opts, path)
+		},
+	}
+}
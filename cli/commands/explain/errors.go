@@ -0,0 +1,7 @@
+package explain
+
+type MissingAttributePathError struct{}
+
+func (err MissingAttributePathError) Error() string {
+	return "Missing the path of the attribute to explain, such as inputs.vpc_cidr"
+}
@@ -0,0 +1,15 @@
+package explain
+
+import "github.com/gruntwork-io/terragrunt/options"
+
+type Options struct {
+	*options.TerragruntOptions
+
+	JSONOutput bool
+}
+
+func NewOptions(general *options.TerragruntOptions) *Options {
+	return &Options{
+		TerragruntOptions: general,
+	}
+}
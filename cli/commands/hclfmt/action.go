@@ -6,18 +6,23 @@ package hclfmt
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"strings"
+	"unicode/utf8"
 
+	"github.com/gruntwork-io/terragrunt/internal/view"
+	"github.com/gruntwork-io/terragrunt/internal/view/diagnostic"
 	"github.com/gruntwork-io/terragrunt/pkg/log"
 	"github.com/gruntwork-io/terragrunt/pkg/log/writer"
 
 	"github.com/gruntwork-io/terragrunt/internal/errors"
 
+	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/hclwrite"
 	"github.com/mattn/go-zglob"
 
@@ -27,18 +32,45 @@ import (
 )
 
 func Run(opts *options.TerragruntOptions) error {
-	workingDir := opts.WorkingDir
-	targetFile := opts.HclFile
-	stdIn := opts.HclFromStdin
-
-	if stdIn {
-		if targetFile != "" {
+	if opts.HclFromStdin {
+		if opts.HclFile != "" {
 			return errors.Errorf("both stdin and path flags are specified")
 		}
 
 		return formatFromStdin(opts)
 	}
 
+	if opts.HclDiagnosticsFormat == "" {
+		return formatFiles(opts, nil)
+	}
+
+	// When a format is given, the diagnostics are collected to be rendered at once instead of being logged.
+	render, err := view.NewRender(opts.HclDiagnosticsFormat, &view.RenderOptions{
+		BaseDir:       view.RepoRootDir(context.Background(), opts),
+		DisableColors: opts.Logger.Formatter().DisabledColors(),
+	})
+	if err != nil {
+		return err
+	}
+
+	diags := diagnostic.Diagnostics{}
+	formatErr := formatFiles(opts, &diags)
+
+	// SARIF consumers expect a log even when there are no results.
+	if len(diags) > 0 || opts.HclDiagnosticsFormat == view.SARIFFormat {
+		if err := view.NewWriter(opts.Writer, render).Diagnostics(diags); err != nil {
+			return err
+		}
+	}
+
+	return formatErr
+}
+
+// formatFiles formats the file given with `--file`, or all the hcl files from the working directory.
+func formatFiles(opts *options.TerragruntOptions, diags *diagnostic.Diagnostics) error {
+	workingDir := opts.WorkingDir
+	targetFile := opts.HclFile
+
 	// handle when option specifies a particular file
 	if targetFile != "" {
 		if !filepath.IsAbs(targetFile) {
@@ -47,7 +79,7 @@ func Run(opts *options.TerragruntOptions) error {
 
 		opts.Logger.Debugf("Formatting hcl file at: %s.", targetFile)
 
-		return formatTgHCL(opts, targetFile)
+		return formatTgHCL(opts, targetFile, diags)
 	}
 
 	opts.Logger.Debugf("Formatting hcl files from the directory tree %s.", opts.WorkingDir)
@@ -90,7 +122,7 @@ func Run(opts *options.TerragruntOptions) error {
 	var formatErrors *errors.MultiError
 
 	for _, tgHclFile := range filteredTgHclFiles {
-		err := formatTgHCL(opts, tgHclFile)
+		err := formatTgHCL(opts, tgHclFile, diags)
 		if err != nil {
 			formatErrors = formatErrors.Append(err)
 		}
@@ -108,7 +140,7 @@ func formatFromStdin(opts *options.TerragruntOptions) error {
 		return fmt.Errorf("error reading from stdin: %w", err)
 	}
 
-	if err = checkErrors(opts.Logger, opts.Logger.Formatter().DisabledColors(), contents, "stdin"); err != nil {
+	if _, err = checkErrors(opts.Logger, opts.Logger.Formatter().DisabledColors(), contents, "stdin", nil); err != nil {
 		opts.Logger.Errorf("Error parsing hcl from stdin")
 
 		return fmt.Errorf("error parsing hcl from stdin: %w", err)
@@ -134,8 +166,9 @@ func formatFromStdin(opts *options.TerragruntOptions) error {
 }
 
 // formatTgHCL uses the hcl2 library to format the hcl file. This will attempt to parse the HCL file first to
-// ensure that there are no syntax errors, before attempting to format it.
-func formatTgHCL(opts *options.TerragruntOptions, tgHclFile string) error {
+// ensure that there are no syntax errors, before attempting to format it. If `diags` is not nil, the syntax errors
+// and, in check mode, the file not being formatted are appended to it instead of being logged.
+func formatTgHCL(opts *options.TerragruntOptions, tgHclFile string, diags *diagnostic.Diagnostics) error {
 	opts.Logger.Debugf("Formatting %s", tgHclFile)
 
 	info, err := os.Stat(tgHclFile)
@@ -152,7 +185,7 @@ func formatTgHCL(opts *options.TerragruntOptions, tgHclFile string) error {
 
 	contents := []byte(contentsStr)
 
-	err = checkErrors(opts.Logger, opts.Logger.Formatter().DisabledColors(), contents, tgHclFile)
+	hclFile, err := checkErrors(opts.Logger, opts.Logger.Formatter().DisabledColors(), contents, tgHclFile, diags)
 	if err != nil {
 		opts.Logger.Errorf("Error parsing %s", tgHclFile)
 		return err
@@ -177,6 +210,10 @@ func formatTgHCL(opts *options.TerragruntOptions, tgHclFile string) error {
 	}
 
 	if opts.Check && fileUpdated {
+		if diags != nil {
+			*diags = append(*diags, notFormattedDiagnostic(hclFile, tgHclFile, contents, newContents))
+		}
+
 		return fmt.Errorf("invalid file format %s", tgHclFile)
 	}
 
@@ -188,24 +225,62 @@ func formatTgHCL(opts *options.TerragruntOptions, tgHclFile string) error {
 	return nil
 }
 
-// checkErrors takes in the contents of a hcl file and looks for syntax errors.
-func checkErrors(logger log.Logger, disableColor bool, contents []byte, tgHclFile string) error {
+// checkErrors takes in the contents of a hcl file and looks for syntax errors. If `collected` is not nil, the
+// diagnostics are appended to it instead of being logged.
+func checkErrors(logger log.Logger, disableColor bool, contents []byte, tgHclFile string, collected *diagnostic.Diagnostics) (*hcl.File, error) {
 	parser := hclparse.NewParser()
-	_, diags := parser.ParseHCL(contents, tgHclFile)
+	file, diags := parser.ParseHCL(contents, tgHclFile)
 
-	writer := writer.New(writer.WithLogger(logger), writer.WithDefaultLevel(log.ErrorLevel))
-	diagWriter := parser.GetDiagnosticsWriter(writer, disableColor)
+	if collected != nil {
+		for _, diag := range diags {
+			*collected = append(*collected, diagnostic.NewDiagnostic(file, diag))
+		}
+	} else {
+		writer := writer.New(writer.WithLogger(logger), writer.WithDefaultLevel(log.ErrorLevel))
+		diagWriter := parser.GetDiagnosticsWriter(writer, disableColor)
 
-	err := diagWriter.WriteDiagnostics(diags)
-	if err != nil {
-		return errors.New(err)
+		if err := diagWriter.WriteDiagnostics(diags); err != nil {
+			return nil, errors.New(err)
+		}
 	}
 
 	if diags.HasErrors() {
-		return diags
+		return nil, diags
 	}
 
-	return nil
+	return file, nil
+}
+
+// notFormattedDiagnostic returns the diagnostic of a file that is not formatted, pointing to the first line that
+// formatting changes.
+func notFormattedDiagnostic(file *hcl.File, filename string, contents, newContents []byte) *diagnostic.Diagnostic {
+	var (
+		lines    = bytes.Split(contents, []byte("\n"))
+		newLines = bytes.Split(newContents, []byte("\n"))
+		offset   int
+		index    int
+	)
+
+	for index < len(lines)-1 && index < len(newLines) && bytes.Equal(lines[index], newLines[index]) {
+		offset += len(lines[index]) + 1
+		index++
+	}
+
+	rng := hcl.Range{
+		Filename: filename,
+		Start:    hcl.Pos{Line: index + 1, Column: 1, Byte: offset},
+		End:      hcl.Pos{Line: index + 1, Column: utf8.RuneCount(lines[index]) + 1, Byte: offset + len(lines[index])},
+	}
+
+	diag := diagnostic.NewDiagnostic(file, &hcl.Diagnostic{
+		Severity: hcl.DiagError,
+		Summary:  "File is not formatted",
+		Detail:   "The file is not in the canonical HCL format, starting from this line. Run `terragrunt hclfmt` to format it.",
+		Subject:  &rng,
+	})
+	diag.Rule = CommandName
+
+	return diag
 }
 
 // bytesDiff uses GNU diff to display the differences between the contents of HCL file before and after formatting
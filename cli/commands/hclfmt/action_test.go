@@ -1,6 +1,7 @@
 package hclfmt_test
 
 import (
+	"bytes"
 	"os"
 	"path/filepath"
 	"testing"
@@ -10,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/gruntwork-io/terragrunt/cli/commands/hclfmt"
+	"github.com/gruntwork-io/terragrunt/internal/view"
 	"github.com/gruntwork-io/terragrunt/options"
 	"github.com/gruntwork-io/terragrunt/util"
 )
@@ -222,6 +224,41 @@ func TestHCLFmtCheckErrors(t *testing.T) {
 	}
 }
 
+func TestHCLFmtCheckFormat(t *testing.T) {
+	t.Parallel()
+
+	tmpPath, err := files.CopyFolderToTemp("../../../test/fixtures/hclfmt-check-errors", t.Name(), func(path string) bool { return true })
+
+	t.Cleanup(func() {
+		os.RemoveAll(tmpPath)
+	})
+
+	require.NoError(t, err)
+
+	tgOptions, err := options.NewTerragruntOptionsForTest("")
+	require.NoError(t, err)
+
+	stdout := new(bytes.Buffer)
+
+	tgOptions.Check = true
+	tgOptions.WorkingDir = tmpPath
+	tgOptions.Writer = stdout
+	tgOptions.HclFile = "a/terragrunt.hcl"
+	tgOptions.HclDiagnosticsFormat = view.GitHubFormat
+
+	err = hclfmt.Run(tgOptions)
+	require.Error(t, err)
+
+	assert.Equal(t, "::error file=a/terragrunt.hcl,line=2,col=1,endLine=2,endColumn=11,title=File is not formatted::"+
+		"The file is not in the canonical HCL format, starting from this line. Run `terragrunt hclfmt` to format it.\n", stdout.String())
+
+	tgOptions.HclDiagnosticsFormat = "unknown"
+
+	err = hclfmt.Run(tgOptions)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `Unknown output format "unknown"`)
+}
+
 func TestHCLFmtFile(t *testing.T) {
 	t.Parallel()
 
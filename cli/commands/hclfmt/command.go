@@ -2,8 +2,11 @@
 package hclfmt
 
 import (
+	"strings"
+
 	"github.com/gruntwork-io/terragrunt/cli/flags"
 	"github.com/gruntwork-io/terragrunt/internal/cli"
+	"github.com/gruntwork-io/terragrunt/internal/view"
 	"github.com/gruntwork-io/terragrunt/options"
 )
 
@@ -15,6 +18,7 @@ const (
 	CheckFlagName      = "check"
 	DiffFlagName       = "diff"
 	StdinFlagName      = "stdin"
+	FormatFlagName     = "format"
 
 	DeprecatedHclfmtFileFlagName        = "hclfmt-file"
 	DeprecatedHclfmtcExcludeDirFlagName = "hclfmt-exclude-dir"
@@ -68,6 +72,13 @@ func NewFlags(opts *options.TerragruntOptions, prefix flags.Prefix) cli.Flags {
 			Usage:       "Format HCL from stdin and print result to stdout.",
 		},
 			flags.WithDeprecatedNames(terragruntPrefix.FlagNames(DeprecatedHclfmtStdinFlagName), terragruntPrefixControl)),
+
+		flags.NewFlag(&cli.GenericFlag[string]{
+			Name:        FormatFlagName,
+			EnvVars:     tgPrefix.EnvVars(FormatFlagName),
+			Destination: &opts.HclDiagnosticsFormat,
+			Usage:       "Render the syntax errors, and the files that are not formatted in check mode, in the given format, one of " + strings.Join(view.Formats(), ", ") + ".",
+		}),
 	}
 }
 
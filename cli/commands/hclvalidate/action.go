@@ -16,6 +16,16 @@ import (
 func Run(ctx context.Context, opts *Options) (er error) {
 	var diags diagnostic.Diagnostics
 
+	format := outputFormat(opts)
+
+	render, err := view.NewRender(format, &view.RenderOptions{
+		BaseDir:       view.RepoRootDir(ctx, opts.TerragruntOptions),
+		DisableColors: opts.Logger.Formatter().DisabledColors(),
+	})
+	if err != nil {
+		return err
+	}
+
 	parseOptions := []hclparse.Option{
 		hclparse.WithDiagnosticsHandler(func(file *hcl.File, hclDiags hcl.Diagnostics) (hcl.Diagnostics, error) {
 			for _, hclDiag := range hclDiags {
@@ -42,7 +52,8 @@ func Run(ctx context.Context, opts *Options) (er error) {
 
 	stackErr := stack.Run(ctx, opts.TerragruntOptions)
 
-	if len(diags) > 0 {
+	// SARIF consumers expect a log even when there are no results.
+	if len(diags) > 0 || format == view.SARIFFormat {
 		sort.Slice(diags, func(i, j int) bool {
 			if diags[i].Range != nil && diags[j].Range != nil && diags[i].Range.Filename > diags[j].Range.Filename {
 				return false
@@ -51,7 +62,7 @@ func Run(ctx context.Context, opts *Options) (er error) {
 			return true
 		})
 
-		if err := writeDiagnostics(opts, diags); err != nil {
+		if err := writeDiagnostics(opts, render, diags); err != nil {
 			return err
 		}
 	}
@@ -59,12 +70,16 @@ func Run(ctx context.Context, opts *Options) (er error) {
 	return stackErr
 }
 
-func writeDiagnostics(opts *Options, diags diagnostic.Diagnostics) error {
-	render := view.NewHumanRender(opts.Logger.Formatter().DisabledColors())
+// outputFormat returns the format of the result, `--json` takes precedence over `--format`.
+func outputFormat(opts *Options) string {
 	if opts.JSONOutput {
-		render = view.NewJSONRender()
+		return view.JSONFormat
 	}
 
+	return opts.Format
+}
+
+func writeDiagnostics(opts *Options, render view.Render, diags diagnostic.Diagnostics) error {
 	writer := view.NewWriter(opts.Writer, render)
 
 	if opts.ShowConfigPath {
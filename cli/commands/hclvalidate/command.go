@@ -5,8 +5,11 @@
 package hclvalidate
 
 import (
+	"strings"
+
 	"github.com/gruntwork-io/terragrunt/cli/flags"
 	"github.com/gruntwork-io/terragrunt/internal/cli"
+	"github.com/gruntwork-io/terragrunt/internal/view"
 	"github.com/gruntwork-io/terragrunt/options"
 )
 
@@ -15,6 +18,7 @@ const (
 
 	ShowConfigPathFlagName = "show-config-path"
 	JSONFlagName           = "json"
+	FormatFlagName         = "format"
 
 	DeprecatedHclvalidateShowConfigPathFlagName = "hclvalidate-show-config-path"
 	DeprecatedHclvalidateJSONFlagName           = "hclvalidate-json"
@@ -41,6 +45,13 @@ func NewFlags(opts *Options, prefix flags.Prefix) cli.Flags {
 			Usage:       "Output the result in JSON format.",
 		},
 			flags.WithDeprecatedNames(terragruntPrefix.FlagNames(DeprecatedHclvalidateJSONFlagName), terragruntPrefixControl)),
+
+		flags.NewFlag(&cli.GenericFlag[string]{
+			Name:        FormatFlagName,
+			EnvVars:     tgPrefix.EnvVars(FormatFlagName),
+			Destination: &opts.Format,
+			Usage:       "The format of the result, one of " + strings.Join(view.Formats(), ", ") + ".",
+		}),
 	}
 }
 
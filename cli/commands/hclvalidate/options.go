@@ -1,16 +1,21 @@
 package hclvalidate
 
-import "github.com/gruntwork-io/terragrunt/options"
+import (
+	"github.com/gruntwork-io/terragrunt/internal/view"
+	"github.com/gruntwork-io/terragrunt/options"
+)
 
 type Options struct {
 	*options.TerragruntOptions
 
 	ShowConfigPath bool
 	JSONOutput     bool
+	Format         string
 }
 
 func NewOptions(general *options.TerragruntOptions) *Options {
 	return &Options{
 		TerragruntOptions: general,
+		Format:            view.HumanFormat,
 	}
 }
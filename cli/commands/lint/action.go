@@ -0,0 +1,250 @@
+package lint
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/gruntwork-io/terragrunt/config"
+	"github.com/gruntwork-io/terragrunt/config/hclparse"
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/internal/view"
+	"github.com/gruntwork-io/terragrunt/internal/view/diagnostic"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+)
+
+func Run(ctx context.Context, opts *Options) error {
+	rules, err := selectRules(opts)
+	if err != nil {
+		return err
+	}
+
+	render, err := view.NewRender(opts.Format, &view.RenderOptions{
+		BaseDir:       view.RepoRootDir(ctx, opts.TerragruntOptions),
+		DisableColors: opts.Logger.Formatter().DisabledColors(),
+	})
+	if err != nil {
+		return err
+	}
+
+	configPaths, err := config.FindConfigFilesInPath(opts.WorkingDir, opts.TerragruntOptions)
+	if err != nil {
+		return err
+	}
+
+	units := make([]*Unit, 0, len(configPaths))
+
+	for _, configPath := range configPaths {
+		unit, err := loadUnit(ctx, opts, configPath)
+		if err != nil {
+			return err
+		}
+
+		if unit != nil {
+			units = append(units, unit)
+		}
+	}
+
+	markIncludedUnits(units)
+
+	var issues []*Issue
+
+	for _, unit := range units {
+		for _, rule := range rules {
+			issues = append(issues, rule.check(unit)...)
+		}
+	}
+
+	if opts.Fix {
+		if issues, err = fixIssues(opts, issues); err != nil {
+			return err
+		}
+	}
+
+	diags := make(diagnostic.Diagnostics, 0, len(issues))
+	for _, issue := range issues {
+		diags = append(diags, issue.Diagnostic())
+	}
+
+	if len(diags) > 0 || opts.Format == view.SARIFFormat {
+		if err := view.NewWriter(opts.Writer, render).Diagnostics(diags); err != nil {
+			return err
+		}
+	}
+
+	return issuesError(diags)
+}
+
+func selectRules(opts *Options) (Rules, error) {
+	allRules := AllRules()
+
+	for _, name := range slices.Concat(opts.Rules, opts.DisabledRules) {
+		if !slices.Contains(allRules.Names(), name) {
+			return nil, errors.New(UnknownRuleError{Name: name, Rules: allRules.Names()})
+		}
+	}
+
+	return allRules.Filter(opts.Rules, opts.DisabledRules), nil
+}
+
+// loadUnit parses the configuration of the unit. Configurations that cannot be parsed are reported by `hclvalidate`, not by the linter,
+// so they are skipped. When the configuration cannot be evaluated, only the rules that do not need the evaluated configuration are run.
+func loadUnit(ctx context.Context, opts *Options, configPath string) (*Unit, error) {
+	file, err := hclparse.NewParser().ParseFromFile(configPath)
+	if err != nil {
+		opts.Logger.Warnf("Not linting %s, the configuration cannot be parsed: %v", configPath, err)
+		return nil, nil
+	}
+
+	unitOpts, err := opts.CloneWithConfigPath(configPath)
+	if err != nil {
+		return nil, err
+	}
+
+	unitOpts.SkipOutput = true
+	unitOpts.NonInteractive = true
+
+	unit := &Unit{opts: unitOpts, File: file}
+
+	// The diagnostics are returned as errors instead of being logged, since the linter only warns that the rules needing
+	// the evaluated configuration are not run.
+	parserOpts := append(config.DefaultParserOptions(unitOpts), hclparse.WithDiagnosticsHandler(func(_ *hcl.File, diags hcl.Diagnostics) (hcl.Diagnostics, error) {
+		return nil, diags
+	}))
+
+	// The includes are resolved separately from the configuration, so that the rules comparing the unit to its includes
+	// are also run when the merged configuration cannot be evaluated.
+	if includes, err := includePaths(ctx, unitOpts, parserOpts, file); err != nil {
+		opts.Logger.Warnf("Not linting the includes of %s, they cannot be resolved: %v", configPath, err)
+	} else {
+		for _, includePath := range includes {
+			includeFile, err := hclparse.NewParser().ParseFromFile(includePath)
+			if err != nil {
+				opts.Logger.Warnf("Not linting the include %s of %s, the configuration cannot be parsed: %v", includePath, configPath, err)
+				continue
+			}
+
+			unit.Includes = append(unit.Includes, includeFile)
+		}
+	}
+
+	cfg, err := config.ReadTerragruntConfig(ctx, unitOpts, parserOpts)
+	if err != nil {
+		opts.Logger.Warnf("Only running the syntactic lint rules on %s, the configuration cannot be evaluated: %v", configPath, err)
+		return unit, nil
+	}
+
+	unit.Config = cfg
+
+	return unit, nil
+}
+
+// markIncludedUnits marks the units whose configuration is included by another unit, such as a legacy root `terragrunt.hcl`.
+func markIncludedUnits(units []*Unit) {
+	included := map[string]bool{}
+
+	for _, unit := range units {
+		for _, include := range unit.Includes {
+			included[filepath.Clean(include.ConfigPath)] = true
+		}
+	}
+
+	for _, unit := range units {
+		unit.Included = included[filepath.Clean(unit.File.ConfigPath)]
+	}
+}
+
+// includePaths returns the absolute paths of the configurations included by the unit.
+func includePaths(ctx context.Context, opts *options.TerragruntOptions, parserOpts []hclparse.Option, file *hclparse.File) ([]string, error) {
+	parsingCtx := config.NewParsingContext(ctx, opts).WithParseOption(parserOpts)
+
+	baseBlocks, err := config.DecodeBaseBlocks(parsingCtx, file, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make([]string, 0, len(baseBlocks.TrackInclude.CurrentList))
+
+	for _, include := range baseBlocks.TrackInclude.CurrentList {
+		includePath := include.Path
+		if !filepath.IsAbs(includePath) {
+			includePath = filepath.Join(filepath.Dir(file.ConfigPath), includePath)
+		}
+
+		paths = append(paths, includePath)
+	}
+
+	return paths, nil
+}
+
+// fixIssues fixes the issues that can be fixed, and returns the others.
+func fixIssues(opts *Options, issues []*Issue) ([]*Issue, error) {
+	var (
+		remaining []*Issue
+		fixes     = map[string][]*Issue{}
+		filenames []string
+	)
+
+	for _, issue := range issues {
+		if issue.Fix == nil {
+			remaining = append(remaining, issue)
+			continue
+		}
+
+		filename := issue.File.ConfigPath
+		if _, ok := fixes[filename]; !ok {
+			filenames = append(filenames, filename)
+		}
+
+		fixes[filename] = append(fixes[filename], issue)
+	}
+
+	for _, filename := range filenames {
+		content, err := os.ReadFile(filename)
+		if err != nil {
+			return nil, errors.New(err)
+		}
+
+		file, diags := hclwrite.ParseConfig(content, filename, hcl.InitialPos)
+		if diags.HasErrors() {
+			return nil, errors.New(diags)
+		}
+
+		for _, issue := range fixes[filename] {
+			issue.Fix(file)
+		}
+
+		info, err := os.Stat(filename)
+		if err != nil {
+			return nil, errors.New(err)
+		}
+
+		if err := os.WriteFile(filename, hclwrite.Format(file.Bytes()), info.Mode()); err != nil {
+			return nil, errors.New(err)
+		}
+
+		opts.Logger.Infof("Fixed %d lint issue(s) in %s", len(fixes[filename]), filename)
+	}
+
+	return remaining, nil
+}
+
+// issuesError returns an error if any of the diagnostics is an error.
+func issuesError(diags diagnostic.Diagnostics) error {
+	var count int
+
+	for _, diag := range diags {
+		if hcl.DiagnosticSeverity(diag.Severity) == hcl.DiagError {
+			count++
+		}
+	}
+
+	if count == 0 {
+		return nil
+	}
+
+	return errors.New(LintIssuesError{Count: count})
+}
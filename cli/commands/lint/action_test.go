@@ -0,0 +1,240 @@
+package lint_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gruntwork-io/terragrunt/cli/commands/lint"
+	"github.com/gruntwork-io/terragrunt/internal/view"
+	"github.com/gruntwork-io/terragrunt/internal/view/diagnostic"
+	"github.com/gruntwork-io/terragrunt/options"
+)
+
+func TestLintRules(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		rule     string
+		config   string
+		expected []string
+	}{
+		{
+			name: "unused locals",
+			rule: "unused-locals",
+			config: `
+locals {
+  used   = "used"
+  unused = "unused"
+  nested = local.used
+  _      = run_cmd("--terragrunt-quiet", "true")
+}
+
+inputs = {
+  value = local.nested
+}
+`,
+			expected: []string{`Unused local "unused"`},
+		},
+		{
+			name: "dependency without mock outputs",
+			rule: "dependency-mock-outputs",
+			config: `
+dependency "vpc" {
+  config_path = "../vpc"
+}
+
+dependency "unused" {
+  config_path = "../unused"
+}
+
+dependency "skipped" {
+  config_path  = "../skipped"
+  skip_outputs = true
+}
+
+inputs = {
+  vpc_id  = dependency.vpc.outputs.id
+  skipped = dependency.skipped.outputs.id
+}
+`,
+			expected: []string{`Dependency "vpc" has no mock_outputs`},
+		},
+		{
+			name: "dependency mock outputs not allowed for plan",
+			rule: "dependency-mock-outputs",
+			config: `
+dependency "vpc" {
+  config_path                             = "../vpc"
+  mock_outputs                            = { id = "mock" }
+  mock_outputs_allowed_terraform_commands = ["validate"]
+}
+
+dependency "db" {
+  config_path                             = "../db"
+  mock_outputs                            = { id = "mock" }
+  mock_outputs_allowed_terraform_commands = ["validate", "plan"]
+}
+
+inputs = {
+  vpc_id = dependency.vpc.outputs.id
+  db_id  = dependency.db.outputs.id
+}
+`,
+			expected: []string{`Dependency "vpc" does not allow mock_outputs for plan`},
+		},
+		{
+			name: "deprecated functions",
+			rule: "deprecated-functions",
+			config: `
+locals {
+  default  = find_in_parent_folders()
+  explicit = find_in_parent_folders("terragrunt.hcl")
+  root     = find_in_parent_folders("root.hcl")
+}
+`,
+			expected: []string{
+				"Deprecated usage of find_in_parent_folders",
+				"Deprecated usage of find_in_parent_folders",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			opts, stdout := newTestOptions(t, tc.config)
+			opts.Format = view.JSONFormat
+			opts.Rules = []string{tc.rule}
+
+			require.NoError(t, lint.Run(context.Background(), opts))
+
+			var diags diagnostic.Diagnostics
+			require.NoError(t, json.Unmarshal(stdout.Bytes(), &diags))
+
+			summaries := make([]string, 0, len(diags))
+			for _, diag := range diags {
+				assert.Equal(t, tc.rule, diag.Rule)
+				summaries = append(summaries, diag.Summary)
+			}
+
+			assert.Equal(t, tc.expected, summaries)
+		})
+	}
+}
+
+func TestLintFixUnusedLocalsNotFixed(t *testing.T) {
+	t.Parallel()
+
+	config := `
+locals {
+  used   = "used"
+  unused = "unused"
+}
+
+inputs = {
+  value = local.used
+}
+`
+
+	opts, stdout := newTestOptions(t, config)
+	opts.Rules = []string{"unused-locals"}
+	opts.Fix = true
+
+	require.NoError(t, lint.Run(context.Background(), opts))
+	assert.Contains(t, stdout.String(), `Unused local "unused"`)
+
+	content, err := os.ReadFile(filepath.Join(opts.WorkingDir, "terragrunt.hcl"))
+	require.NoError(t, err)
+	assert.Equal(t, config, string(content))
+}
+
+func TestLintUnusedLocalsIncludedRoot(t *testing.T) {
+	t.Parallel()
+
+	root := `
+locals {
+  env = "dev"
+}
+`
+
+	opts, stdout := newTestOptions(t, root)
+	opts.Rules = []string{"unused-locals"}
+	opts.Fix = true
+
+	childDir := filepath.Join(opts.WorkingDir, "child")
+	require.NoError(t, os.MkdirAll(childDir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(childDir, "terragrunt.hcl"), []byte(`
+include "root" {
+  path   = find_in_parent_folders("terragrunt.hcl")
+  expose = true
+}
+
+inputs = {
+  env = include.root.locals.env
+}
+`), 0644))
+
+	// The locals of the legacy root configuration are read by the child through the include.
+	require.NoError(t, lint.Run(context.Background(), opts))
+	assert.Empty(t, stdout.String())
+
+	content, err := os.ReadFile(filepath.Join(opts.WorkingDir, "terragrunt.hcl"))
+	require.NoError(t, err)
+	assert.Equal(t, root, string(content))
+}
+
+func TestLintUnknownRule(t *testing.T) {
+	t.Parallel()
+
+	opts, _ := newTestOptions(t, "")
+	opts.Rules = []string{"unknown"}
+
+	err := lint.Run(context.Background(), opts)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `Unknown lint rule "unknown"`)
+}
+
+func TestLintGitHubFormatRelativeToWorkspace(t *testing.T) {
+	t.Parallel()
+
+	opts, stdout := newTestOptions(t, `
+locals {
+  unused = "unused"
+}
+`)
+	opts.Format = view.GitHubFormat
+	opts.Rules = []string{"unused-locals"}
+
+	// The paths are relative to the root of the repository checked out in the workspace, rather than to the working dir.
+	workspace := filepath.Dir(opts.WorkingDir)
+	opts.Env = map[string]string{"GITHUB_WORKSPACE": workspace}
+
+	require.NoError(t, lint.Run(context.Background(), opts))
+	assert.Contains(t, stdout.String(), "file="+filepath.Base(opts.WorkingDir)+"/terragrunt.hcl,")
+}
+
+func newTestOptions(t *testing.T, config string) (*lint.Options, *bytes.Buffer) {
+	t.Helper()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "terragrunt.hcl"), []byte(config), 0644))
+
+	tgOptions, err := options.NewTerragruntOptionsForTest(filepath.Join(dir, "terragrunt.hcl"))
+	require.NoError(t, err)
+
+	stdout := new(bytes.Buffer)
+
+	tgOptions.WorkingDir = dir
+	tgOptions.Writer = stdout
+
+	return lint.NewOptions(tgOptions), stdout
+}
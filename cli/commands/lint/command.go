@@ -0,0 +1,71 @@
+// Package lint provides the `lint` command for Terragrunt.
+//
+// `lint` recursively looks for Terragrunt configurations in the directory tree starting at workingDir, and checks them against a set
+// of named rules, such as unused locals or deprecated functions. The issues of the safe rules can be fixed automatically with `--fix`.
+package lint
+
+import (
+	"strings"
+
+	"github.com/gruntwork-io/terragrunt/cli/flags"
+	"github.com/gruntwork-io/terragrunt/internal/cli"
+	"github.com/gruntwork-io/terragrunt/internal/view"
+	"github.com/gruntwork-io/terragrunt/options"
+)
+
+const (
+	CommandName = "lint"
+
+	RuleFlagName        = "rule"
+	DisableRuleFlagName = "disable-rule"
+	FormatFlagName      = "format"
+	FixFlagName         = "fix"
+)
+
+func NewFlags(opts *Options, prefix flags.Prefix) cli.Flags {
+	tgPrefix := prefix.Prepend(flags.TgPrefix)
+	ruleNames := strings.Join(AllRules().Names(), ", ")
+
+	return cli.Flags{
+		flags.NewFlag(&cli.SliceFlag[string]{
+			Name:        RuleFlagName,
+			EnvVars:     tgPrefix.EnvVars(RuleFlagName),
+			Destination: &opts.Rules,
+			Usage:       "Only run the given rules, all of them by default. Available rules: " + ruleNames + ".",
+		}),
+
+		flags.NewFlag(&cli.SliceFlag[string]{
+			Name:        DisableRuleFlagName,
+			EnvVars:     tgPrefix.EnvVars(DisableRuleFlagName),
+			Destination: &opts.DisabledRules,
+			Usage:       "Do not run the given rules.",
+		}),
+
+		flags.NewFlag(&cli.GenericFlag[string]{
+			Name:        FormatFlagName,
+			EnvVars:     tgPrefix.EnvVars(FormatFlagName),
+			Destination: &opts.Format,
+			Usage:       "The format of the issues, one of " + strings.Join(view.Formats(), ", ") + ".",
+		}),
+
+		flags.NewFlag(&cli.BoolFlag{
+			Name:        FixFlagName,
+			EnvVars:     tgPrefix.EnvVars(FixFlagName),
+			Destination: &opts.Fix,
+			Usage:       "Fix the issues of the rules that support it.",
+		}),
+	}
+}
+
+func NewCommand(generalOpts *options.TerragruntOptions) *cli.Command {
+	opts := NewOptions(generalOpts)
+	prefix := flags.Prefix{CommandName}
+
+	return &cli.Command{
+		Name:                 CommandName,
+		Usage:                "Find all Terragrunt configurations from the working directory and check them against the lint rules.",
+		Flags:                NewFlags(opts, prefix).Sort(),
+		ErrorOnUndefinedFlag: true,
+		Action:               func(ctx *cli.Context) error { return Run(ctx, opts) },
+	}
+}
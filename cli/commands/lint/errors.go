@@ -0,0 +1,23 @@
+package lint
+
+import (
+	"fmt"
+	"strings"
+)
+
+type UnknownRuleError struct {
+	Name  string
+	Rules []string
+}
+
+func (err UnknownRuleError) Error() string {
+	return fmt.Sprintf("Unknown lint rule %q, available rules: %s", err.Name, strings.Join(err.Rules, ", "))
+}
+
+type LintIssuesError struct {
+	Count int
+}
+
+func (err LintIssuesError) Error() string {
+	return fmt.Sprintf("%d lint error(s) found", err.Count)
+}
@@ -0,0 +1,22 @@
+package lint
+
+import (
+	"github.com/gruntwork-io/terragrunt/internal/view"
+	"github.com/gruntwork-io/terragrunt/options"
+)
+
+type Options struct {
+	*options.TerragruntOptions
+
+	Format        string
+	Rules         []string
+	DisabledRules []string
+	Fix           bool
+}
+
+func NewOptions(general *options.TerragruntOptions) *Options {
+	return &Options{
+		TerragruntOptions: general,
+		Format:            view.HumanFormat,
+	}
+}
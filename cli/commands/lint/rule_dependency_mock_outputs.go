@@ -0,0 +1,126 @@
+package lint
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/gruntwork-io/terragrunt/tf"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const (
+	dependencyBlockType = "dependency"
+	dependencyVarName   = "dependency"
+
+	mockOutputsAttr                = "mock_outputs"
+	mockOutputsAllowedCommandsAttr = "mock_outputs_allowed_terraform_commands"
+	skipOutputsAttr                = "skip_outputs"
+
+	// dependencyOutputsTraversalLen is the length of the `dependency.<name>.outputs` traversal.
+	dependencyOutputsTraversalLen = 3
+)
+
+var dependencyMockOutputsRule = &Rule{
+	Name:        "dependency-mock-outputs",
+	Description: "Dependencies whose outputs are used without mock_outputs allowed for plan, which fails until the dependency is applied.",
+	Severity:    hcl.DiagWarning,
+	Check:       checkDependencyMockOutputs,
+}
+
+func checkDependencyMockOutputs(unit *Unit) []*Issue {
+	body := syntaxBody(unit.File)
+	if body == nil {
+		return nil
+	}
+
+	usedOutputs := referencedDependencyOutputs(body)
+
+	var issues []*Issue
+
+	for _, block := range body.Blocks {
+		if block.Type != dependencyBlockType || len(block.Labels) == 0 || !usedOutputs[block.Labels[0]] {
+			continue
+		}
+
+		name := block.Labels[0]
+		attrs := block.Body.Attributes
+
+		if skipOutputs, ok := staticValue(attrs[skipOutputsAttr]); ok && skipOutputs.Type() == cty.Bool && skipOutputs.True() {
+			continue
+		}
+
+		if _, ok := attrs[mockOutputsAttr]; !ok {
+			rng := block.DefRange()
+
+			issues = append(issues, &Issue{
+				File:    unit.File,
+				Range:   &rng,
+				Summary: fmt.Sprintf("Dependency %q has no mock_outputs", name),
+				Detail: fmt.Sprintf("The outputs of dependency %q are used, but it declares no %s: running %s before it is applied fails. Set %s, allowed for %s with %s.",
+					name, mockOutputsAttr, tf.CommandNamePlan, mockOutputsAttr, tf.CommandNamePlan, mockOutputsAllowedCommandsAttr),
+			})
+
+			continue
+		}
+
+		allowedAttr, ok := attrs[mockOutputsAllowedCommandsAttr]
+		if !ok {
+			continue
+		}
+
+		allowed, ok := staticValue(allowedAttr)
+		if !ok || !allowed.CanIterateElements() || allowed.LengthInt() == 0 {
+			continue
+		}
+
+		var commands []string
+
+		for it := allowed.ElementIterator(); it.Next(); {
+			_, val := it.Element()
+			if val.Type() == cty.String && val.IsKnown() && !val.IsNull() {
+				commands = append(commands, val.AsString())
+			}
+		}
+
+		if !slices.Contains(commands, tf.CommandNamePlan) {
+			rng := allowedAttr.SrcRange
+
+			issues = append(issues, &Issue{
+				File:    unit.File,
+				Range:   &rng,
+				Summary: fmt.Sprintf("Dependency %q does not allow mock_outputs for plan", name),
+				Detail: fmt.Sprintf("The outputs of dependency %q are used, but its mock_outputs are not allowed for %s: running %s before it is applied fails.",
+					name, tf.CommandNamePlan, tf.CommandNamePlan),
+			})
+		}
+	}
+
+	return issues
+}
+
+// referencedDependencyOutputs returns the names of the dependencies whose outputs are referenced, as `dependency.<name>.outputs`.
+func referencedDependencyOutputs(body *hclsyntax.Body) map[string]bool {
+	referenced := map[string]bool{}
+
+	hclsyntax.VisitAll(body, func(node hclsyntax.Node) hcl.Diagnostics { //nolint:errcheck
+		expr, ok := node.(*hclsyntax.ScopeTraversalExpr)
+		if !ok || len(expr.Traversal) < dependencyOutputsTraversalLen || expr.Traversal.RootName() != dependencyVarName {
+			return nil
+		}
+
+		name, ok := expr.Traversal[1].(hcl.TraverseAttr)
+		if !ok {
+			return nil
+		}
+
+		if outputs, ok := expr.Traversal[2].(hcl.TraverseAttr); ok && outputs.Name == "outputs" {
+			referenced[name.Name] = true
+		}
+
+		return nil
+	})
+
+	return referenced
+}
@@ -0,0 +1,86 @@
+package lint
+
+import (
+	"fmt"
+
+	"github.com/gruntwork-io/terragrunt/config"
+	"github.com/gruntwork-io/terragrunt/internal/strict/controls"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+)
+
+var deprecatedFunctionsRule = &Rule{
+	Name:        "deprecated-functions",
+	Description: "Calls of deprecated functions, or of functions with deprecated arguments. They are errors when the matching strict control is enabled.",
+	Severity:    hcl.DiagWarning,
+	Check:       checkDeprecatedFunctions,
+}
+
+// deprecatedFunctionCall is a deprecated usage of a function, controlled by a strict control.
+type deprecatedFunctionCall struct {
+	// matches returns true if the call is deprecated.
+	matches func(call *hclsyntax.FunctionCallExpr) bool
+
+	funcName string
+	control  string
+	message  string
+}
+
+var deprecatedFunctionCalls = []deprecatedFunctionCall{
+	{
+		funcName: config.FuncNameFindInParentFolders,
+		control:  controls.RootTerragruntHCL,
+		message:  "Using `terragrunt.hcl` as the root of Terragrunt configurations is deprecated. Pass the name of a differently named root file, like `root.hcl`. For more information, see https://terragrunt.gruntwork.io/docs/migrate/migrating-from-root-terragrunt-hcl",
+		matches: func(call *hclsyntax.FunctionCallExpr) bool {
+			if len(call.Args) == 0 {
+				return true
+			}
+
+			val, diags := call.Args[0].Value(nil)
+
+			return !diags.HasErrors() && val.Type() == cty.String && val.IsKnown() && !val.IsNull() && val.AsString() == config.DefaultTerragruntConfigPath
+		},
+	},
+}
+
+func checkDeprecatedFunctions(unit *Unit) []*Issue {
+	body := syntaxBody(unit.File)
+	if body == nil {
+		return nil
+	}
+
+	var issues []*Issue
+
+	hclsyntax.VisitAll(body, func(node hclsyntax.Node) hcl.Diagnostics { //nolint:errcheck
+		call, ok := node.(*hclsyntax.FunctionCallExpr)
+		if !ok {
+			return nil
+		}
+
+		for _, deprecated := range deprecatedFunctionCalls {
+			if call.Name != deprecated.funcName || !deprecated.matches(call) {
+				continue
+			}
+
+			rng := call.Range()
+			issue := &Issue{
+				File:    unit.File,
+				Range:   &rng,
+				Summary: fmt.Sprintf("Deprecated usage of %s", call.Name),
+				Detail:  deprecated.message,
+			}
+
+			if control := unit.opts.StrictControls.Find(deprecated.control); control != nil && control.GetEnabled() {
+				issue.Severity = hcl.DiagError
+				issue.Detail += fmt.Sprintf(" This is an error, since the %s strict control is enabled.", deprecated.control)
+			}
+
+			issues = append(issues, issue)
+		}
+
+		return nil
+	})
+
+	return issues
+}
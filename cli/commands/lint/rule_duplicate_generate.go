@@ -0,0 +1,131 @@
+package lint
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+
+	"github.com/gruntwork-io/terragrunt/config/hclparse"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const (
+	generateBlockType = "generate"
+	generatePathAttr  = "path"
+)
+
+var duplicateGenerateRule = &Rule{
+	Name:        "duplicate-generate",
+	Description: "Generate blocks of a unit that are also declared by its includes, or that generate the same file.",
+	Severity:    hcl.DiagWarning,
+	Fixable:     true,
+	Check:       checkDuplicateGenerate,
+}
+
+type generateBlock struct {
+	file  *hclparse.File
+	block *hclsyntax.Block
+	name  string
+	path  string
+}
+
+func checkDuplicateGenerate(unit *Unit) []*Issue {
+	var (
+		issues []*Issue
+		byName = map[string]*generateBlock{}
+		byPath = map[string]*generateBlock{}
+	)
+
+	// The includes are visited first, so that the issues are reported on the unit, which overrides them.
+	for _, file := range append(slices.Clone(unit.Includes), unit.File) {
+		for _, gen := range generateBlocks(file) {
+			if prev, ok := byName[gen.name]; ok && prev.file != gen.file {
+				issues = append(issues, duplicateGenerateIssue(prev, gen))
+				continue
+			}
+
+			byName[gen.name] = gen
+
+			if gen.path == "" {
+				continue
+			}
+
+			if prev, ok := byPath[gen.path]; ok && prev.name != gen.name {
+				rng := gen.block.DefRange()
+
+				issues = append(issues, &Issue{
+					File:     gen.file,
+					Range:    &rng,
+					Severity: hcl.DiagError,
+					Summary:  fmt.Sprintf("Generate blocks %q and %q generate the same file", prev.name, gen.name),
+					Detail:   fmt.Sprintf("Both generate blocks %q in %s and %q write %s, only one of them is kept.", prev.name, prev.file.ConfigPath, gen.name, gen.path),
+				})
+
+				continue
+			}
+
+			byPath[gen.path] = gen
+		}
+	}
+
+	return issues
+}
+
+func duplicateGenerateIssue(prev, gen *generateBlock) *Issue {
+	rng := gen.block.DefRange()
+
+	issue := &Issue{
+		File:    gen.file,
+		Range:   &rng,
+		Summary: fmt.Sprintf("Generate block %q is also declared in %s", gen.name, prev.file.ConfigPath),
+		Detail:  fmt.Sprintf("The generate block %q overrides the one declared in %s.", gen.name, prev.file.ConfigPath),
+	}
+
+	// Removing the block only keeps the same behavior if it is identical to the one it overrides.
+	if bytes.Equal(blockBytes(prev), blockBytes(gen)) {
+		issue.Detail = fmt.Sprintf("The generate block %q is identical to the one declared in %s, and can be removed.", gen.name, prev.file.ConfigPath)
+		issue.Fix = func(file *hclwrite.File) {
+			for _, block := range file.Body().Blocks() {
+				if block.Type() == generateBlockType && len(block.Labels()) > 0 && block.Labels()[0] == gen.name {
+					file.Body().RemoveBlock(block)
+				}
+			}
+		}
+	}
+
+	return issue
+}
+
+func generateBlocks(file *hclparse.File) []*generateBlock {
+	body := syntaxBody(file)
+	if body == nil {
+		return nil
+	}
+
+	var blocks []*generateBlock
+
+	for _, block := range body.Blocks {
+		if block.Type != generateBlockType || len(block.Labels) == 0 {
+			continue
+		}
+
+		gen := &generateBlock{file: file, block: block, name: block.Labels[0]}
+
+		if path, ok := staticValue(block.Body.Attributes[generatePathAttr]); ok && path.Type() == cty.String && !path.IsNull() {
+			gen.path = path.AsString()
+		}
+
+		blocks = append(blocks, gen)
+	}
+
+	return blocks
+}
+
+// blockBytes returns the source of the block body, without surrounding whitespace.
+func blockBytes(gen *generateBlock) []byte {
+	rng := gen.block.Body.Range()
+	return bytes.TrimSpace(gen.file.Bytes[rng.Start.Byte:rng.End.Byte])
+}
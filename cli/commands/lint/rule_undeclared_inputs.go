@@ -0,0 +1,119 @@
+package lint
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/gruntwork-io/terragrunt/tf"
+	"github.com/gruntwork-io/terragrunt/util"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const inputsAttr = "inputs"
+
+var undeclaredInputsRule = &Rule{
+	Name:        "undeclared-inputs",
+	Description: "Inputs that are not declared as variables by the module. Only modules with a local source are checked.",
+	Severity:    hcl.DiagWarning,
+	Check:       checkUndeclaredInputs,
+}
+
+func checkUndeclaredInputs(unit *Unit) []*Issue {
+	if unit.Config == nil || len(unit.Config.Inputs) == 0 {
+		return nil
+	}
+
+	moduleDir, ok := localModuleDir(unit)
+	if !ok {
+		return nil
+	}
+
+	if files, err := filepath.Glob(filepath.Join(moduleDir, "*.tf")); err != nil || len(files) == 0 {
+		return nil
+	}
+
+	required, optional, err := tf.ModuleVariables(moduleDir)
+	if err != nil {
+		unit.opts.Logger.Debugf("Not checking the inputs of %s, the variables of the module could not be read: %v", unit.File.ConfigPath, err)
+		return nil
+	}
+
+	variables := slices.Concat(required, optional)
+
+	var undeclared []string
+
+	for name := range unit.Config.Inputs {
+		if !slices.Contains(variables, name) {
+			undeclared = append(undeclared, name)
+		}
+	}
+
+	sort.Strings(undeclared)
+
+	issues := make([]*Issue, 0, len(undeclared))
+
+	for _, name := range undeclared {
+		issues = append(issues, &Issue{
+			File:    unit.File,
+			Range:   inputRange(unit, name),
+			Summary: fmt.Sprintf("Undeclared input %q", name),
+			Detail:  fmt.Sprintf("The input %q is not declared as a variable by the module in %s, so it is ignored.", name, moduleDir),
+		})
+	}
+
+	return issues
+}
+
+// localModuleDir returns the directory of the module of the unit, if it is the unit directory or a local path.
+func localModuleDir(unit *Unit) (string, bool) {
+	unitDir := filepath.Dir(unit.File.ConfigPath)
+
+	if unit.Config.Terraform == nil || unit.Config.Terraform.Source == nil {
+		return unitDir, true
+	}
+
+	source := *unit.Config.Terraform.Source
+
+	switch {
+	case filepath.IsAbs(source):
+		return filepath.Clean(source), true
+	case strings.HasPrefix(source, "./"), strings.HasPrefix(source, "../"):
+		// The `//` separator of the subdirectory of a local source resolves to the same path.
+		return util.JoinPath(unitDir, source), true
+	default:
+		return "", false
+	}
+}
+
+// inputRange returns the range of the input in the `inputs` attribute of the unit, or of the attribute itself if the input is inherited.
+func inputRange(unit *Unit, name string) *hcl.Range {
+	body := syntaxBody(unit.File)
+	if body == nil {
+		return nil
+	}
+
+	attr, ok := body.Attributes[inputsAttr]
+	if !ok {
+		return nil
+	}
+
+	if obj, ok := attr.Expr.(*hclsyntax.ObjectConsExpr); ok {
+		for _, item := range obj.Items {
+			// Bare keys, such as `name = ...`, evaluate to their name.
+			key, diags := item.KeyExpr.Value(nil)
+			if !diags.HasErrors() && key.Type() == cty.String && key.IsKnown() && !key.IsNull() && key.AsString() == name {
+				rng := item.KeyExpr.Range()
+				return &rng
+			}
+		}
+	}
+
+	rng := attr.NameRange
+
+	return &rng
+}
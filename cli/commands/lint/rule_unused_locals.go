@@ -0,0 +1,100 @@
+package lint
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+const (
+	localsBlockType = "locals"
+	localVarName    = "local"
+)
+
+// unusedLocalsRule is not fixable, since the locals may still be read by other configurations, such as with
+// `read_terragrunt_config(...).locals`, which cannot be told from the configuration itself.
+var unusedLocalsRule = &Rule{
+	Name:        "unused-locals",
+	Description: "Locals that are declared but never referenced in the configuration.",
+	Severity:    hcl.DiagWarning,
+	Check:       checkUnusedLocals,
+}
+
+func checkUnusedLocals(unit *Unit) []*Issue {
+	body := syntaxBody(unit.File)
+	if body == nil {
+		return nil
+	}
+
+	// The locals of an included configuration are read by the units including it, through `include.<name>.locals`.
+	if unit.Included {
+		return nil
+	}
+
+	used := referencedAttrs(body, localVarName)
+
+	var issues []*Issue
+
+	for _, block := range body.Blocks {
+		if block.Type != localsBlockType {
+			continue
+		}
+
+		for name, attr := range block.Body.Attributes {
+			// The locals calling functions, such as `_ = run_cmd(...)`, may be declared only for their side effects.
+			if used[name] || callsFunction(attr.Expr) {
+				continue
+			}
+
+			rng := attr.NameRange
+
+			issues = append(issues, &Issue{
+				File:    unit.File,
+				Range:   &rng,
+				Summary: fmt.Sprintf("Unused local %q", name),
+				Detail:  fmt.Sprintf("The local %q is declared, but never referenced as local.%s.", name, name),
+			})
+		}
+	}
+
+	sort.Slice(issues, func(i, j int) bool { return issues[i].Range.Start.Byte < issues[j].Range.Start.Byte })
+
+	return issues
+}
+
+// referencedAttrs returns the names of the attributes of the given root variable referenced in the body, such as `local.<name>`.
+func referencedAttrs(body *hclsyntax.Body, rootName string) map[string]bool {
+	referenced := map[string]bool{}
+
+	hclsyntax.VisitAll(body, func(node hclsyntax.Node) hcl.Diagnostics { //nolint:errcheck
+		expr, ok := node.(*hclsyntax.ScopeTraversalExpr)
+		if !ok || len(expr.Traversal) < 2 || expr.Traversal.RootName() != rootName {
+			return nil
+		}
+
+		if attr, ok := expr.Traversal[1].(hcl.TraverseAttr); ok {
+			referenced[attr.Name] = true
+		}
+
+		return nil
+	})
+
+	return referenced
+}
+
+// callsFunction returns true if the expression calls any function.
+func callsFunction(expr hclsyntax.Expression) bool {
+	var found bool
+
+	hclsyntax.VisitAll(expr, func(node hclsyntax.Node) hcl.Diagnostics { //nolint:errcheck
+		if _, ok := node.(*hclsyntax.FunctionCallExpr); ok {
+			found = true
+		}
+
+		return nil
+	})
+
+	return found
+}
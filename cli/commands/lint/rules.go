@@ -0,0 +1,129 @@
+package lint
+
+import (
+	"slices"
+	"sort"
+
+	"github.com/gruntwork-io/terragrunt/config"
+	"github.com/gruntwork-io/terragrunt/config/hclparse"
+	"github.com/gruntwork-io/terragrunt/internal/view/diagnostic"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+)
+
+// Rule is a named lint rule.
+type Rule struct {
+	// Check returns the issues of the given unit.
+	Check func(unit *Unit) []*Issue
+
+	Name        string
+	Description string
+	Severity    hcl.DiagnosticSeverity
+	// Fixable is true if the issues of the rule can be fixed with `--fix`, which is only the case when the fix never changes the behavior
+	// of the configuration.
+	Fixable bool
+}
+
+// Rules is a set of lint rules.
+type Rules []*Rule
+
+// AllRules returns all the lint rules, sorted by name.
+func AllRules() Rules {
+	rules := Rules{
+		unusedLocalsRule,
+		dependencyMockOutputsRule,
+		deprecatedFunctionsRule,
+		undeclaredInputsRule,
+		duplicateGenerateRule,
+	}
+
+	sort.Slice(rules, func(i, j int) bool { return rules[i].Name < rules[j].Name })
+
+	return rules
+}
+
+// Names returns the names of the rules.
+func (rules Rules) Names() []string {
+	names := make([]string, 0, len(rules))
+
+	for _, rule := range rules {
+		names = append(names, rule.Name)
+	}
+
+	return names
+}
+
+// Filter returns the rules enabled by the given names, all of them if `enabled` is empty, minus the `disabled` ones.
+func (rules Rules) Filter(enabled, disabled []string) Rules {
+	var filtered Rules
+
+	for _, rule := range rules {
+		if (len(enabled) == 0 || slices.Contains(enabled, rule.Name)) && !slices.Contains(disabled, rule.Name) {
+			filtered = append(filtered, rule)
+		}
+	}
+
+	return filtered
+}
+
+// Unit is a unit under lint.
+type Unit struct {
+	opts *options.TerragruntOptions
+
+	// File is the configuration file of the unit.
+	File *hclparse.File
+	// Includes are the configuration files included by the unit.
+	Includes []*hclparse.File
+	// Config is the parsed configuration of the unit, nil if it could not be parsed, in which case the rules that need it are skipped.
+	Config *config.TerragruntConfig
+	// Included is true if the configuration of the unit is included by another unit under lint.
+	Included bool
+}
+
+// Issue is a violation of a lint rule.
+type Issue struct {
+	// Fix fixes the issue in the given file, for the rules that are fixable.
+	Fix func(file *hclwrite.File)
+
+	Rule  *Rule
+	File  *hclparse.File
+	Range *hcl.Range
+	// Severity overrides the severity of the rule, if set.
+	Severity hcl.DiagnosticSeverity
+	Summary  string
+	Detail   string
+}
+
+// check runs the rule against the given unit.
+func (rule *Rule) check(unit *Unit) []*Issue {
+	issues := rule.Check(unit)
+
+	for _, issue := range issues {
+		issue.Rule = rule
+
+		if issue.Severity == hcl.DiagInvalid {
+			issue.Severity = rule.Severity
+		}
+	}
+
+	return issues
+}
+
+// Diagnostic converts the issue to a diagnostic.
+func (issue *Issue) Diagnostic() *diagnostic.Diagnostic {
+	detail := issue.Detail
+	if issue.Fix != nil {
+		detail += " This issue can be fixed automatically with --fix."
+	}
+
+	diag := diagnostic.NewDiagnostic(issue.File.File, &hcl.Diagnostic{
+		Severity: issue.Severity,
+		Summary:  issue.Summary,
+		Detail:   detail,
+		Subject:  issue.Range,
+	})
+	diag.Rule = issue.Rule.Name
+
+	return diag
+}
@@ -0,0 +1,35 @@
+package lint
+
+import (
+	"github.com/gruntwork-io/terragrunt/config/hclparse"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// syntaxBody returns the native syntax body of the file, nil for JSON configurations, which are not linted.
+func syntaxBody(file *hclparse.File) *hclsyntax.Body {
+	if file == nil {
+		return nil
+	}
+
+	body, ok := file.Body.(*hclsyntax.Body)
+	if !ok {
+		return nil
+	}
+
+	return body
+}
+
+// staticValue returns the value of the attribute if it can be evaluated without any variable or function.
+func staticValue(attr *hclsyntax.Attribute) (cty.Value, bool) {
+	if attr == nil {
+		return cty.NilVal, false
+	}
+
+	val, diags := attr.Expr.Value(nil)
+	if diags.HasErrors() || !val.IsWhollyKnown() {
+		return cty.NilVal, false
+	}
+
+	return val, true
+}
@@ -0,0 +1,18 @@
+package lsp
+
+import (
+	"context"
+	"os"
+
+	"github.com/gruntwork-io/terragrunt/internal/lsp"
+	"github.com/gruntwork-io/terragrunt/options"
+)
+
+func Run(ctx context.Context, opts *options.TerragruntOptions) error {
+	// The standard output is used by the protocol, so anything else written by Terragrunt goes to the standard error.
+	opts.Writer = opts.ErrWriter
+
+	opts.Logger.Debugf("Starting the language server")
+
+	return lsp.NewServer(opts).Serve(ctx, os.Stdin, os.Stdout)
+}
@@ -0,0 +1,23 @@
+// Package lsp provides the `lsp` command for Terragrunt.
+//
+// `lsp` runs a language server speaking the Language Server Protocol over stdio, to provide editors with diagnostics,
+// go-to-definition, hover and completion for Terragrunt configurations.
+package lsp
+
+import (
+	"github.com/gruntwork-io/terragrunt/internal/cli"
+	"github.com/gruntwork-io/terragrunt/options"
+)
+
+const (
+	CommandName = "lsp"
+)
+
+func NewCommand(opts *options.TerragruntOptions) *cli.Command {
+	return &cli.Command{
+		Name:                 CommandName,
+		Usage:                "Run a language server for Terragrunt configurations, speaking the Language Server Protocol over stdio.",
+		ErrorOnUndefinedFlag: true,
+		Action:               func(ctx *cli.Context) error { return Run(ctx, opts) },
+	}
+}
@@ -0,0 +1,275 @@
+package policy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"slices"
+
+	"github.com/gruntwork-io/terragrunt/config"
+	"github.com/gruntwork-io/terragrunt/config/hclparse"
+	"github.com/gruntwork-io/terragrunt/configstack"
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	policyeval "github.com/gruntwork-io/terragrunt/internal/policy"
+	"github.com/gruntwork-io/terragrunt/internal/view"
+	"github.com/gruntwork-io/terragrunt/internal/view/diagnostic"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+)
+
+func Run(ctx context.Context, opts *Options) error {
+	if opts.PolicyDir == "" {
+		return errors.New(MissingPolicyDirError{})
+	}
+
+	stack, err := configstack.FindStackInSubfolders(ctx, opts.TerragruntOptions)
+	if err != nil {
+		return err
+	}
+
+	diags, err := CheckStack(ctx, opts.TerragruntOptions, stack)
+	if err != nil {
+		return err
+	}
+
+	if len(diags) > 0 {
+		if err := writeDiagnostics(opts.TerragruntOptions, opts.Writer, opts.JSONOutput, diags); err != nil {
+			return err
+		}
+	}
+
+	return violationsError(diags)
+}
+
+// Preflight evaluates the policies before `run-all` runs the stack, and fails if any unit violates a policy with the `error` severity.
+func Preflight(ctx context.Context, opts *options.TerragruntOptions, stack *configstack.Stack) error {
+	opts.Logger.Infof("Evaluating the policies of %s against the stack", opts.PolicyDir)
+
+	diags, err := CheckStack(ctx, opts, stack)
+	if err != nil {
+		return err
+	}
+
+	if len(diags) > 0 {
+		if err := writeDiagnostics(opts, opts.ErrWriter, false, diags); err != nil {
+			return err
+		}
+	}
+
+	return violationsError(diags)
+}
+
+// CheckStack evaluates the policies against the rendered configuration of each unit of the stack, and returns the violations as diagnostics.
+// Dependency outputs are not fetched: the outputs of a dependency are its `mock_outputs` if they are allowed for the command,
+// and are not set otherwise.
+func CheckStack(ctx context.Context, opts *options.TerragruntOptions, stack *configstack.Stack) (diagnostic.Diagnostics, error) {
+	policyDir := opts.PolicyDir
+	if !filepath.IsAbs(policyDir) {
+		policyDir = filepath.Join(opts.WorkingDir, policyDir)
+	}
+
+	policies, err := policyeval.Load(policyDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var diags diagnostic.Diagnostics
+
+	for _, module := range stack.Modules {
+		if module.FlagExcluded {
+			continue
+		}
+
+		unitDiags, err := checkUnit(ctx, opts, module, policies)
+		if err != nil {
+			return nil, err
+		}
+
+		diags = append(diags, unitDiags...)
+	}
+
+	return diags, nil
+}
+
+func checkUnit(ctx context.Context, opts *options.TerragruntOptions, module *configstack.TerraformModule, policies *policyeval.Policies) (diagnostic.Diagnostics, error) {
+	unitOpts := module.TerragruntOptions.Clone()
+	// Only the `mock_outputs` of the dependencies are used, their state is never read.
+	unitOpts.SkipOutput = true
+	unitOpts.NonInteractive = true
+
+	cfg, err := config.ReadTerragruntConfig(ctx, unitOpts, config.DefaultParserOptions(unitOpts))
+	if err != nil {
+		return nil, err
+	}
+
+	renderedConfig, err := renderConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := hclparse.NewParser().ParseFromFile(unitOpts.TerragruntConfigPath)
+	if err != nil {
+		return nil, err
+	}
+
+	functions, err := configFunctions(file, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	unitPath, err := filepath.Rel(opts.WorkingDir, module.Path)
+	if err != nil {
+		return nil, errors.New(err)
+	}
+
+	input := &policyeval.Input{
+		Config: renderedConfig,
+		Unit: policyeval.Unit{
+			Path:       filepath.ToSlash(unitPath),
+			ConfigPath: unitOpts.TerragruntConfigPath,
+			Functions:  functions,
+		},
+	}
+
+	violations, err := policies.Evaluate(ctx, unitOpts, input)
+	if err != nil {
+		return nil, err
+	}
+
+	diags := make(diagnostic.Diagnostics, 0, len(violations))
+
+	for _, violation := range violations {
+		diags = append(diags, violationDiagnostic(file, input.Unit.Path, violation))
+	}
+
+	return diags, nil
+}
+
+// renderConfig renders the configuration the same way as `render-json`.
+func renderConfig(cfg *config.TerragruntConfig) (map[string]any, error) {
+	configCty, err := config.TerragruntConfigAsCty(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// The policies are evaluated against the actual values, including the sensitive ones.
+	configCty, _ = configCty.UnmarkDeep()
+
+	jsonBytes, err := ctyjson.SimpleJSONValue{Value: configCty}.MarshalJSON()
+	if err != nil {
+		return nil, errors.New(err)
+	}
+
+	var rendered map[string]any
+	if err := json.Unmarshal(jsonBytes, &rendered); err != nil {
+		return nil, errors.New(err)
+	}
+
+	return rendered, nil
+}
+
+// configFunctions returns the names of the functions called in the given configuration and the configurations it includes.
+func configFunctions(file *hclparse.File, cfg *config.TerragruntConfig) ([]string, error) {
+	files := []*hclparse.File{file}
+
+	for _, include := range cfg.ProcessedIncludes {
+		includePath := include.Path
+		if !filepath.IsAbs(includePath) {
+			includePath = filepath.Join(filepath.Dir(file.ConfigPath), includePath)
+		}
+
+		includeFile, err := hclparse.NewParser().ParseFromFile(includePath)
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, includeFile)
+	}
+
+	var functions []string
+
+	for _, file := range files {
+		body, ok := file.Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		hclsyntax.VisitAll(body, func(node hclsyntax.Node) hcl.Diagnostics { //nolint:errcheck
+			if call, ok := node.(*hclsyntax.FunctionCallExpr); ok && !slices.Contains(functions, call.Name) {
+				functions = append(functions, call.Name)
+			}
+
+			return nil
+		})
+	}
+
+	return functions, nil
+}
+
+// violationDiagnostic converts the violation to a diagnostic, pointing to the attribute or block of the unit configuration that caused it,
+// or to the unit configuration as a whole.
+func violationDiagnostic(file *hclparse.File, unitPath string, violation *policyeval.Violation) *diagnostic.Diagnostic {
+	hclDiag := &hcl.Diagnostic{
+		Severity: hcl.DiagError,
+		Summary:  fmt.Sprintf("Policy %s violated by unit %s", violation.Policy, unitPath),
+		Detail:   violation.Message,
+		Subject:  violationRange(file, violation.Attribute),
+	}
+
+	if !violation.IsError() {
+		hclDiag.Severity = hcl.DiagWarning
+	}
+
+	return diagnostic.NewDiagnostic(file.File, hclDiag)
+}
+
+func violationRange(file *hclparse.File, name string) *hcl.Range {
+	body, ok := file.Body.(*hclsyntax.Body)
+	if !ok {
+		return &hcl.Range{Filename: file.ConfigPath, Start: hcl.InitialPos, End: hcl.InitialPos}
+	}
+
+	if attr, ok := body.Attributes[name]; ok && name != "" {
+		return &attr.SrcRange
+	}
+
+	for _, block := range body.Blocks {
+		if block.Type == name && name != "" {
+			rng := block.DefRange()
+			return &rng
+		}
+	}
+
+	// The violation does not relate to a specific attribute, the start of the configuration is shown.
+	return &hcl.Range{Filename: body.SrcRange.Filename, Start: body.SrcRange.Start, End: body.SrcRange.Start}
+}
+
+func writeDiagnostics(opts *options.TerragruntOptions, writer io.Writer, jsonOutput bool, diags diagnostic.Diagnostics) error {
+	render := view.NewHumanRender(opts.Logger.Formatter().DisabledColors())
+	if jsonOutput {
+		render = view.NewJSONRender()
+	}
+
+	return view.NewWriter(writer, render).Diagnostics(diags)
+}
+
+// violationsError returns an error if any of the diagnostics is an error.
+func violationsError(diags diagnostic.Diagnostics) error {
+	var count int
+
+	for _, diag := range diags {
+		if hcl.DiagnosticSeverity(diag.Severity) == hcl.DiagError {
+			count++
+		}
+	}
+
+	if count == 0 {
+		return nil
+	}
+
+	return errors.New(PolicyViolationsError{Count: count})
+}
@@ -0,0 +1,68 @@
+// Package policy provides the `policy` command for Terragrunt.
+//
+// `policy check` evaluates the policies of a directory against the rendered configuration of each unit of the stack,
+// and reports the violations as diagnostics.
+package policy
+
+import (
+	"github.com/gruntwork-io/terragrunt/cli/flags"
+	"github.com/gruntwork-io/terragrunt/internal/cli"
+	"github.com/gruntwork-io/terragrunt/options"
+)
+
+const (
+	CommandName = "policy"
+
+	PolicyDirFlagName = "policy-dir"
+	JSONFlagName      = "json"
+
+	checkCommandName = "check"
+)
+
+// NewPolicyDirFlag returns the flag of the policy directory, shared by `policy check` and `run-all`.
+func NewPolicyDirFlag(opts *options.TerragruntOptions, usage string) *flags.Flag {
+	tgPrefix := flags.Prefix{flags.TgPrefix}
+
+	return flags.NewFlag(&cli.GenericFlag[string]{
+		Name:        PolicyDirFlagName,
+		EnvVars:     tgPrefix.EnvVars(PolicyDirFlagName),
+		Destination: &opts.PolicyDir,
+		Usage:       usage,
+	})
+}
+
+func NewCheckFlags(opts *Options, prefix flags.Prefix) cli.Flags {
+	tgPrefix := prefix.Prepend(flags.TgPrefix)
+
+	return cli.Flags{
+		NewPolicyDirFlag(opts.TerragruntOptions, "Directory with the policies to evaluate: .hcl files with rule blocks and .rego files."),
+
+		flags.NewFlag(&cli.BoolFlag{
+			Name:        JSONFlagName,
+			EnvVars:     tgPrefix.EnvVars(JSONFlagName),
+			Destination: &opts.JSONOutput,
+			Usage:       "Output the violations in JSON format.",
+		}),
+	}
+}
+
+func NewCommand(generalOpts *options.TerragruntOptions) *cli.Command {
+	opts := NewOptions(generalOpts)
+	prefix := flags.Prefix{CommandName}
+
+	return &cli.Command{
+		Name:                 CommandName,
+		Usage:                "Evaluate policies against Terragrunt configurations.",
+		ErrorOnUndefinedFlag: true,
+		Subcommands: cli.Commands{
+			&cli.Command{
+				Name:                 checkCommandName,
+				Usage:                "Evaluate the policies against the rendered configuration of each unit of the stack.",
+				Flags:                NewCheckFlags(opts, prefix).Sort(),
+				ErrorOnUndefinedFlag: true,
+				Action:               func(ctx *cli.Context) error { return Run(ctx, opts) },
+			},
+		},
+		Action: cli.ShowCommandHelp,
+	}
+}
@@ -0,0 +1,17 @@
+package policy
+
+import "fmt"
+
+type MissingPolicyDirError struct{}
+
+func (err MissingPolicyDirError) Error() string {
+	return "Missing policy directory, set it with --" + PolicyDirFlagName
+}
+
+type PolicyViolationsError struct {
+	Count int
+}
+
+func (err PolicyViolationsError) Error() string {
+	return fmt.Sprintf("%d policy violation(s) found", err.Count)
+}
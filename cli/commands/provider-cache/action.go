@@ -0,0 +1,101 @@
+package providercache
+
+import (
+	"context"
+	"net"
+	"os"
+
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/internal/os/signal"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/tf/cache/services"
+)
+
+// RunServe runs the Provider Cache server until the given `ctx` is done.
+// Each reload signal rebuilds the server from the current CLI config and restarts it on the same address. The provider service
+// is kept across the reloads, so that the caching requests pending on a reload are not lost.
+func RunServe(ctx context.Context, opts *options.TerragruntOptions) error {
+	reloadCh := make(chan os.Signal, 1)
+
+	signal.NotifierWithContext(ctx, func(sig os.Signal) {
+		select {
+		case reloadCh <- sig:
+		default:
+		}
+	}, signal.ReloadSignals...)
+
+	providerService, err := newProviderService(opts)
+	if err != nil {
+		return err
+	}
+
+	// The service is stopped once the last server is shut down rather than when `ctx` is done, so that the requests in flight can complete.
+	serviceCtx, cancelService := context.WithCancel(context.WithoutCancel(ctx))
+	serviceErrCh := make(chan error, 1)
+
+	go func() {
+		serviceErrCh <- providerService.Run(serviceCtx)
+	}()
+
+	err = serveWithReloads(ctx, opts, providerService, reloadCh)
+
+	cancelService()
+
+	return (&errors.MultiError{}).Append(err, <-serviceErrCh).ErrorOrNil()
+}
+
+// serveWithReloads runs the servers built around the given provider service, one after the other on each reload signal, until the `ctx` is done.
+func serveWithReloads(ctx context.Context, opts *options.TerragruntOptions, providerService *services.ProviderService, reloadCh <-chan os.Signal) error {
+	tokenGenerated := opts.ProviderCacheToken == ""
+
+	for {
+		server, err := newSharedServer(opts, providerService)
+		if err != nil {
+			return err
+		}
+
+		ln, err := server.Listen()
+		if err != nil {
+			return err
+		}
+
+		// Pin the automatically chosen port so that the clients can keep using the same address after a reload.
+		if addr, ok := ln.Addr().(*net.TCPAddr); ok && opts.ProviderCachePort == 0 {
+			opts.ProviderCachePort = addr.Port
+		}
+
+		if tokenGenerated {
+			opts.Logger.Infof("Terragrunt Cache server token: %s", opts.ProviderCacheToken)
+
+			tokenGenerated = false
+		}
+
+		reload, err := serve(ctx, server, ln, reloadCh)
+		if err != nil || !reload {
+			return err
+		}
+
+		opts.Logger.Infof("Reloading Terragrunt Cache server...")
+	}
+}
+
+// serve runs the given `server` until either the `ctx` is done or a reload signal is received, in the latter case it returns true.
+func serve(ctx context.Context, server *Server, ln net.Listener, reloadCh <-chan os.Signal) (bool, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- server.Run(ctx, ln)
+	}()
+
+	select {
+	case <-reloadCh:
+		cancel()
+
+		return true, <-errCh
+	case err := <-errCh:
+		return false, err
+	}
+}
@@ -0,0 +1,95 @@
+// Package providercache provides the `provider-cache` command that manages the Terragrunt Provider Cache server.
+package providercache
+
+import (
+	"github.com/gruntwork-io/terragrunt/cli/commands/run"
+	"github.com/gruntwork-io/terragrunt/cli/flags"
+	"github.com/gruntwork-io/terragrunt/internal/cli"
+	"github.com/gruntwork-io/terragrunt/options"
+)
+
+const (
+	CommandName = "provider-cache"
+
+	LockFileFlagName = "lock-file"
+	PlatformFlagName = "platform"
+
+	serveCommandName  = "serve"
+	mirrorCommandName = "mirror"
+	warmCommandName   = "warm"
+)
+
+// NewServeFlags builds the flags for `provider-cache serve`.
+func NewServeFlags(opts *options.TerragruntOptions, prefix flags.Prefix) cli.Flags {
+	return run.NewFlags(opts, prefix).Filter(
+		run.ProviderCacheDirFlagName,
+		run.ProviderCacheHostnameFlagName,
+		run.ProviderCachePortFlagName,
+		run.ProviderCacheTokenFlagName,
+		run.ProviderCacheRegistryNamesFlagName,
+		run.ProviderCacheOfflineFlagName,
+	)
+}
+
+// NewMirrorFlags builds the flags for `provider-cache mirror` and `provider-cache warm`.
+func NewMirrorFlags(opts *Options, prefix flags.Prefix) cli.Flags {
+	tgPrefix := prefix.Prepend(flags.TgPrefix)
+
+	return cli.Flags{
+		flags.NewFlag(&cli.SliceFlag[string]{
+			Name:        LockFileFlagName,
+			EnvVars:     tgPrefix.EnvVars(LockFileFlagName),
+			Destination: &opts.LockFiles,
+			Usage:       "The dependency lock file to read the providers from. Can be specified multiple times. By default, all '.terraform.lock.hcl' files in the working directory.",
+		}),
+
+		flags.NewFlag(&cli.SliceFlag[string]{
+			Name:        PlatformFlagName,
+			EnvVars:     tgPrefix.EnvVars(PlatformFlagName),
+			Destination: &opts.Platforms,
+			Usage:       "The target platform in the os_arch format, e.g. linux_amd64. Can be specified multiple times. By default, the current platform.",
+		}),
+	}
+}
+
+// NewCommand builds the command for `provider-cache`.
+func NewCommand(generalOpts *options.TerragruntOptions) *cli.Command {
+	opts := NewOptions(generalOpts)
+	prefix := flags.Prefix{CommandName}
+
+	return &cli.Command{
+		Name:                 CommandName,
+		Usage:                "Terragrunt Provider Cache server commands.",
+		ErrorOnUndefinedFlag: true,
+		Subcommands: cli.Commands{
+			&cli.Command{
+				Name:        serveCommandName,
+				Usage:       "Run the Provider Cache server as a long-running process shared by multiple Terragrunt invocations.",
+				Description: "The server runs until it receives an interrupt signal. On SIGHUP, the CLI config is re-read and the server is restarted on the same address without dropping in-flight requests.",
+				Flags:       NewServeFlags(opts.TerragruntOptions, nil).Sort(),
+				Action: func(ctx *cli.Context) error {
+					return RunServe(ctx.Context, opts.TerragruntOptions)
+				},
+			},
+			&cli.Command{
+				Name:        warmCommandName,
+				Usage:       "Download all providers used in the working directory into the provider cache in one go.",
+				Description: "The providers are read from the dependency lock files and the 'required_providers' blocks. Run it before 'run-all' to replace the serialized cold-cache 'init' of each unit with one parallel bulk fetch.",
+				Flags:       append(NewMirrorFlags(opts, prefix), run.NewFlags(opts.TerragruntOptions, nil).Filter(run.ProviderCacheDirFlagName)...).Sort(),
+				Action: func(ctx *cli.Context) error {
+					return RunWarm(ctx.Context, opts)
+				},
+			},
+			&cli.Command{
+				Name:        mirrorCommandName,
+				Usage:       "Populate a filesystem mirror with the providers recorded in the dependency lock files.",
+				Description: "The mirror can then be used in air-gapped environments with --provider-cache-offline, by adding it as a 'filesystem_mirror' to the CLI config file.",
+				Flags:       NewMirrorFlags(opts, prefix).Sort(),
+				Action: func(ctx *cli.Context) error {
+					return RunMirror(ctx.Context, opts, ctx.Args().First())
+				},
+			},
+		},
+		Action: cli.ShowCommandHelp,
+	}
+}
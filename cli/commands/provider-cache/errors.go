@@ -0,0 +1,56 @@
+package providercache
+
+import (
+	"fmt"
+
+	"github.com/gruntwork-io/terragrunt/tf/getproviders"
+)
+
+type InvalidPlatformError string
+
+func (platform InvalidPlatformError) Error() string {
+	return fmt.Sprintf("invalid platform %q, expected the os_arch format, e.g. linux_amd64", string(platform))
+}
+
+type ProviderNotFoundError string
+
+func (provider ProviderNotFoundError) Error() string {
+	return fmt.Sprintf("provider %s is not found in any registry or mirror", string(provider))
+}
+
+type FetchProviderError struct {
+	Provider string
+	Err      error
+}
+
+func (err FetchProviderError) Error() string {
+	return fmt.Sprintf("failed to fetch provider %s: %v", err.Provider, err.Err)
+}
+
+func (err FetchProviderError) Unwrap() error {
+	return err.Err
+}
+
+type HashMismatchError struct {
+	Provider string
+	Hash     getproviders.Hash
+}
+
+func (err HashMismatchError) Error() string {
+	return fmt.Sprintf("the checksum %s of provider %s does not match any of the hashes recorded in the lock files", err.Hash, err.Provider)
+}
+
+type MissingMirrorDirError struct{}
+
+func (err MissingMirrorDirError) Error() string {
+	return "the mirror directory must be specified, e.g. `terragrunt provider-cache mirror /path/to/mirror`"
+}
+
+type NoMatchingVersionError struct {
+	Provider    string
+	Constraints string
+}
+
+func (err NoMatchingVersionError) Error() string {
+	return fmt.Sprintf("no available version of provider %s matches the constraints %q", err.Provider, err.Constraints)
+}
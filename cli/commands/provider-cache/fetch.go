@@ -0,0 +1,279 @@
+package providercache
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"runtime"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"github.com/gruntwork-io/terragrunt/tf/cache/handlers"
+	"github.com/gruntwork-io/terragrunt/tf/cache/helpers"
+	"github.com/gruntwork-io/terragrunt/tf/cache/models"
+	"github.com/gruntwork-io/terragrunt/tf/cache/services"
+	"github.com/gruntwork-io/terragrunt/tf/cliconfig"
+	"github.com/gruntwork-io/terragrunt/tf/getproviders"
+	"github.com/gruntwork-io/terragrunt/util"
+	"github.com/hashicorp/go-version"
+	svchost "github.com/hashicorp/terraform-svchost"
+	"golang.org/x/sync/errgroup"
+)
+
+// readLockfiles reads and merges the providers of the given dependency lock files. If no files are given, all lock files in `workingDir` are used.
+func readLockfiles(workingDir string, filenames []string) (getproviders.LockedProviders, error) {
+	if len(filenames) == 0 {
+		var err error
+
+		if filenames, err = getproviders.FindLockfiles(workingDir); err != nil {
+			return nil, err
+		}
+	}
+
+	var providers getproviders.LockedProviders
+
+	for _, filename := range filenames {
+		lockedProviders, err := getproviders.ParseLockfile(filename)
+		if err != nil {
+			return nil, err
+		}
+
+		providers = providers.Merge(lockedProviders...)
+	}
+
+	return providers, nil
+}
+
+// providersForPlatforms returns the providers for each of the given `platforms` in the `os_arch` format, by default, the current platform.
+func providersForPlatforms(lockedProviders getproviders.LockedProviders, platforms []string) (models.Providers, error) {
+	if len(platforms) == 0 {
+		platforms = []string{runtime.GOOS + "_" + runtime.GOARCH}
+	}
+
+	var providers models.Providers
+
+	for _, platform := range platforms {
+		goos, goarch, ok := strings.Cut(platform, "_")
+		if !ok {
+			return nil, errors.New(InvalidPlatformError(platform))
+		}
+
+		for _, lockedProvider := range lockedProviders {
+			provider := models.ParseProvider(lockedProvider.Address)
+			provider.Version = lockedProvider.Version
+			provider.OS = goos
+			provider.Arch = goarch
+
+			providers = append(providers, provider)
+		}
+	}
+
+	return providers, nil
+}
+
+// providerFetcher resolves and downloads providers outside of the Terraform `init` flow, using the same handlers and provider service as the cache server.
+type providerFetcher struct {
+	logger           log.Logger
+	credsSource      *cliconfig.CredentialsSource
+	providerHandlers handlers.ProviderHandlers
+}
+
+func newProviderFetcher(logger log.Logger) (*providerFetcher, error) {
+	cliCfg, err := cliconfig.LoadUserConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	providerHandlers, err := handlers.NewProviderHandlers(cliCfg, logger, nil)
+	if err != nil {
+		return nil, errors.Errorf("creating provider handlers failed: %w", err)
+	}
+
+	return &providerFetcher{
+		logger:           logger,
+		credsSource:      cliCfg.CredentialsSource(),
+		providerHandlers: providerHandlers,
+	}, nil
+}
+
+// resolveVersion returns the newest available version of the given provider that satisfies its constraints.
+func (fetcher *providerFetcher) resolveVersion(ctx context.Context, requiredProvider *getproviders.RequiredProvider) (string, error) {
+	var constraints version.Constraints
+
+	if requiredProvider.Constraints != "" {
+		var err error
+
+		if constraints, err = version.NewConstraint(requiredProvider.Constraints); err != nil {
+			return "", errors.New(err)
+		}
+	}
+
+	versions, err := fetcher.providerHandlers.GetVersions(ctx, models.ParseProvider(requiredProvider.Address))
+	if err != nil {
+		return "", err
+	}
+
+	var newest *version.Version
+
+	for _, availableVersion := range versions {
+		ver, err := version.NewVersion(availableVersion.Version)
+		if err != nil || ver.Prerelease() != "" || !constraints.Check(ver) {
+			continue
+		}
+
+		if newest == nil || ver.GreaterThan(newest) {
+			newest = ver
+		}
+	}
+
+	if newest == nil {
+		return "", errors.New(NoMatchingVersionError{Provider: requiredProvider.Address, Constraints: requiredProvider.Constraints})
+	}
+
+	return newest.String(), nil
+}
+
+// fetch downloads, verifies and unpacks the given providers into `cacheDir` in parallel.
+func (fetcher *providerFetcher) fetch(ctx context.Context, cacheDir, userProviderDir string, providers models.Providers) (services.ProviderCaches, error) {
+	providerService := services.NewProviderService(cacheDir, userProviderDir, fetcher.credsSource, fetcher.logger)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	errGroup, ctx := errgroup.WithContext(ctx)
+	errGroup.Go(func() error {
+		return providerService.Run(ctx)
+	})
+
+	var (
+		requestID = uuid.New().String()
+		errs      = &errors.MultiError{}
+	)
+
+	for _, provider := range providers {
+		// The providers are requested once per platform, the errors are wrapped with the platform to tell them apart.
+		resp, err := fetcher.providerHandlers.GetPlatform(ctx, provider)
+		if err != nil {
+			errs = errs.Append(errors.New(FetchProviderError{Provider: fmt.Sprintf("%s (%s)", provider, provider.Platform()), Err: err}))
+			continue
+		}
+
+		if resp == nil {
+			errs = errs.Append(errors.New(ProviderNotFoundError(fmt.Sprintf("%s (%s)", provider, provider.Platform()))))
+			continue
+		}
+
+		provider.ResponseBody = resp
+		providerService.CacheProvider(ctx, requestID, provider)
+	}
+
+	if _, err := providerService.WaitForCacheReady(requestID); err != nil {
+		errs = errs.Append(err)
+	}
+
+	var caches services.ProviderCaches
+
+	for _, provider := range providers {
+		if cache := providerService.GetProviderCache(provider); cache != nil {
+			caches = append(caches, cache)
+		}
+	}
+
+	cancel()
+
+	if err := errGroup.Wait(); err != nil {
+		errs = errs.Append(err)
+	}
+
+	return caches, errs.ErrorOrNil()
+}
+
+// FetchPackage implements `getproviders.PackageFetcher`, it downloads the provider archive for the given platform and checks it against the checksum reported by the registry.
+func (fetcher *providerFetcher) FetchPackage(ctx context.Context, address, version, platform, dst string) error {
+	goos, goarch, ok := strings.Cut(platform, "_")
+	if !ok {
+		return errors.New(InvalidPlatformError(platform))
+	}
+
+	provider := models.ParseProvider(address)
+	provider.Version = version
+	provider.OS = goos
+	provider.Arch = goarch
+
+	resp, err := fetcher.providerHandlers.GetPlatform(ctx, provider)
+	if err != nil {
+		return err
+	}
+
+	if resp == nil || resp.DownloadURL == "" {
+		return errors.New(ProviderNotFoundError(fmt.Sprintf("%s (%s)", provider, platform)))
+	}
+
+	if util.FileExists(resp.DownloadURL) {
+		if err := util.CopyFile(resp.DownloadURL, dst); err != nil {
+			return err
+		}
+	} else {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, resp.DownloadURL, nil)
+		if err != nil {
+			return errors.New(err)
+		}
+
+		if fetcher.credsSource != nil {
+			if creds := fetcher.credsSource.ForHost(svchost.Hostname(req.URL.Hostname())); creds != nil {
+				creds.PrepareRequest(req)
+			}
+		}
+
+		if err := helpers.FetchToFile(ctx, req, dst); err != nil {
+			return err
+		}
+	}
+
+	if resp.SHA256Sum == "" {
+		return nil
+	}
+
+	hash, err := getproviders.PackageHashLegacyZipSHA(dst)
+	if err != nil {
+		return err
+	}
+
+	if expected := getproviders.HashSchemeZip.New(resp.SHA256Sum); hash != expected {
+		return errors.New(HashMismatchError{Provider: fmt.Sprintf("%s (%s)", provider, platform), Hash: hash})
+	}
+
+	return nil
+}
+
+// verifyLockedHashes checks that the fetched providers match the hashes recorded in the dependency lock files.
+// The `zh:` hashes cover all platforms and are checked strictly, the `h1:` hashes are usually recorded only for some platforms.
+func verifyLockedHashes(logger log.Logger, lockedProviders getproviders.LockedProviders, caches services.ProviderCaches) error {
+	for _, cache := range caches {
+		lockedProvider := lockedProviders.Find(cache.Address(), cache.Version())
+		if lockedProvider == nil || len(lockedProvider.Hashes) == 0 {
+			continue
+		}
+
+		if cache.SHA256Sum != "" && len(lockedProvider.HashesByScheme(getproviders.HashSchemeZip)) > 0 {
+			if hash := getproviders.HashSchemeZip.New(cache.SHA256Sum); !lockedProvider.HasHash(hash) {
+				return errors.New(HashMismatchError{Provider: cache.Provider.String(), Hash: hash})
+			}
+
+			continue
+		}
+
+		hash, err := getproviders.PackageHashV1(cache.PackageDir())
+		if err != nil {
+			return err
+		}
+
+		if !lockedProvider.HasHash(hash) {
+			logger.Warnf("Unable to verify %s (%s) against the lock file hashes", cache.Provider, cache.Platform())
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package providercache
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+)
+
+// RunMirror populates the filesystem mirror `mirrorDir` with the providers recorded in the dependency lock files,
+// so that they can be served in offline mode. The mirror has the unpacked layout, the same as the provider cache directory.
+func RunMirror(ctx context.Context, opts *Options, mirrorDir string) error {
+	if mirrorDir == "" {
+		return errors.New(MissingMirrorDirError{})
+	}
+
+	mirrorDir, err := filepath.Abs(mirrorDir)
+	if err != nil {
+		return errors.New(err)
+	}
+
+	lockedProviders, err := readLockfiles(opts.WorkingDir, opts.LockFiles)
+	if err != nil {
+		return err
+	}
+
+	if len(lockedProviders) == 0 {
+		opts.Logger.Warnf("No providers found in the lock files")
+		return nil
+	}
+
+	providers, err := providersForPlatforms(lockedProviders, opts.Platforms)
+	if err != nil {
+		return err
+	}
+
+	opts.Logger.Infof("Mirroring %d providers into %s", len(providers), mirrorDir)
+
+	fetcher, err := newProviderFetcher(opts.Logger)
+	if err != nil {
+		return err
+	}
+
+	// The user plugins directory is not used, since the mirror must be self-contained.
+	caches, err := fetcher.fetch(ctx, mirrorDir, "", providers)
+	if err != nil {
+		return err
+	}
+
+	return verifyLockedHashes(opts.Logger, lockedProviders, caches)
+}
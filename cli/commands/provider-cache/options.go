@@ -0,0 +1,19 @@
+package providercache
+
+import "github.com/gruntwork-io/terragrunt/options"
+
+type Options struct {
+	*options.TerragruntOptions
+
+	// LockFiles are the dependency lock files to read the providers from, by default, all lock files in the working directory.
+	LockFiles []string
+
+	// Platforms are the target platforms of the providers, by default, the current platform.
+	Platforms []string
+}
+
+func NewOptions(general *options.TerragruntOptions) *Options {
+	return &Options{
+		TerragruntOptions: general,
+	}
+}
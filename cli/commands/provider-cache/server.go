@@ -0,0 +1,155 @@
+package providercache
+
+import (
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/tf/cache"
+	"github.com/gruntwork-io/terragrunt/tf/cache/handlers"
+	"github.com/gruntwork-io/terragrunt/tf/cache/services"
+	"github.com/gruntwork-io/terragrunt/tf/cliconfig"
+	"github.com/gruntwork-io/terragrunt/tf/getproviders"
+	"github.com/gruntwork-io/terragrunt/util"
+)
+
+const (
+	// The status returned when making a request to the caching provider.
+	// It is needed to prevent further loading of providers by terraform, and at the same time make sure that the request was processed successfully.
+	CacheProviderHTTPStatusCode = http.StatusLocked
+
+	// Authentication type on the Terragrunt Provider Cache server.
+	APIKeyAuth = "x-api-key"
+)
+
+// Server is the Terragrunt Provider Cache server together with the CLI config and the provider service it was built from.
+type Server struct {
+	*cache.Server
+
+	CLIConfig       *cliconfig.Config
+	ProviderService *services.ProviderService
+
+	// PlatformHashes is set if `ProviderCacheLockPlatforms` is specified, to complete the lock files with the hashes for these platforms.
+	PlatformHashes *getproviders.PlatformHashes
+}
+
+// NewServer fills in the provider cache defaults in `opts`, such as the cache directory and the token, and builds a new cache server.
+func NewServer(opts *options.TerragruntOptions) (*Server, error) {
+	providerService, err := newProviderService(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return newServer(opts, providerService, cache.WithProviderService(providerService))
+}
+
+// newSharedServer builds a new cache server around the given provider service, which is shared with the previous servers
+// and run by the caller, so that the pending caching requests are kept when the server is rebuilt.
+func newSharedServer(opts *options.TerragruntOptions, providerService *services.ProviderService) (*Server, error) {
+	return newServer(opts, providerService, cache.WithSharedProviderService(providerService))
+}
+
+// newProviderService fills in the provider cache directory in `opts` and builds a new provider service. Its credentials
+// are set from the CLI config by `newServer`.
+func newProviderService(opts *options.TerragruntOptions) (*services.ProviderService, error) {
+	if err := prepareProviderCacheDir(opts); err != nil {
+		return nil, err
+	}
+
+	userProviderDir, err := cliconfig.UserProviderDir()
+	if err != nil {
+		return nil, err
+	}
+
+	providerService := services.NewProviderService(opts.ProviderCacheDir, userProviderDir, nil, opts.Logger)
+	providerService.SetOffline(opts.ProviderCacheOffline)
+
+	return providerService, nil
+}
+
+// newServer fills in the token in `opts`, loads the CLI config and builds a new cache server around the given provider service.
+func newServer(opts *options.TerragruntOptions, providerService *services.ProviderService, providerServiceOpt cache.Option) (*Server, error) {
+	if opts.ProviderCacheToken == "" {
+		opts.ProviderCacheToken = uuid.New().String()
+	}
+	// Currently, the cache server only supports the `x-api-key` token.
+	if !strings.HasPrefix(strings.ToLower(opts.ProviderCacheToken), APIKeyAuth+":") {
+		opts.ProviderCacheToken = fmt.Sprintf("%s:%s", APIKeyAuth, opts.ProviderCacheToken)
+	}
+
+	cliCfg, err := cliconfig.LoadUserConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	userProviderDir, err := cliconfig.UserProviderDir()
+	if err != nil {
+		return nil, err
+	}
+
+	providerService.SetCredentialsSource(cliCfg.CredentialsSource())
+	proxyProviderHandler := handlers.NewProxyProviderHandler(opts.Logger, cliCfg.CredentialsSource())
+
+	providerHandlers, err := handlers.NewProviderHandlers(cliCfg, opts.Logger, opts.ProviderCacheRegistryNames)
+	if err != nil {
+		return nil, errors.Errorf("creating provider handlers failed: %w", err)
+	}
+
+	if opts.ProviderCacheOffline {
+		providerHandlers = providerHandlers.Offline(opts.Logger, opts.ProviderCacheDir, userProviderDir)
+	}
+
+	server := cache.NewServer(
+		cache.WithHostname(opts.ProviderCacheHostname),
+		cache.WithPort(opts.ProviderCachePort),
+		cache.WithToken(opts.ProviderCacheToken),
+		providerServiceOpt,
+		cache.WithProviderHandlers(providerHandlers...),
+		cache.WithProxyProviderHandler(proxyProviderHandler),
+		cache.WithCacheProviderHTTPStatusCode(CacheProviderHTTPStatusCode),
+		cache.WithLogger(opts.Logger),
+	)
+
+	var platformHashes *getproviders.PlatformHashes
+
+	if len(opts.ProviderCacheLockPlatforms) > 0 {
+		fetcher := &providerFetcher{
+			logger:           opts.Logger,
+			credsSource:      cliCfg.CredentialsSource(),
+			providerHandlers: providerHandlers,
+		}
+		platformHashes = getproviders.NewPlatformHashes(fetcher, opts.ProviderCacheLockPlatforms...)
+	}
+
+	return &Server{
+		Server:          server,
+		CLIConfig:       cliCfg,
+		ProviderService: providerService,
+		PlatformHashes:  platformHashes,
+	}, nil
+}
+
+// prepareProviderCacheDir sets the default provider cache directory, if not specified, and makes it absolute.
+func prepareProviderCacheDir(opts *options.TerragruntOptions) error {
+	// ProviderCacheDir has the same file structure as terraform plugin_cache_dir.
+	// https://developer.hashicorp.com/terraform/cli/config/config-file#provider-plugin-cache
+	if opts.ProviderCacheDir == "" {
+		cacheDir, err := util.GetCacheDir()
+		if err != nil {
+			return err
+		}
+
+		opts.ProviderCacheDir = filepath.Join(cacheDir, "providers")
+	}
+
+	var err error
+	if opts.ProviderCacheDir, err = filepath.Abs(opts.ProviderCacheDir); err != nil {
+		return errors.New(err)
+	}
+
+	return nil
+}
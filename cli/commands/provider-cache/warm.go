@@ -0,0 +1,89 @@
+package providercache
+
+import (
+	"context"
+
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/tf/cliconfig"
+	"github.com/gruntwork-io/terragrunt/tf/getproviders"
+)
+
+const (
+	defaultRegistryName   = "registry.terraform.io"
+	defaultOtRegistryName = "registry.opentofu.org"
+)
+
+// RunWarm downloads all providers used in the working directory into the provider cache in one go, before running `run-all`.
+// The providers are taken from the dependency lock files and, for the modules without a lock file entry, resolved from the `required_providers` constraints.
+func RunWarm(ctx context.Context, opts *Options) error {
+	if err := prepareProviderCacheDir(opts.TerragruntOptions); err != nil {
+		return err
+	}
+
+	lockedProviders, err := readLockfiles(opts.WorkingDir, opts.LockFiles)
+	if err != nil {
+		return err
+	}
+
+	fetcher, err := newProviderFetcher(opts.Logger)
+	if err != nil {
+		return err
+	}
+
+	defaultRegistry := defaultRegistryName
+	if opts.TerraformImplementation == options.OpenTofuImpl {
+		defaultRegistry = defaultOtRegistryName
+	}
+
+	requiredProviders, err := getproviders.FindRequiredProviders(opts.WorkingDir, defaultRegistry)
+	if err != nil {
+		return err
+	}
+
+	// The same constraints are usually repeated across many modules, so each of them is resolved once.
+	resolved := make(map[getproviders.RequiredProvider]bool)
+
+	for _, requiredProvider := range requiredProviders {
+		if resolved[*requiredProvider] || lockedProviders.HasAddress(requiredProvider.Address) {
+			continue
+		}
+
+		resolved[*requiredProvider] = true
+
+		version, err := fetcher.resolveVersion(ctx, requiredProvider)
+		if err != nil {
+			return err
+		}
+
+		opts.Logger.Debugf("Resolved provider %s %q to version %s", requiredProvider.Address, requiredProvider.Constraints, version)
+
+		lockedProviders = lockedProviders.Merge(&getproviders.LockedProvider{
+			Address: requiredProvider.Address,
+			Version: version,
+		})
+	}
+
+	if len(lockedProviders) == 0 {
+		opts.Logger.Warnf("No providers found in %s", opts.WorkingDir)
+		return nil
+	}
+
+	providers, err := providersForPlatforms(lockedProviders, opts.Platforms)
+	if err != nil {
+		return err
+	}
+
+	userProviderDir, err := cliconfig.UserProviderDir()
+	if err != nil {
+		return err
+	}
+
+	opts.Logger.Infof("Warming up the provider cache %s with %d providers", opts.ProviderCacheDir, len(providers))
+
+	caches, err := fetcher.fetch(ctx, opts.ProviderCacheDir, userProviderDir, providers)
+	if err != nil {
+		return err
+	}
+
+	return verifyLockedHashes(opts.Logger, lockedProviders, caches)
+}
@@ -55,14 +55,14 @@ func runRenderJSON(ctx context.Context, opts *options.TerragruntOptions, cfg *co
 			return err
 		}
 
-		terragruntConfigCty = cty
+		terragruntConfigCty = cfg.RedactSensitiveValuesWithMetadata(cty)
 	} else {
 		cty, err := config.TerragruntConfigAsCty(cfg)
 		if err != nil {
 			return err
 		}
 
-		terragruntConfigCty = cty
+		terragruntConfigCty = cfg.RedactSensitiveValues(cty)
 	}
 
 	jsonBytes, err := marshalCtyValueJSONWithoutType(terragruntConfigCty)
@@ -3,6 +3,7 @@ package runall
 import (
 	"context"
 
+	"github.com/gruntwork-io/terragrunt/cli/commands/policy"
 	"github.com/gruntwork-io/terragrunt/configstack"
 	"github.com/gruntwork-io/terragrunt/internal/errors"
 	"github.com/gruntwork-io/terragrunt/options"
@@ -57,6 +58,12 @@ func RunAllOnStack(ctx context.Context, opts *options.TerragruntOptions, stack *
 		return err
 	}
 
+	if opts.PolicyDir != "" {
+		if err := policy.Preflight(ctx, opts, stack); err != nil {
+			return err
+		}
+	}
+
 	var prompt string
 
 	switch opts.TerraformCommand {
@@ -127,6 +127,10 @@ func runTerraform(ctx context.Context, terragruntOptions *options.TerragruntOpti
 
 	terragruntOptions.Errors = errConfig
 
+	if err := terragruntOptions.RemoveErrorSignals(); err != nil {
+		return target.runErrorCallback(terragruntOptions, terragruntConfig, err)
+	}
+
 	terragruntOptionsClone, err := terragruntOptions.CloneWithConfigPath(terragruntOptions.TerragruntConfigPath)
 	if err != nil {
 		return err
@@ -345,7 +349,11 @@ func runTerragruntWithConfig(ctx context.Context, originalTerragruntOptions *opt
 		return err
 	}
 
-	return RunActionWithHooks(ctx, "terraform", terragruntOptions, terragruntConfig, func(ctx context.Context) error {
+	if err := runPlanJSONHooksBeforeApply(ctx, terragruntOptions, terragruntConfig); err != nil {
+		return err
+	}
+
+	action := func(ctx context.Context) error {
 		runTerraformError := RunTerraformWithRetry(ctx, terragruntOptions)
 
 		var lockFileError error
@@ -361,7 +369,13 @@ func runTerragruntWithConfig(ctx context.Context, originalTerragruntOptions *opt
 		}
 
 		return multierror.Append(runTerraformError, lockFileError).ErrorOrNil()
-	})
+	}
+
+	if terragruntOptions.TerraformCommand == tf.CommandNamePlan && len(planJSONHooks(terragruntConfig)) > 0 {
+		action = preparePlanJSONHooks(terragruntOptions, action)
+	}
+
+	return RunActionWithHooks(ctx, "terraform", terragruntOptions, terragruntConfig, action)
 }
 
 // confirmActionWithDependentModules - Show warning with list of dependent modules from current module before destroy
@@ -76,7 +76,13 @@ func terragruntDebugFileContents(
 
 	jsonValuesByKey := make(map[string]interface{})
 
-	for varName, varValue := range terragruntConfig.Inputs {
+	// The sensitive values, such as the secrets returned by `get_secret`, are never written to the debug file.
+	inputs, err := terragruntConfig.RedactedInputs()
+	if err != nil {
+		return nil, err
+	}
+
+	for varName, varValue := range inputs {
 		nameAsEnvVar := fmt.Sprintf(tf.EnvNameTFVarFmt, varName)
 		_, varIsInEnv := envVars[nameAsEnvVar]
 		varIsDefined := util.ListContainsElement(moduleVariables, varName)
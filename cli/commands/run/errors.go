@@ -3,6 +3,7 @@ package run
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/gruntwork-io/terragrunt/options"
 )
@@ -66,3 +67,39 @@ type RunAllDisabledErr struct {
 func (err RunAllDisabledErr) Error() string {
 	return fmt.Sprintf("%s with run-all is disabled: %s", err.command, err.reason)
 }
+
+type HookTimeoutError struct {
+	HookName string
+	Timeout  time.Duration
+}
+
+func (err HookTimeoutError) Error() string {
+	return fmt.Sprintf("Hook %s timed out after %s", err.HookName, err.Timeout)
+}
+
+type InvalidHookOutputError struct {
+	Err        error
+	HookName   string
+	OutputFile string
+}
+
+func (err InvalidHookOutputError) Error() string {
+	return fmt.Sprintf("Hook %s wrote invalid JSON to its output file %s: %v", err.HookName, err.OutputFile, err.Err)
+}
+
+func (err InvalidHookOutputError) Unwrap() error {
+	return err.Err
+}
+
+type PlanHooksFailedError struct {
+	Err        error
+	WorkingDir string
+}
+
+func (err PlanHooksFailedError) Error() string {
+	return fmt.Sprintf("Not running apply in %s, the plan_json hooks failed: %v", err.WorkingDir, err.Err)
+}
+
+func (err PlanHooksFailedError) Unwrap() error {
+	return err.Err
+}
@@ -3,8 +3,10 @@ package run
 
 import (
 	"strconv"
+	"time"
 
 	"github.com/gruntwork-io/terragrunt/cli/flags"
+	"github.com/gruntwork-io/terragrunt/config"
 	"github.com/gruntwork-io/terragrunt/internal/cli"
 	"github.com/gruntwork-io/terragrunt/internal/errors"
 	"github.com/gruntwork-io/terragrunt/internal/strict/controls"
@@ -28,6 +30,18 @@ const (
 	UnitsThatIncludeFlagName               = "units-that-include"
 	DependencyFetchOutputFromStateFlagName = "dependency-fetch-output-from-state"
 	UsePartialParseConfigCacheFlagName     = "use-partial-parse-config-cache"
+	RunCmdCacheDirFlagName                 = "run-cmd-cache-dir"
+	RunCmdCacheRefreshFlagName             = "run-cmd-cache-refresh"
+	RunCmdCacheClearFlagName               = "run-cmd-cache-clear"
+	NoRunCmdFlagName                       = "no-run-cmd"
+	RunCmdPlaceholderFlagName              = "run-cmd-placeholder"
+	RunCmdAllowFlagName                    = "run-cmd-allow"
+	RunCmdTimeoutFlagName                  = "run-cmd-timeout"
+	RunCmdStripEnvFlagName                 = "run-cmd-strip-env"
+	RunCmdKeepEnvFlagName                  = "run-cmd-keep-env"
+	ProfileConfigFlagName                  = "profile-config"
+	ProfileConfigFileFlagName              = "profile-config-file"
+	ProfileConfigTopFlagName               = "profile-config-top"
 
 	BackendRequireBootstrapFlagName = "backend-require-bootstrap"
 	DisableBucketUpdateFlagName     = "disable-bucket-update"
@@ -61,12 +75,15 @@ const (
 
 	// Terragrunt Provider Cache related flags.
 
-	ProviderCacheFlagName              = "provider-cache"
-	ProviderCacheDirFlagName           = "provider-cache-dir"
-	ProviderCacheHostnameFlagName      = "provider-cache-hostname"
-	ProviderCachePortFlagName          = "provider-cache-port"
-	ProviderCacheTokenFlagName         = "provider-cache-token"
-	ProviderCacheRegistryNamesFlagName = "provider-cache-registry-names"
+	ProviderCacheFlagName               = "provider-cache"
+	ProviderCacheDirFlagName            = "provider-cache-dir"
+	ProviderCacheHostnameFlagName       = "provider-cache-hostname"
+	ProviderCachePortFlagName           = "provider-cache-port"
+	ProviderCacheTokenFlagName          = "provider-cache-token"
+	ProviderCacheRegistryNamesFlagName  = "provider-cache-registry-names"
+	ProviderCacheExternalServerFlagName = "provider-cache-external-server"
+	ProviderCacheOfflineFlagName        = "provider-cache-offline"
+	ProviderCacheLockPlatformsFlagName  = "provider-cache-lock-platforms"
 
 	// Engine related environment variables.
 
@@ -346,6 +363,105 @@ func NewFlags(opts *options.TerragruntOptions, prefix flags.Prefix) cli.Flags {
 		},
 			flags.WithDeprecatedNames(terragruntPrefix.FlagNames(DeprecatedUsePartialParseConfigCacheFlagName), terragruntPrefixControl)),
 
+		flags.NewFlag(&cli.GenericFlag[string]{
+			Name:        RunCmdCacheDirFlagName,
+			EnvVars:     tgPrefix.EnvVars(RunCmdCacheDirFlagName),
+			Destination: &opts.RunCmdCacheDir,
+			Usage:       "The path to the directory of the run_cmd results cached on disk. By default, 'terragrunt/run-cmd' folder in the user cache directory.",
+		}),
+
+		flags.NewFlag(&cli.BoolFlag{
+			Name:        RunCmdCacheRefreshFlagName,
+			EnvVars:     tgPrefix.EnvVars(RunCmdCacheRefreshFlagName),
+			Destination: &opts.RunCmdCacheRefresh,
+			Usage:       "Ignores the run_cmd results cached on disk, running the commands again and caching their new results.",
+		}),
+
+		flags.NewFlag(&cli.BoolFlag{
+			Name:    RunCmdCacheClearFlagName,
+			EnvVars: tgPrefix.EnvVars(RunCmdCacheClearFlagName),
+			Usage:   "Removes all the run_cmd results cached on disk before running the command.",
+			Action: func(ctx *cli.Context, value bool) error {
+				if !value {
+					return nil
+				}
+
+				return config.ClearRunCmdCache(ctx, opts)
+			},
+		}),
+
+		flags.NewFlag(&cli.BoolFlag{
+			Name:        NoRunCmdFlagName,
+			EnvVars:     tgPrefix.EnvVars(NoRunCmdFlagName),
+			Destination: &opts.NoRunCmd,
+			Usage:       "Do not run the commands of run_cmd, which returns the values of --run-cmd-placeholder instead.",
+		}),
+
+		flags.NewFlag(&cli.MapFlag[string, string]{
+			Name:        RunCmdPlaceholderFlagName,
+			EnvVars:     tgPrefix.EnvVars(RunCmdPlaceholderFlagName),
+			Destination: &opts.RunCmdPlaceholders,
+			Usage:       "The value returned by run_cmd for the command with --no-run-cmd, as command=value. The '*' command sets the value for the other commands.",
+		}),
+
+		flags.NewFlag(&cli.SliceFlag[string]{
+			Name:        RunCmdAllowFlagName,
+			EnvVars:     tgPrefix.EnvVars(RunCmdAllowFlagName),
+			Destination: &opts.RunCmdAllow,
+			Usage:       "The executables that run_cmd is allowed to run, as names, paths or glob patterns. By default, all the executables are allowed.",
+		}),
+
+		flags.NewFlag(&cli.GenericFlag[string]{
+			Name:    RunCmdTimeoutFlagName,
+			EnvVars: tgPrefix.EnvVars(RunCmdTimeoutFlagName),
+			Usage:   "The maximum duration of each command run by run_cmd, such as 30s. By default, the commands have no timeout.",
+			Action: func(_ *cli.Context, value string) error {
+				timeout, err := time.ParseDuration(value)
+				if err != nil || timeout <= 0 {
+					return errors.Errorf("invalid value %q for --%s, it must be a positive duration, such as 30s", value, RunCmdTimeoutFlagName)
+				}
+
+				opts.RunCmdTimeout = timeout
+
+				return nil
+			},
+		}),
+
+		flags.NewFlag(&cli.BoolFlag{
+			Name:        RunCmdStripEnvFlagName,
+			EnvVars:     tgPrefix.EnvVars(RunCmdStripEnvFlagName),
+			Destination: &opts.RunCmdStripEnv,
+			Usage:       "Run the commands of run_cmd with only the base environment variables, such as PATH and HOME, and the variables of --run-cmd-keep-env.",
+		}),
+
+		flags.NewFlag(&cli.SliceFlag[string]{
+			Name:        RunCmdKeepEnvFlagName,
+			EnvVars:     tgPrefix.EnvVars(RunCmdKeepEnvFlagName),
+			Destination: &opts.RunCmdKeepEnv,
+			Usage:       "The environment variables kept for the commands of run_cmd with --run-cmd-strip-env.",
+		}),
+
+		flags.NewFlag(&cli.BoolFlag{
+			Name:        ProfileConfigFlagName,
+			EnvVars:     tgPrefix.EnvVars(ProfileConfigFlagName),
+			Destination: &opts.ProfileConfig,
+			Usage:       "Record the time spent evaluating the configs per file, include, function call and dependency output fetch, written as a flamegraph profile along with a summary.",
+		}),
+
+		flags.NewFlag(&cli.GenericFlag[string]{
+			Name:        ProfileConfigFileFlagName,
+			EnvVars:     tgPrefix.EnvVars(ProfileConfigFileFlagName),
+			Destination: &opts.ProfileConfigFile,
+			Usage:       "The path of the file the --profile-config profile is written to, in the folded stacks format of flamegraph tools.",
+		}),
+
+		flags.NewFlag(&cli.GenericFlag[int]{
+			Name:        ProfileConfigTopFlagName,
+			EnvVars:     tgPrefix.EnvVars(ProfileConfigTopFlagName),
+			Destination: &opts.ProfileConfigTop,
+			Usage:       "The number of entries with the most time spent in the --profile-config summary.",
+		}),
+
 		flags.NewFlag(&cli.BoolFlag{
 			Name:        DependencyFetchOutputFromStateFlagName,
 			EnvVars:     tgPrefix.EnvVars(DependencyFetchOutputFromStateFlagName),
@@ -477,6 +593,27 @@ func NewFlags(opts *options.TerragruntOptions, prefix flags.Prefix) cli.Flags {
 		},
 			flags.WithDeprecatedNames(terragruntPrefix.FlagNames(DeprecatedProviderCacheRegistryNamesFlagName), terragruntPrefixControl)),
 
+		flags.NewFlag(&cli.BoolFlag{
+			Name:        ProviderCacheExternalServerFlagName,
+			EnvVars:     tgPrefix.EnvVars(ProviderCacheExternalServerFlagName),
+			Destination: &opts.ProviderCacheExternalServer,
+			Usage:       "Use the Terragrunt Provider Cache server started by 'terragrunt provider-cache serve' at the given hostname, port and token instead of starting a new one.",
+		}),
+
+		flags.NewFlag(&cli.BoolFlag{
+			Name:        ProviderCacheOfflineFlagName,
+			EnvVars:     tgPrefix.EnvVars(ProviderCacheOfflineFlagName),
+			Destination: &opts.ProviderCacheOffline,
+			Usage:       "Serve only the providers that are already cached or available in filesystem mirrors, without reaching out to the registries.",
+		}),
+
+		flags.NewFlag(&cli.SliceFlag[string]{
+			Name:        ProviderCacheLockPlatformsFlagName,
+			EnvVars:     tgPrefix.EnvVars(ProviderCacheLockPlatformsFlagName),
+			Destination: &opts.ProviderCacheLockPlatforms,
+			Usage:       "The list of platforms, e.g. 'linux_amd64', whose hashes are added to the dependency lock files by the Terragrunt Provider Cache.",
+		}),
+
 		flags.NewFlag(&cli.GenericFlag[string]{
 			Name:        AuthProviderCmdFlagName,
 			EnvVars:     tgPrefix.EnvVars(AuthProviderCmdFlagName),
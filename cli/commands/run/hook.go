@@ -2,7 +2,10 @@ package run
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
 	"sync"
 
 	"github.com/gruntwork-io/terragrunt/config"
@@ -20,6 +23,9 @@ const (
 	HookCtxTFPathEnvName   = "TG_CTX_TF_PATH"
 	HookCtxCommandEnvName  = "TG_CTX_COMMAND"
 	HookCtxHookNameEnvName = "TG_CTX_HOOK_NAME"
+
+	HookCtxErrorSignalsFileEnvName = "TG_CTX_ERROR_SIGNALS_FILE"
+	HookCtxHookOutputsEnvName      = "TG_CTX_HOOK_OUTPUTS"
 )
 
 func processErrorHooks(ctx context.Context, hooks []config.ErrorHook, terragruntOptions *options.TerragruntOptions, previousExecErrors *errors.MultiError) error {
@@ -70,16 +76,9 @@ func processErrorHooks(ctx context.Context, hooks []config.ErrorHook, terragrunt
 
 			actionToExecute := curHook.Execute[0]
 			actionParams := curHook.Execute[1:]
-			terragruntOptions = terragruntOptionsWithHookEnvs(terragruntOptions, curHook.Name)
-
-			_, possibleError := shell.RunCommandWithOutput(
-				ctx,
-				terragruntOptions,
-				workingDir,
-				suppressStdout,
-				false,
-				actionToExecute, actionParams...,
-			)
+			hookOpts := terragruntOptionsWithHookEnvs(terragruntOptions, curHook.Name, curHook.GetEnv())
+
+			possibleError := runErrorHookCommand(ctx, hookOpts, curHook, workingDir, suppressStdout, actionToExecute, actionParams...)
 			if possibleError != nil {
 				terragruntOptions.Logger.Errorf("Error running hook %s with message: %s", curHook.Name, possibleError.Error())
 				errorsOccured = multierror.Append(errorsOccured, possibleError)
@@ -90,6 +89,24 @@ func processErrorHooks(ctx context.Context, hooks []config.ErrorHook, terragrunt
 	return errorsOccured.ErrorOrNil()
 }
 
+// runErrorHookCommand runs the command of the error hook, within the hook timeout if it is set.
+func runErrorHookCommand(ctx context.Context, opts *options.TerragruntOptions, hook config.ErrorHook, workingDir string, suppressStdout bool, command string, args ...string) error {
+	if timeout := hook.GetTimeout(); timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	_, err := shell.RunCommandWithOutput(ctx, opts, workingDir, suppressStdout, false, command, args...)
+
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return errors.New(HookTimeoutError{HookName: hook.Name, Timeout: hook.GetTimeout()})
+	}
+
+	return err
+}
+
 func processHooks(
 	ctx context.Context,
 	hooks []config.Hook,
@@ -154,9 +171,24 @@ func runHook(ctx context.Context, terragruntOptions *options.TerragruntOptions,
 		suppressStdout = true
 	}
 
+	outputFile := hookOutputFile(terragruntOptions, curHook, workingDir)
+	if outputFile != "" {
+		// Remove the output of the previous run, so that a stale result is never recorded.
+		if err := os.Remove(outputFile); err != nil && !os.IsNotExist(err) {
+			return errors.New(err)
+		}
+	}
+
+	if timeout := curHook.GetTimeout(); timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
 	actionToExecute := curHook.Execute[0]
 	actionParams := curHook.Execute[1:]
-	terragruntOptions = terragruntOptionsWithHookEnvs(terragruntOptions, curHook.Name)
+	terragruntOptions = terragruntOptionsWithHookEnvs(terragruntOptions, curHook.Name, curHook.GetEnv())
 
 	if actionToExecute == "tflint" {
 		if err := executeTFLint(ctx, terragruntOptions, terragruntConfig, curHook, workingDir); err != nil {
@@ -171,12 +203,59 @@ func runHook(ctx context.Context, terragruntOptions *options.TerragruntOptions,
 			false,
 			actionToExecute, actionParams...,
 		)
+		// The command may exit successfully once interrupted, still the hook did not complete within its timeout.
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			possibleError = errors.New(HookTimeoutError{HookName: curHook.Name, Timeout: curHook.GetTimeout()})
+		}
+
 		if possibleError != nil {
 			terragruntOptions.Logger.Errorf("Error running hook %s with message: %s", curHook.Name, possibleError.Error())
+
 			return possibleError
 		}
 	}
 
+	if outputFile != "" {
+		return recordHookOutput(terragruntOptions, curHook.Name, outputFile)
+	}
+
+	return nil
+}
+
+// hookOutputFile returns the absolute path of the hook `output_file`, relative paths are resolved from the hook working dir.
+func hookOutputFile(opts *options.TerragruntOptions, hook config.Hook, workingDir string) string {
+	if hook.OutputFile == nil || *hook.OutputFile == "" {
+		return ""
+	}
+
+	if workingDir == "" {
+		workingDir = opts.WorkingDir
+	}
+
+	return util.JoinPath(workingDir, *hook.OutputFile)
+}
+
+// recordHookOutput reads the JSON value the hook wrote to its output file and records it in the hook outputs of the unit,
+// for the hooks that run after it and the run-all summary.
+func recordHookOutput(opts *options.TerragruntOptions, hookName, outputFile string) error {
+	content, err := os.ReadFile(outputFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			opts.Logger.Warnf("Hook %s did not write its output file %s", hookName, outputFile)
+			return nil
+		}
+
+		return errors.New(err)
+	}
+
+	var output any
+
+	if err := json.Unmarshal(content, &output); err != nil {
+		return errors.New(InvalidHookOutputError{HookName: hookName, OutputFile: outputFile, Err: err})
+	}
+
+	opts.AddHookOutput(filepath.Dir(opts.TerragruntConfigPath), hookName, output)
+
 	return nil
 }
 
@@ -196,12 +275,27 @@ func executeTFLint(ctx context.Context, terragruntOptions *options.TerragruntOpt
 	return nil
 }
 
-func terragruntOptionsWithHookEnvs(opts *options.TerragruntOptions, hookName string) *options.TerragruntOptions {
+func terragruntOptionsWithHookEnvs(opts *options.TerragruntOptions, hookName string, hookEnv map[string]string) *options.TerragruntOptions {
 	newOpts := *opts
 	newOpts.Env = cloner.Clone(opts.Env)
+
+	for key, value := range hookEnv {
+		newOpts.Env[key] = value
+	}
+
 	newOpts.Env[HookCtxTFPathEnvName] = opts.TerraformPath
 	newOpts.Env[HookCtxCommandEnvName] = opts.TerraformCommand
 	newOpts.Env[HookCtxHookNameEnvName] = hookName
 
+	if signalsFile := opts.ErrorSignalsFile(); util.FileExists(signalsFile) {
+		newOpts.Env[HookCtxErrorSignalsFileEnvName] = signalsFile
+	}
+
+	if outputs := opts.UnitHookOutputs(filepath.Dir(opts.TerragruntConfigPath)); len(outputs) > 0 {
+		if outputsJSON, err := json.Marshal(outputs); err == nil {
+			newOpts.Env[HookCtxHookOutputsEnvName] = string(outputsJSON)
+		}
+	}
+
 	return &newOpts
 }
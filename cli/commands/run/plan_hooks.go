@@ -0,0 +1,206 @@
+package run
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/gruntwork-io/terragrunt/config"
+	"github.com/gruntwork-io/terragrunt/internal/cloner"
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/tf"
+	"github.com/gruntwork-io/terragrunt/util"
+)
+
+const (
+	HookCtxPlanJSONFileEnvName = "TG_CTX_PLAN_JSON_FILE"
+
+	planOutFlagName   = "-out"
+	planOutFlagPrefix = planOutFlagName + "="
+
+	planJSONFilePerms = 0600
+)
+
+var (
+	// planningFlagNames are the flags of `apply` used to create the plan, which cannot be set when a saved plan is applied.
+	planningFlagNames = []string{"-var", "-var-file", "-target", "-replace", tf.FlagNameDestroy, "-refresh", "-refresh-only"}
+
+	// planningFlagNamesWithValue are the planning flags whose value can be passed as the next arg, such as `-var foo=bar`.
+	planningFlagNamesWithValue = []string{"-var", "-var-file", "-target", "-replace"}
+)
+
+// planJSONHooks returns the after hooks of the `plan` command that have `plan_json` enabled.
+func planJSONHooks(terragruntConfig *config.TerragruntConfig) []config.Hook {
+	var hooks []config.Hook
+
+	for _, hook := range terragruntConfig.Terraform.GetAfterHooks() {
+		if hook.IsPlanJSON() && util.ListContainsElement(hook.Commands, tf.CommandNamePlan) {
+			hooks = append(hooks, hook)
+		}
+	}
+
+	return hooks
+}
+
+// preparePlanJSONHooks makes sure the plan is saved when `plan_json` hooks run after `plan`, and returns the action that runs `plan`
+// and then converts the saved plan to JSON, whose path is passed to the hooks in the `TG_CTX_PLAN_JSON_FILE` env var.
+func preparePlanJSONHooks(terragruntOptions *options.TerragruntOptions, action func(ctx context.Context) error) func(ctx context.Context) error {
+	planFile := planFileFromArgs(terragruntOptions)
+	if planFile == "" {
+		planFile = filepath.Join(terragruntOptions.WorkingDir, tf.TerraformPlanFile)
+		terragruntOptions.AppendTerraformCliArgs(planOutFlagPrefix + planFile)
+	}
+
+	return func(ctx context.Context) error {
+		if err := action(ctx); err != nil {
+			return err
+		}
+
+		planJSONFile, err := showPlanJSON(ctx, terragruntOptions, planFile)
+		if err != nil {
+			return err
+		}
+
+		// The hooks are run with the env of the unit, the plan JSON file is only relevant to the hooks of this command. The env is
+		// cloned before, since its map may be shared with the options of other units.
+		env := cloner.Clone(terragruntOptions.Env)
+		env[HookCtxPlanJSONFileEnvName] = planJSONFile
+		terragruntOptions.Env = env
+
+		return nil
+	}
+}
+
+// runPlanJSONHooksBeforeApply gates `apply` on the `plan_json` hooks: the plan is saved and converted to JSON, and the hooks are run
+// against it. If any of them fails, `apply` is not run, otherwise the args of `apply` are replaced to apply the checked plan,
+// so that what is applied is exactly what was checked. Applying a saved plan given by the user is not gated, since the plan
+// was already checked by `plan`.
+func runPlanJSONHooksBeforeApply(ctx context.Context, terragruntOptions *options.TerragruntOptions, terragruntConfig *config.TerragruntConfig) error {
+	hooks := planJSONHooks(terragruntConfig)
+	if len(hooks) == 0 || terragruntOptions.TerraformCommand != tf.CommandNameApply || util.IsFile(terragruntOptions.TerraformCliArgs.Last()) {
+		return nil
+	}
+
+	terragruntOptions.Logger.Infof("Running plan to check it with the plan_json hooks before apply")
+
+	// The plan is run with the same args as apply, such as `-var` and `-target`, so that the hooks check what is going to be applied.
+	planOptions := terragruntOptions.Clone()
+	planOptions.TerraformCommand = tf.CommandNamePlan
+	planOptions.TerraformCliArgs = []string{tf.CommandNamePlan}
+
+	for _, arg := range terragruntOptions.TerraformCliArgs[1:] {
+		if normalizeFlagName(arg) != tf.FlagNameAutoApprove {
+			planOptions.TerraformCliArgs = append(planOptions.TerraformCliArgs, arg)
+		}
+	}
+
+	planFile := filepath.Join(planOptions.WorkingDir, tf.TerraformPlanFile)
+	planOptions.AppendTerraformCliArgs(planOutFlagPrefix + planFile)
+
+	if _, err := tf.RunCommandWithOutput(ctx, planOptions, planOptions.TerraformCliArgs...); err != nil {
+		return err
+	}
+
+	planJSONFile, err := showPlanJSON(ctx, planOptions, planFile)
+	if err != nil {
+		return err
+	}
+
+	// The env of the cloned options is a copy, the plan JSON file does not leak to the env of `apply`.
+	planOptions.Env[HookCtxPlanJSONFileEnvName] = planJSONFile
+
+	if err := processHooks(ctx, hooks, planOptions, terragruntConfig, nil); err != nil {
+		return errors.New(PlanHooksFailedError{Err: err, WorkingDir: terragruntOptions.WorkingDir})
+	}
+
+	terragruntOptions.Logger.Debugf("Applying the plan checked by the plan_json hooks %s", planFile)
+
+	terragruntOptions.TerraformCliArgs = savedPlanApplyArgs(terragruntOptions.TerraformCliArgs, planFile)
+
+	return nil
+}
+
+// savedPlanApplyArgs returns the args of `apply` to apply the saved plan, without the planning flags, which were passed to `plan`.
+func savedPlanApplyArgs(args []string, planFile string) []string {
+	applyArgs := []string{args[0]}
+
+	for i := 1; i < len(args); i++ {
+		name, _, hasValue := strings.Cut(normalizeFlagName(args[i]), "=")
+
+		if !slices.Contains(planningFlagNames, name) {
+			applyArgs = append(applyArgs, args[i])
+			continue
+		}
+
+		if !hasValue && slices.Contains(planningFlagNamesWithValue, name) {
+			// Skip the value of the flag too.
+			i++
+		}
+	}
+
+	return append(applyArgs, planFile)
+}
+
+// showPlanJSON runs `show -json` on the saved plan and writes its output next to the plan file.
+func showPlanJSON(ctx context.Context, terragruntOptions *options.TerragruntOptions, planFile string) (string, error) {
+	showOptions := terragruntOptions.Clone()
+	showOptions.ForwardTFStdout = true
+	showOptions.Writer = io.Discard
+	showOptions.TerraformCommand = tf.CommandNameShow
+
+	output, err := tf.RunCommandWithOutput(ctx, showOptions, tf.CommandNameShow, "-json", planFile)
+	if err != nil {
+		return "", err
+	}
+
+	planJSONFile := filepath.Join(filepath.Dir(planFile), tf.TerraformPlanJSONFile)
+
+	// The plan may contain sensitive values, it is only readable by the owner.
+	if err := os.WriteFile(planJSONFile, output.Stdout.Bytes(), planJSONFilePerms); err != nil {
+		return "", errors.New(err)
+	}
+
+	terragruntOptions.Logger.Debugf("Saved plan JSON to %s", planJSONFile)
+
+	return planJSONFile, nil
+}
+
+// planFileFromArgs returns the plan file given in the `-out` flag, resolved from the working dir.
+func planFileFromArgs(terragruntOptions *options.TerragruntOptions) string {
+	args := terragruntOptions.TerraformCliArgs
+
+	for i, arg := range args {
+		var planFile string
+
+		// Both `-out` and `--out` are accepted, with the value given as `-out=file` or `-out file`.
+		switch arg = normalizeFlagName(arg); {
+		case strings.HasPrefix(arg, planOutFlagPrefix):
+			planFile = strings.TrimPrefix(arg, planOutFlagPrefix)
+		case arg == planOutFlagName && i+1 < len(args):
+			planFile = args[i+1]
+		default:
+			continue
+		}
+
+		if !filepath.IsAbs(planFile) {
+			planFile = filepath.Join(terragruntOptions.WorkingDir, planFile)
+		}
+
+		return planFile
+	}
+
+	return ""
+}
+
+// normalizeFlagName returns the flag with a single dash, such as `-out=file` for `--out=file`, other args are returned as is.
+func normalizeFlagName(arg string) string {
+	if !strings.HasPrefix(arg, "-") {
+		return arg
+	}
+
+	return "-" + strings.TrimLeft(arg, "-")
+}
@@ -79,6 +79,11 @@ func processStackFile(ctx context.Context, opts *options.TerragruntOptions, stac
 			}
 		}
 
+		// check the unit values against the values schema of the unit, before generating them
+		if err := config.CheckUnitValues(opts, unit, dest); err != nil {
+			return errors.New(err)
+		}
+
 		// generate unit values file
 		if err := config.WriteUnitValues(opts, unit, dest); err != nil {
 			return errors.New(err)
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/zclconf/go-cty/cty"
+
 	"github.com/gruntwork-io/terragrunt/internal/errors"
 
 	"github.com/gruntwork-io/terragrunt/cli/commands/run"
@@ -28,7 +30,7 @@ type TerragruntInfoGroup struct {
 	WorkingDir       string `json:"WorkingDir"`
 }
 
-func printTerragruntInfo(opts *options.TerragruntOptions) error {
+func printTerragruntInfo(opts *options.TerragruntOptions, cfg *config.TerragruntConfig) error {
 	group := TerragruntInfoGroup{
 		ConfigPath:       opts.TerragruntConfigPath,
 		DownloadDir:      opts.DownloadDir,
@@ -38,6 +40,10 @@ func printTerragruntInfo(opts *options.TerragruntOptions) error {
 		WorkingDir:       opts.WorkingDir,
 	}
 
+	if cfg != nil && cfg.IsSensitive(cty.GetAttrPath(config.MetadataIamRole)) {
+		group.IamRole = config.RedactedValue
+	}
+
 	b, err := json.MarshalIndent(group, "", "  ")
 	if err != nil {
 		opts.Logger.Errorf("JSON error marshalling terragrunt-info")
@@ -53,13 +59,13 @@ func printTerragruntInfo(opts *options.TerragruntOptions) error {
 }
 
 func runTerragruntInfo(ctx context.Context, opts *options.TerragruntOptions, cfg *config.TerragruntConfig) error {
-	return printTerragruntInfo(opts)
+	return printTerragruntInfo(opts, cfg)
 }
 
 func runErrorTerragruntInfo(opts *options.TerragruntOptions, cfg *config.TerragruntConfig, err error) error {
 	opts.Logger.Debugf("Fetching terragrunt-info: %v", err)
 
-	if err := printTerragruntInfo(opts); err != nil {
+	if err := printTerragruntInfo(opts, cfg); err != nil {
 		opts.Logger.Errorf("Error printing terragrunt-info: %v", err)
 	}
 
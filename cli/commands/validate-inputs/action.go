@@ -1,6 +1,6 @@
 // `validate-inputs` command collects all the terraform variables defined in the target module, and the terragrunt
 // inputs that are configured, and compare the two to determine if there are any unused inputs or undefined required
-// inputs.
+// inputs. The values of the `inputs` are also checked against the type constraints and the validations of the variables.
 
 package validateinputs
 
@@ -60,6 +60,17 @@ func runValidateInputs(ctx context.Context, opts *options.TerragruntOptions, cfg
 		}
 	}
 
+	// Invalid inputs are those that do not match the type constraint or the validations of their variable.
+	variables, err := config.ParseVariables(opts, opts.WorkingDir)
+	if err != nil {
+		return err
+	}
+
+	invalidInputs, err := config.CheckInputs(cfg.Inputs, variables)
+	if err != nil {
+		return err
+	}
+
 	// Now print out all the information
 	if len(unusedVars) > 0 {
 		opts.Logger.Warn("The following inputs passed in by terragrunt are unused:\n")
@@ -87,15 +98,38 @@ func runValidateInputs(ctx context.Context, opts *options.TerragruntOptions, cfg
 		opts.Logger.Debug(fmt.Sprintf("Strict mode enabled: %t", opts.ValidateStrict))
 	}
 
+	if len(invalidInputs) > 0 {
+		opts.Logger.Error("The following inputs do not match their variable:\n")
+
+		for _, err := range invalidInputs {
+			opts.Logger.Errorf("\t- %s", err)
+		}
+
+		opts.Logger.Error("")
+	} else {
+		opts.Logger.Info("All inputs passed in by terragrunt match the type and the validations of their variable")
+	}
+
 	// Return an error when there are misaligned inputs. Terragrunt strict mode defaults to false. When it is false,
-	// an error will only be returned if required inputs are missing. When strict mode is true, an error will be
-	// returned if required inputs are missing OR if any unused variables are passed
+	// an error will only be returned if required inputs are missing or if inputs do not match their variable. When
+	// strict mode is true, an error will also be returned if any unused variables are passed. All the problems found
+	// are reported in the same error.
+	var problems []string
+
+	if len(invalidInputs) > 0 {
+		problems = append(problems, fmt.Sprintf("%d input(s) that do not match their variable", len(invalidInputs)))
+	}
+
 	if len(missingVars) > 0 || len(unusedVars) > 0 && opts.ValidateStrict {
-		return fmt.Errorf("terragrunt configuration has misaligned inputs. Strict mode enabled: %t", opts.ValidateStrict)
+		problems = append(problems, fmt.Sprintf("misaligned inputs. Strict mode enabled: %t", opts.ValidateStrict))
 	} else if len(unusedVars) > 0 {
 		opts.Logger.Warn("Terragrunt configuration has misaligned inputs, but running in relaxed mode so ignoring.")
 	}
 
+	if len(problems) > 0 {
+		return fmt.Errorf("terragrunt configuration has %s", strings.Join(problems, " and "))
+	}
+
 	return nil
 }
 
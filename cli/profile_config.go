@@ -0,0 +1,54 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/gruntwork-io/terragrunt/internal/cli"
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/telemetry"
+	"github.com/gruntwork-io/terragrunt/util"
+)
+
+// runActionWithConfigProfile runs the action with the telemetry spans recorded by a profiler, then writes the profile
+// to the --profile-config-file, and its summary to stderr.
+func runActionWithConfigProfile(ctx *cli.Context, opts *options.TerragruntOptions, action cli.ActionFunc) error {
+	profiler := telemetry.NewProfiler(opts.WorkingDir)
+	ctx.Context = telemetry.ContextWithProfiler(ctx.Context, profiler) //nolint:fatcontext
+
+	actionErr := runAction(ctx, opts, action)
+
+	if err := writeConfigProfile(opts, profiler); err != nil {
+		if actionErr != nil {
+			opts.Logger.Errorf("Failed to write the config profile: %v", err)
+
+			return actionErr
+		}
+
+		return err
+	}
+
+	return actionErr
+}
+
+func writeConfigProfile(opts *options.TerragruntOptions, profiler *telemetry.Profiler) error {
+	profilePath := opts.ProfileConfigFile
+	if !filepath.IsAbs(profilePath) {
+		profilePath = util.JoinPath(opts.WorkingDir, profilePath)
+	}
+
+	file, err := os.Create(profilePath)
+	if err != nil {
+		return errors.New(err)
+	}
+	defer file.Close()
+
+	if err := profiler.WriteFolded(file); err != nil {
+		return err
+	}
+
+	opts.Logger.Infof("Config profile written to %s, the %d spans with the most time spent are:", profilePath, opts.ProfileConfigTop)
+
+	return profiler.WriteSummary(opts.ErrWriter, opts.ProfileConfigTop)
+}
@@ -1,24 +1,29 @@
 package cli
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"os"
+	"path"
 	"path/filepath"
 	"regexp"
 	"strconv"
 	"strings"
 
 	"github.com/google/uuid"
+	providercache "github.com/gruntwork-io/terragrunt/cli/commands/provider-cache"
+	runCmd "github.com/gruntwork-io/terragrunt/cli/commands/run"
 	"github.com/gruntwork-io/terragrunt/internal/cli"
 	"github.com/gruntwork-io/terragrunt/internal/errors"
 	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
 	"github.com/gruntwork-io/terragrunt/tf"
-	"github.com/gruntwork-io/terragrunt/tf/cache"
-	"github.com/gruntwork-io/terragrunt/tf/cache/handlers"
-	"github.com/gruntwork-io/terragrunt/tf/cache/services"
+	"github.com/gruntwork-io/terragrunt/tf/cache/helpers"
+	"github.com/gruntwork-io/terragrunt/tf/cache/models"
 	"github.com/gruntwork-io/terragrunt/tf/cliconfig"
 	"github.com/gruntwork-io/terragrunt/tf/getproviders"
 	"github.com/gruntwork-io/terragrunt/util"
@@ -30,10 +35,10 @@ const (
 
 	// The status returned when making a request to the caching provider.
 	// It is needed to prevent further loading of providers by terraform, and at the same time make sure that the request was processed successfully.
-	CacheProviderHTTPStatusCode = http.StatusLocked
+	CacheProviderHTTPStatusCode = providercache.CacheProviderHTTPStatusCode
 
 	// Authentication type on the Terragrunt Provider Cache server.
-	APIKeyAuth = "x-api-key"
+	APIKeyAuth = providercache.APIKeyAuth
 )
 
 var (
@@ -55,70 +60,27 @@ var (
 )
 
 type ProviderCache struct {
-	*cache.Server
-	cliCfg          *cliconfig.Config
-	providerService *services.ProviderService
+	*providercache.Server
 }
 
+// InitProviderCacheServer builds the provider cache server. If `ProviderCacheExternalServer` is set, the returned server is not meant to be run,
+// it only provides the URLs of the server that was already started by `terragrunt provider-cache serve` with the same hostname, port and token.
 func InitProviderCacheServer(opts *options.TerragruntOptions) (*ProviderCache, error) {
-	// ProviderCacheDir has the same file structure as terraform plugin_cache_dir.
-	// https://developer.hashicorp.com/terraform/cli/config/config-file#provider-plugin-cache
-	if opts.ProviderCacheDir == "" {
-		cacheDir, err := util.GetCacheDir()
-		if err != nil {
-			return nil, err
-		}
-
-		opts.ProviderCacheDir = filepath.Join(cacheDir, "providers")
-	}
-
-	var err error
-	if opts.ProviderCacheDir, err = filepath.Abs(opts.ProviderCacheDir); err != nil {
-		return nil, errors.New(err)
+	if opts.ProviderCacheExternalServer && (opts.ProviderCachePort == 0 || opts.ProviderCacheToken == "") {
+		return nil, errors.Errorf("the external provider cache server requires both --%s and --%s to be set", runCmd.ProviderCachePortFlagName, runCmd.ProviderCacheTokenFlagName)
 	}
 
-	if opts.ProviderCacheToken == "" {
-		opts.ProviderCacheToken = uuid.New().String()
-	}
-	// Currently, the cache server only supports the `x-api-key` token.
-	if !strings.HasPrefix(strings.ToLower(opts.ProviderCacheToken), APIKeyAuth+":") {
-		opts.ProviderCacheToken = fmt.Sprintf("%s:%s", APIKeyAuth, opts.ProviderCacheToken)
-	}
-
-	cliCfg, err := cliconfig.LoadUserConfig()
+	server, err := providercache.NewServer(opts)
 	if err != nil {
 		return nil, err
 	}
 
-	userProviderDir, err := cliconfig.UserProviderDir()
-	if err != nil {
-		return nil, err
-	}
-
-	providerService := services.NewProviderService(opts.ProviderCacheDir, userProviderDir, cliCfg.CredentialsSource(), opts.Logger)
-	proxyProviderHandler := handlers.NewProxyProviderHandler(opts.Logger, cliCfg.CredentialsSource())
-
-	providerHandlers, err := handlers.NewProviderHandlers(cliCfg, opts.Logger, opts.ProviderCacheRegistryNames)
-	if err != nil {
-		return nil, errors.Errorf("creating provider handlers failed: %w", err)
+	if opts.ProviderCacheExternalServer {
+		// The server is not listening in this process, so its address is taken as is from the options.
+		server.Server.Server.Addr = server.Addr()
 	}
 
-	cache := cache.NewServer(
-		cache.WithHostname(opts.ProviderCacheHostname),
-		cache.WithPort(opts.ProviderCachePort),
-		cache.WithToken(opts.ProviderCacheToken),
-		cache.WithProviderService(providerService),
-		cache.WithProviderHandlers(providerHandlers...),
-		cache.WithProxyProviderHandler(proxyProviderHandler),
-		cache.WithCacheProviderHTTPStatusCode(CacheProviderHTTPStatusCode),
-		cache.WithLogger(opts.Logger),
-	)
-
-	return &ProviderCache{
-		Server:          cache,
-		cliCfg:          cliCfg,
-		providerService: providerService,
-	}, nil
+	return &ProviderCache{Server: server}, nil
 }
 
 // TerraformCommandHook warms up the providers cache, creates `.terraform.lock.hcl` and runs the `tofu/terraform init`
@@ -197,20 +159,71 @@ func (cache *ProviderCache) warmUpCache(
 
 	for _, args := range commandsArgs {
 		if output, err := runTerraformCommand(ctx, opts, args, env); err != nil {
+			// In offline mode, Terraform fails with a generic error if some providers are unavailable, so we report the exact list of them instead.
+			if opts.ProviderCacheOffline {
+				if _, cacheErr := cache.cachedProviders(ctx, opts, cacheRequestID); cacheErr != nil {
+					return output, cacheErr
+				}
+			}
+
 			return output, err
 		}
 	}
 
-	caches, err := cache.providerService.WaitForCacheReady(cacheRequestID)
+	caches, err := cache.cachedProviders(ctx, opts, cacheRequestID)
 	if err != nil {
 		return nil, err
 	}
 
-	err = getproviders.UpdateLockfile(ctx, opts.WorkingDir, caches)
+	var lockfileOpts []getproviders.LockfileOption
+
+	if cache.PlatformHashes != nil {
+		lockfileOpts = append(lockfileOpts, getproviders.WithPlatformHashes(cache.PlatformHashes))
+	}
+
+	err = getproviders.UpdateLockfile(ctx, opts.WorkingDir, caches, lockfileOpts...)
 
 	return nil, err
 }
 
+// cachedProviders returns the providers cached by the requests with the given `cacheRequestID`.
+// If the external server is used, they are retrieved over HTTP, since that server runs in another process.
+func (cache *ProviderCache) cachedProviders(ctx context.Context, opts *options.TerragruntOptions, cacheRequestID string) ([]getproviders.Provider, error) {
+	if !opts.ProviderCacheExternalServer {
+		return cache.ProviderService.WaitForCacheReady(cacheRequestID)
+	}
+
+	reqURL := cache.CacheController.URL()
+	reqURL.Path = path.Join(reqURL.Path, cacheRequestID)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL.String(), nil)
+	if err != nil {
+		return nil, errors.New(err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+opts.ProviderCacheToken)
+
+	body := new(bytes.Buffer)
+
+	if err := helpers.Fetch(ctx, req, body); err != nil {
+		return nil, errors.Errorf("unable to retrieve cached providers from %s: %w", reqURL.Host, err)
+	}
+
+	var cachedProviders models.CachedProviders
+
+	if err := json.Unmarshal(body.Bytes(), &cachedProviders); err != nil {
+		return nil, errors.New(err)
+	}
+
+	providers := make([]getproviders.Provider, 0, len(cachedProviders))
+
+	for _, cachedProvider := range cachedProviders {
+		providers = append(providers, &externalProvider{CachedProvider: cachedProvider, logger: opts.Logger})
+	}
+
+	return providers, nil
+}
+
 func (cache *ProviderCache) runTerraformWithCache(
 	ctx context.Context,
 	opts *options.TerragruntOptions,
@@ -263,8 +276,10 @@ func (cache *ProviderCache) runTerraformWithCache(
 // It creates two types of configuration depending on the `cacheRequestID` variable set.
 // 1. If `cacheRequestID` is set, `terraform init` does _not_ use the provider cache directory, the cache server creates a cache for requested providers and returns HTTP status 423. Since for each module we create the CLI config, using `cacheRequestID` we have the opportunity later retrieve from the cache server exactly those cached providers that were requested by `terraform init` using this configuration.
 // 2. If `cacheRequestID` is empty, 'terraform init` uses provider cache directory, the cache server acts as a proxy.
+// With the external server, the provider cache directory is the one of the server, which may not be accessible from this machine,
+// so `terraform init` only uses the server, which serves the cached providers through its proxy.
 func (cache *ProviderCache) createLocalCLIConfig(ctx context.Context, opts *options.TerragruntOptions, filename string, cacheRequestID string) error {
-	cfg := cache.cliCfg.Clone()
+	cfg := cache.CLIConfig.Clone()
 	cfg.PluginCacheDir = ""
 
 	var providerInstallationIncludes = make([]string, 0, len(opts.ProviderCacheRegistryNames))
@@ -284,12 +299,16 @@ func (cache *ProviderCache) createLocalCLIConfig(ctx context.Context, opts *opti
 		})
 	}
 
-	if cacheRequestID == "" {
+	switch {
+	case cacheRequestID != "":
+		cfg.ProviderInstallation = nil
+	case opts.ProviderCacheExternalServer:
+		// The local provider cache directory is never populated by the external server.
+		cfg.ProviderInstallation = nil
+	default:
 		cfg.AddProviderInstallationMethods(
 			cliconfig.NewProviderInstallationFilesystemMirror(opts.ProviderCacheDir, providerInstallationIncludes, nil),
 		)
-	} else {
-		cfg.ProviderInstallation = nil
 	}
 
 	cfg.AddProviderInstallationMethods(
@@ -368,6 +387,33 @@ func providerCacheEnvironment(opts *options.TerragruntOptions, cliConfigFile str
 	return envs
 }
 
+// externalProvider implements `getproviders.Provider` for the providers cached by the external server.
+type externalProvider struct {
+	*models.CachedProvider
+
+	logger log.Logger
+}
+
+func (provider *externalProvider) Address() string {
+	return provider.CachedProvider.Address
+}
+
+func (provider *externalProvider) Version() string {
+	return provider.CachedProvider.Version
+}
+
+func (provider *externalProvider) DocumentSHA256Sums(_ context.Context) ([]byte, error) {
+	return provider.CachedProvider.DocumentSHA256Sums, nil
+}
+
+func (provider *externalProvider) PackageDir() string {
+	return provider.CachedProvider.PackageDir
+}
+
+func (provider *externalProvider) Logger() log.Logger {
+	return provider.logger
+}
+
 // convertToMultipleCommandsByPlatforms converts `providers lock -platform=.. -platform=..` command into multiple commands that include only one platform.
 // for example:
 // `providers lock -platform=linux_amd64 -platform=darwin_arm64 -platform=freebsd_amd64`
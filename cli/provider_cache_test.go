@@ -64,6 +64,23 @@ func TestProviderCache(t *testing.T) {
 			expectedStatusCode: http.StatusOK,
 			expectedBodyReg:    regexp.MustCompile(regexp.QuoteMeta(`{"providers.v1":"/v1/providers"}`)),
 		},
+		{
+			opts:               append(opts, cache.WithToken("")),
+			fullURLPath:        "/health",
+			expectedStatusCode: http.StatusOK,
+			expectedBodyReg:    regexp.MustCompile(regexp.QuoteMeta(`{"status":"ok"}`)),
+		},
+		{
+			opts:               append(opts, cache.WithToken("")),
+			fullURLPath:        "/v1/cache/" + uuid.New().String(),
+			expectedStatusCode: http.StatusUnauthorized,
+		},
+		{
+			opts:               opts,
+			fullURLPath:        "/v1/cache/" + uuid.New().String(),
+			expectedStatusCode: http.StatusOK,
+			expectedBodyReg:    regexp.MustCompile(regexp.QuoteMeta(`[]`)),
+		},
 		{
 			opts:               append(opts, cache.WithToken("")),
 			relURLPath:         "/cache/registry.terraform.io/hashicorp/aws/versions",
@@ -9,8 +9,10 @@ import (
 	"path"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gruntwork-io/terragrunt/pkg/log"
 	"github.com/gruntwork-io/terragrunt/pkg/log/writer"
@@ -28,10 +30,12 @@ import (
 	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"github.com/hashicorp/hcl/v2/hclwrite"
 	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/function"
 
 	"github.com/gruntwork-io/go-commons/files"
 	"github.com/gruntwork-io/terragrunt/codegen"
 	"github.com/gruntwork-io/terragrunt/config/hclparse"
+	"github.com/gruntwork-io/terragrunt/engine"
 	"github.com/gruntwork-io/terragrunt/internal/errors"
 	"github.com/gruntwork-io/terragrunt/options"
 	"github.com/gruntwork-io/terragrunt/remote"
@@ -48,7 +52,7 @@ const (
 
 	iamRoleCacheName = "iamRoleCache"
 
-	DefaultEngineType                   = "rpc"
+	DefaultEngineType                   = engine.DefaultType
 	MetadataTerraform                   = "terraform"
 	MetadataTerraformBinary             = "terraform_binary"
 	MetadataTerraformVersionConstraint  = "terraform_version_constraint"
@@ -79,7 +83,12 @@ const (
 	MetadataErrors                      = "errors"
 	MetadataRetry                       = "retry"
 	MetadataIgnore                      = "ignore"
+	MetadataStackHooks                  = "stack_hooks"
+	MetadataBeforeAll                   = "before_all"
+	MetadataAfterAll                    = "after_all"
+	MetadataOnErrorAll                  = "on_error_all"
 	MetadataValues                      = "values"
+	MetadataUnit                        = "unit"
 )
 
 var (
@@ -104,9 +113,10 @@ var (
 
 // DecodedBaseBlocks decoded base blocks struct
 type DecodedBaseBlocks struct {
-	TrackInclude *TrackInclude
-	Locals       *cty.Value
-	FeatureFlags *cty.Value
+	TrackInclude  *TrackInclude
+	Locals        *cty.Value
+	FeatureFlags  *cty.Value
+	UserFunctions map[string]function.Function
 }
 
 // TerragruntConfig represents a parsed and expanded configuration
@@ -137,6 +147,7 @@ type TerragruntConfig struct {
 	FeatureFlags                FeatureFlags
 	Exclude                     *ExcludeConfig
 	Errors                      *ErrorsConfig
+	StackHooks                  *StackHooksConfig
 
 	// Fields used for internal tracking
 	// Indicates whether this is the result of a partial evaluation
@@ -150,6 +161,12 @@ type TerragruntConfig struct {
 
 	// List of dependent modules
 	DependentModulesPath []*string
+
+	// Paths of the sensitive values, such as `inputs.password`, in the cty representation of the config
+	SensitivePaths []cty.Path
+
+	// Where the values of the config were set, only recorded when parsing with provenance tracking
+	Provenance *Provenance
 }
 
 func (cfg *TerragruntConfig) String() string {
@@ -210,6 +227,7 @@ type terragruntConfigFile struct {
 	FeatureFlags             []*FeatureFlag      `hcl:"feature,block"`
 	Exclude                  *ExcludeConfig      `hcl:"exclude,block"`
 	Errors                   *ErrorsConfig       `hcl:"errors,block"`
+	StackHooks               *StackHooksConfig   `hcl:"stack_hooks,block"`
 
 	// We allow users to configure code generation via blocks:
 	//
@@ -240,6 +258,16 @@ type terragruntConfigFile struct {
 	// that have extraneous, unsupported blocks and attributes.
 	Locals  *terragruntLocal          `hcl:"locals,block"`
 	Include []terragruntIncludeIgnore `hcl:"include,block"`
+
+	// The functions are decoded with the base blocks, before the locals that may call them.
+	Functions       []terragruntFunctionIgnore `hcl:"function,block"`
+	ImportFunctions []string                   `hcl:"import_functions,optional"`
+
+	// The `values` schema of the unit is decoded before the values are added to the evaluation context.
+	ValuesSchema *terragruntValuesIgnore `hcl:"values,block"`
+
+	// The paths of the sensitive values, which are unmarked when decoded.
+	sensitivePaths []cty.Path
 }
 
 // We use a struct designed to not parse the block, as locals and includes are parsed and decoded using a special
@@ -356,6 +384,14 @@ type IncludeConfig struct {
 	Path          string  `hcl:"path,attr"`
 	Expose        *bool   `hcl:"expose,attr"`
 	MergeStrategy *string `hcl:"merge_strategy,attr"`
+
+	// MergeRules overrides the merge strategy of the include for the given attributes and blocks, such as
+	// `inputs = "deep"` or `"terraform.extra_arguments" = "append"`.
+	MergeRules map[string]string `hcl:"merge_rules,optional"`
+
+	// RemoveBlocks are the blocks of the included config removed before it is merged, such as `generate.provider` or
+	// `terraform.after_hook.notify`.
+	RemoveBlocks []string `hcl:"remove_blocks,optional"`
 }
 
 func (include *IncludeConfig) String() string {
@@ -411,6 +447,10 @@ const (
 	ShallowMerge     MergeStrategyType = "shallow"
 	DeepMerge        MergeStrategyType = "deep"
 	DeepMergeMapOnly MergeStrategyType = "deep_map_only"
+
+	// AppendMerge concatenates the lists and blocks of the child to the ones of the included config. It is only
+	// supported by the merge rules of the include.
+	AppendMerge MergeStrategyType = "append"
 )
 
 // ModuleDependencies represents the paths to other Terraform modules that must be applied before the current module
@@ -438,32 +478,94 @@ func (deps *ModuleDependencies) String() string {
 
 // Hook specifies terraform commands (apply/plan) and array of os commands to execute
 type Hook struct {
-	Name           string   `hcl:"name,label" cty:"name"`
-	If             *bool    `hcl:"if,attr" cty:"if"`
-	Commands       []string `hcl:"commands,attr" cty:"commands"`
-	Execute        []string `hcl:"execute,attr" cty:"execute"`
-	RunOnError     *bool    `hcl:"run_on_error,attr" cty:"run_on_error"`
-	SuppressStdout *bool    `hcl:"suppress_stdout,attr" cty:"suppress_stdout"`
-	WorkingDir     *string  `hcl:"working_dir,attr" cty:"working_dir"`
+	Name           string             `hcl:"name,label" cty:"name"`
+	If             *bool              `hcl:"if,attr" cty:"if"`
+	Commands       []string           `hcl:"commands,attr" cty:"commands"`
+	Execute        []string           `hcl:"execute,attr" cty:"execute"`
+	RunOnError     *bool              `hcl:"run_on_error,attr" cty:"run_on_error"`
+	SuppressStdout *bool              `hcl:"suppress_stdout,attr" cty:"suppress_stdout"`
+	WorkingDir     *string            `hcl:"working_dir,attr" cty:"working_dir"`
+	Timeout        *string            `hcl:"timeout,attr" cty:"timeout"`
+	Env            *map[string]string `hcl:"env,attr" cty:"env"`
+	OutputFile     *string            `hcl:"output_file,attr" cty:"output_file"`
+	PlanJSON       *bool              `hcl:"plan_json,attr" cty:"plan_json"`
 }
 
 type ErrorHook struct {
-	Name           string   `hcl:"name,label" cty:"name"`
-	Commands       []string `hcl:"commands,attr" cty:"commands"`
-	Execute        []string `hcl:"execute,attr" cty:"execute"`
-	OnErrors       []string `hcl:"on_errors,attr" cty:"on_errors"`
-	SuppressStdout *bool    `hcl:"suppress_stdout,attr" cty:"suppress_stdout"`
-	WorkingDir     *string  `hcl:"working_dir,attr" cty:"working_dir"`
+	Name           string             `hcl:"name,label" cty:"name"`
+	Commands       []string           `hcl:"commands,attr" cty:"commands"`
+	Execute        []string           `hcl:"execute,attr" cty:"execute"`
+	OnErrors       []string           `hcl:"on_errors,attr" cty:"on_errors"`
+	SuppressStdout *bool              `hcl:"suppress_stdout,attr" cty:"suppress_stdout"`
+	WorkingDir     *string            `hcl:"working_dir,attr" cty:"working_dir"`
+	Timeout        *string            `hcl:"timeout,attr" cty:"timeout"`
+	Env            *map[string]string `hcl:"env,attr" cty:"env"`
 }
 
 func (conf *Hook) String() string {
 	return fmt.Sprintf("Hook{Name = %s, Commands = %v}", conf.Name, len(conf.Commands))
 }
 
+// GetTimeout returns the maximum duration of the hook, or zero if the hook has no timeout.
+func (conf *Hook) GetTimeout() time.Duration {
+	return parseHookTimeout(conf.Timeout)
+}
+
+// IsPlanJSON returns true if the hook checks the plan, passed to it as JSON.
+func (conf *Hook) IsPlanJSON() bool {
+	return conf.PlanJSON != nil && *conf.PlanJSON
+}
+
+// GetEnv returns the env vars to set for the hook.
+func (conf *Hook) GetEnv() map[string]string {
+	if conf.Env == nil {
+		return nil
+	}
+
+	return *conf.Env
+}
+
 func (conf *ErrorHook) String() string {
 	return fmt.Sprintf("Hook{Name = %s, Commands = %v}", conf.Name, len(conf.Commands))
 }
 
+// GetTimeout returns the maximum duration of the error hook, or zero if the hook has no timeout.
+func (conf *ErrorHook) GetTimeout() time.Duration {
+	return parseHookTimeout(conf.Timeout)
+}
+
+// GetEnv returns the env vars to set for the error hook.
+func (conf *ErrorHook) GetEnv() map[string]string {
+	if conf.Env == nil {
+		return nil
+	}
+
+	return *conf.Env
+}
+
+func parseHookTimeout(value *string) time.Duration {
+	if value == nil {
+		return 0
+	}
+
+	// The value is validated by `ValidateHooks`.
+	timeout, _ := time.ParseDuration(*value)
+
+	return timeout
+}
+
+func validateHookTimeout(hookName string, value *string) error {
+	if value == nil {
+		return nil
+	}
+
+	if timeout, err := time.ParseDuration(*value); err != nil || timeout <= 0 {
+		return InvalidArgError(fmt.Sprintf("Error with hook %s. Invalid 'timeout' %q, expected a positive duration such as \"30s\" or \"5m\".", hookName, *value))
+	}
+
+	return nil
+}
+
 // TerraformConfig specifies where to find the Terraform configuration files
 // NOTE: If any attributes or blocks are added here, be sure to add it to ctyTerraformConfig in config_as_cty.go as
 // well.
@@ -517,12 +619,32 @@ func (cfg *TerraformConfig) ValidateHooks() error {
 		if len(curHook.Execute) < 1 || curHook.Execute[0] == "" {
 			return InvalidArgError(fmt.Sprintf("Error with hook %s. Need at least one non-empty argument in 'execute'.", curHook.Name))
 		}
+
+		if err := validateHookTimeout(curHook.Name, curHook.Timeout); err != nil {
+			return err
+		}
+	}
+
+	for _, curHook := range cfg.GetBeforeHooks() {
+		if curHook.IsPlanJSON() {
+			return InvalidArgError(fmt.Sprintf("Error with hook %s. 'plan_json' is only supported by after hooks.", curHook.Name))
+		}
+	}
+
+	for _, curHook := range cfg.GetAfterHooks() {
+		if curHook.IsPlanJSON() && !slices.Contains(curHook.Commands, tf.CommandNamePlan) {
+			return InvalidArgError(fmt.Sprintf("Error with hook %s. 'plan_json' requires the hook to run after the 'plan' command.", curHook.Name))
+		}
 	}
 
 	for _, curHook := range cfg.GetErrorHooks() {
 		if len(curHook.Execute) < 1 || curHook.Execute[0] == "" {
 			return InvalidArgError(fmt.Sprintf("Error with hook %s. Need at least one non-empty argument in 'execute'.", curHook.Name))
 		}
+
+		if err := validateHookTimeout(curHook.Name, curHook.Timeout); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -767,6 +889,17 @@ func ReadTerragruntConfig(ctx context.Context, terragruntOptions *options.Terrag
 	return ParseConfigFile(parcingCtx, terragruntOptions.TerragruntConfigPath, nil) //nolint:contextcheck
 }
 
+// ReadTerragruntConfigWithProvenance reads the Terragrunt config file like ReadTerragruntConfig, and records where its
+// values were set, through the includes and the locals, in its Provenance.
+func ReadTerragruntConfigWithProvenance(ctx context.Context, terragruntOptions *options.TerragruntOptions, parserOptions []hclparse.Option) (*TerragruntConfig, error) {
+	terragruntOptions.Logger.Debugf("Reading Terragrunt config file at %s with provenance", terragruntOptions.TerragruntConfigPath)
+
+	ctx = tf.ContextWithTerraformCommandHook(ctx, nil)
+	parsingCtx := NewParsingContext(ctx, terragruntOptions).WithParseOption(parserOptions).WithProvenance()
+
+	return ParseConfigFile(parsingCtx, terragruntOptions.TerragruntConfigPath, nil) //nolint:contextcheck
+}
+
 // ParseConfigFile parses the Terragrunt config file at the given path. If the include parameter is not nil, then treat this as a config
 // included in some other config file when resolving relative paths.
 func ParseConfigFile(ctx *ParsingContext, configPath string, includeFromChild *IncludeConfig) (*TerragruntConfig, error) {
@@ -775,9 +908,12 @@ func ParseConfigFile(ctx *ParsingContext, configPath string, includeFromChild *I
 	hclCache := cache.ContextCache[*hclparse.File](ctx, HclCacheContextKey)
 
 	err := telemetry.Telemetry(ctx, ctx.TerragruntOptions, "parse_config_file", map[string]interface{}{
-		"config_path": configPath,
-		"working_dir": ctx.TerragruntOptions.WorkingDir,
+		"config_path":         configPath,
+		"working_dir":         ctx.TerragruntOptions.WorkingDir,
+		telemetry.SubjectAttr: configPath,
 	}, func(childCtx context.Context) error {
+		ctx := ctx.WithContext(childCtx)
+
 		childKey := "nil"
 		if includeFromChild != nil {
 			childKey = includeFromChild.String()
@@ -897,7 +1033,7 @@ func ParseConfig(ctx *ParsingContext, file *hclparse.File, includeFromChild *Inc
 
 	// read unit files and add to context
 	if ctx.TerragruntOptions.Experiments.Evaluate(experiment.Stacks) {
-		unitValues, err := ReadUnitValues(ctx.Context, ctx.TerragruntOptions, filepath.Dir(file.ConfigPath))
+		unitValues, err := readCheckedUnitValues(ctx, file)
 		if err != nil {
 			return nil, err
 		}
@@ -913,6 +1049,7 @@ func ParseConfig(ctx *ParsingContext, file *hclparse.File, includeFromChild *Inc
 
 	ctx = ctx.WithTrackInclude(baseBlocks.TrackInclude)
 	ctx = ctx.WithFeatures(baseBlocks.FeatureFlags)
+	ctx = ctx.WithUserFunctions(baseBlocks.UserFunctions)
 	ctx = ctx.WithLocals(baseBlocks.Locals)
 
 	if ctx.DecodedDependencies == nil {
@@ -947,6 +1084,12 @@ func ParseConfig(ctx *ParsingContext, file *hclparse.File, includeFromChild *Inc
 		return nil, err
 	}
 
+	if ctx.TrackProvenance {
+		if config.Provenance, err = newProvenance(file, config); err != nil {
+			return nil, err
+		}
+	}
+
 	// If this file includes another, parse and merge it. Otherwise, just return this config.
 	if ctx.TrackInclude != nil {
 		mergedConfig, err := handleInclude(ctx, config, false)
@@ -962,6 +1105,7 @@ func ParseConfig(ctx *ParsingContext, file *hclparse.File, includeFromChild *Inc
 		//   config.
 		mergedConfig.Locals = config.Locals
 		mergedConfig.Exclude = config.Exclude
+		copyLocalsSensitivePaths(config, mergedConfig)
 
 		return mergedConfig, nil
 	}
@@ -1041,7 +1185,8 @@ func setIAMRole(ctx *ParsingContext, file *hclparse.File, includeFromChild *Incl
 func decodeAsTerragruntConfigFile(ctx *ParsingContext, file *hclparse.File, evalContext *hcl.EvalContext) (*terragruntConfigFile, error) {
 	terragruntConfig := terragruntConfigFile{}
 
-	if err := file.Decode(&terragruntConfig, evalContext); err != nil {
+	pathMarks, err := file.DecodeWithMarks(&terragruntConfig, evalContext)
+	if err != nil {
 		var diagErr hcl.Diagnostics
 		// diagErr, ok := errors.Unwrap(err).(hcl.Diagnostics)
 		ok := errors.As(err, &diagErr)
@@ -1054,6 +1199,8 @@ func decodeAsTerragruntConfigFile(ctx *ParsingContext, file *hclparse.File, eval
 		ctx.TerragruntOptions.Logger.Warnf("Failed to decode inputs %v", diagErr)
 	}
 
+	terragruntConfig.sensitivePaths = sensitivePaths(nil, pathMarks)
+
 	if terragruntConfig.Inputs != nil {
 		inputs, err := UpdateUnknownCtyValValues(*terragruntConfig.Inputs)
 		if err != nil {
@@ -1265,6 +1412,16 @@ func convertToTerragruntConfig(ctx *ParsingContext, configPath string, terragrun
 		terragruntConfig.SetFieldMetadata(MetadataErrors, defaultMetadata)
 	}
 
+	if terragruntConfigFromFile.StackHooks != nil {
+		if err := terragruntConfigFromFile.StackHooks.Validate(); err != nil {
+			return nil, err
+		}
+
+		terragruntConfigFromFile.StackHooks.resolveWorkingDirs(filepath.Dir(configPath))
+		terragruntConfig.StackHooks = terragruntConfigFromFile.StackHooks
+		terragruntConfig.SetFieldMetadata(MetadataStackHooks, defaultMetadata)
+	}
+
 	generateBlocks := []terragruntGenerateBlock{}
 	generateBlocks = append(generateBlocks, terragruntConfigFromFile.GenerateBlocks...)
 
@@ -1344,8 +1501,13 @@ func convertToTerragruntConfig(ctx *ParsingContext, configPath string, terragrun
 		terragruntConfig.SetFieldMetadataMap(MetadataInputs, terragruntConfig.Inputs, defaultMetadata)
 	}
 
+	terragruntConfig.SensitivePaths = terragruntConfigFromFile.sensitivePaths
+
 	if ctx.Locals != nil && *ctx.Locals != cty.NilVal {
-		localsParsed, err := ParseCtyValueToMap(*ctx.Locals)
+		locals, pathMarks := ctx.Locals.UnmarkDeepWithPaths()
+		terragruntConfig.SensitivePaths = append(terragruntConfig.SensitivePaths, sensitivePaths(cty.GetAttrPath(MetadataLocals), pathMarks)...)
+
+		localsParsed, err := ParseCtyValueToMap(locals)
 		if err != nil {
 			return nil, err
 		}
@@ -1510,11 +1672,15 @@ func (cfg *TerragruntConfig) EngineOptions() (*options.EngineOptions, error) {
 		meta = parsedMeta
 	}
 
-	var version, engineType string
+	var version, engineType, checksum string
 	if cfg.Engine.Version != nil {
 		version = *cfg.Engine.Version
 	}
 
+	if cfg.Engine.Checksum != nil {
+		checksum = *cfg.Engine.Checksum
+	}
+
 	if cfg.Engine.Type != nil {
 		engineType = *cfg.Engine.Type
 	}
@@ -1524,10 +1690,11 @@ func (cfg *TerragruntConfig) EngineOptions() (*options.EngineOptions, error) {
 	}
 
 	return &options.EngineOptions{
-		Source:  cfg.Engine.Source,
-		Version: version,
-		Type:    engineType,
-		Meta:    meta,
+		Source:   cfg.Engine.Source,
+		Version:  version,
+		Type:     engineType,
+		Checksum: checksum,
+		Meta:     meta,
 	}, nil
 }
 
@@ -1559,12 +1726,33 @@ func (cfg *TerragruntConfig) ErrorsConfig() (*options.ErrorsConfig, error) {
 			compiledPatterns = append(compiledPatterns, value)
 		}
 
-		result.Retry[retryBlock.Label] = &options.RetryConfig{
+		retryConfig := &options.RetryConfig{
 			Name:             retryBlock.Label,
 			RetryableErrors:  compiledPatterns,
 			MaxAttempts:      retryBlock.MaxAttempts,
 			SleepIntervalSec: retryBlock.SleepIntervalSec,
+			Backoff:          options.ConstantBackoff,
+			BeforeRetry:      retryBlock.BeforeRetry,
+			BeforeRetryExec:  retryBlock.BeforeRetryExec,
 		}
+
+		if retryBlock.Backoff != nil {
+			retryConfig.Backoff = options.RetryBackoff(*retryBlock.Backoff)
+
+			if !slices.Contains(options.RetryBackoffs, retryConfig.Backoff) {
+				return nil, errors.New(InvalidRetryBackoffError{Block: retryBlock.Label, Backoff: *retryBlock.Backoff})
+			}
+		}
+
+		if retryBlock.MaxSleepSec != nil {
+			retryConfig.MaxSleepSec = *retryBlock.MaxSleepSec
+		}
+
+		if retryBlock.Jitter != nil {
+			retryConfig.Jitter = *retryBlock.Jitter
+		}
+
+		result.Retry[retryBlock.Label] = retryConfig
 	}
 
 	for _, ignoreBlock := range cfg.Errors.Ignore {
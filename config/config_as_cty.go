@@ -68,6 +68,15 @@ func TerragruntConfigAsCty(config *TerragruntConfig) (cty.Value, error) {
 		output[MetadataErrors] = errorsConfigCty
 	}
 
+	stackHooksConfigCty, err := stackHooksConfigAsCty(config.StackHooks)
+	if err != nil {
+		return cty.NilVal, err
+	}
+
+	if stackHooksConfigCty != cty.NilVal {
+		output[MetadataStackHooks] = stackHooksConfigCty
+	}
+
 	terraformConfigCty, err := terraformConfigAsCty(config.Terraform)
 	if err != nil {
 		return cty.NilVal, err
@@ -473,10 +482,11 @@ type ctyCatalogConfig struct {
 // ctyEngineConfig is an alternate representation of EngineConfig that converts internal blocks into a map that
 // maps the name to the underlying struct, as opposed to a list representation.
 type ctyEngineConfig struct {
-	Source  string    `cty:"source"`
-	Version string    `cty:"version"`
-	Type    string    `cty:"type"`
-	Meta    cty.Value `cty:"meta"`
+	Source   string    `cty:"source"`
+	Version  string    `cty:"version"`
+	Type     string    `cty:"type"`
+	Checksum string    `cty:"checksum"`
+	Meta     cty.Value `cty:"meta"`
 }
 
 // ctyExclude exclude representation for cty.
@@ -510,7 +520,7 @@ func engineConfigAsCty(config *EngineConfig) (cty.Value, error) {
 		return cty.NilVal, err
 	}
 
-	var v, t string
+	var v, t, c string
 	if config.Version != nil {
 		v = *config.Version
 	}
@@ -519,11 +529,16 @@ func engineConfigAsCty(config *EngineConfig) (cty.Value, error) {
 		t = *config.Type
 	}
 
+	if config.Checksum != nil {
+		c = *config.Checksum
+	}
+
 	configCty := ctyEngineConfig{
-		Source:  config.Source,
-		Version: v,
-		Type:    t,
-		Meta:    ctyMetaVal,
+		Source:   config.Source,
+		Version:  v,
+		Type:     t,
+		Checksum: c,
+		Meta:     ctyMetaVal,
 	}
 
 	return goTypeToCty(configCty)
@@ -696,6 +711,32 @@ func errorsConfigAsCty(config *ErrorsConfig) (cty.Value, error) {
 	return convertValuesMapToCtyVal(output)
 }
 
+// Serialize stack hooks configuration as cty.Value.
+func stackHooksConfigAsCty(config *StackHooksConfig) (cty.Value, error) {
+	if config == nil {
+		return cty.NilVal, nil
+	}
+
+	output := map[string]cty.Value{}
+
+	for name, hooks := range map[string][]*StackHook{
+		MetadataBeforeAll:  config.BeforeAll,
+		MetadataAfterAll:   config.AfterAll,
+		MetadataOnErrorAll: config.OnErrorAll,
+	} {
+		hooksCty, err := goTypeToCty(hooks)
+		if err != nil {
+			return cty.NilVal, err
+		}
+
+		if hooksCty != cty.NilVal {
+			output[name] = hooksCty
+		}
+	}
+
+	return convertValuesMapToCtyVal(output)
+}
+
 // Converts arbitrary go types that are json serializable to a cty Value by using json as an intermediary
 // representation. This avoids the strict type nature of cty, where you need to know the output type beforehand to
 // serialize to cty.
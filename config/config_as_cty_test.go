@@ -126,6 +126,14 @@ func TestTerragruntConfigAsCtyDrift(t *testing.T) {
 				},
 			},
 		},
+		StackHooks: &config.StackHooksConfig{
+			BeforeAll: []*config.StackHook{
+				{
+					Label:   "lock",
+					Execute: []string{"echo", "lock"},
+				},
+			},
+		},
 		GenerateConfigs: map[string]codegen.GenerateConfig{
 			"provider": {
 				Path:          "foo",
@@ -267,6 +275,10 @@ func terragruntConfigStructFieldToMapKey(t *testing.T, fieldName string) (string
 		return "", false
 	case "FieldsMetadata":
 		return "", false
+	case "SensitivePaths":
+		return "", false
+	case "Provenance":
+		return "", false
 	case "RetryableErrors":
 		return "retryable_errors", true
 	case "RetryMaxAttempts":
@@ -283,6 +295,8 @@ func terragruntConfigStructFieldToMapKey(t *testing.T, fieldName string) (string
 		return "exclude", true
 	case "Errors":
 		return "errors", true
+	case "StackHooks":
+		return "stack_hooks", true
 	default:
 		t.Fatalf("Unknown struct property: %s", fieldName)
 		// This should not execute
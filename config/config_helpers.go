@@ -1,6 +1,7 @@
 package config
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -9,6 +10,7 @@ import (
 	"regexp"
 	"runtime"
 	"strings"
+	"time"
 	"unicode/utf8"
 
 	"github.com/getsops/sops/v3/cmd/sops/formats"
@@ -31,6 +33,7 @@ import (
 	"github.com/gruntwork-io/terragrunt/options"
 	"github.com/gruntwork-io/terragrunt/pkg/log"
 	"github.com/gruntwork-io/terragrunt/shell"
+	"github.com/gruntwork-io/terragrunt/telemetry"
 	"github.com/gruntwork-io/terragrunt/tf"
 	"github.com/gruntwork-io/terragrunt/util"
 )
@@ -51,6 +54,12 @@ const (
 	FuncNameGetRepoRoot                             = "get_repo_root"
 	FuncNameGetPathFromRepoRoot                     = "get_path_from_repo_root"
 	FuncNameGetPathToRepoRoot                       = "get_path_to_repo_root"
+	FuncNameGetGitBranch                            = "get_git_branch"
+	FuncNameGetGitCommit                            = "get_git_commit"
+	FuncNameGetGitTags                              = "get_git_tags"
+	FuncNameGetGitDirty                             = "get_git_dirty"
+	FuncNameGetGitLastAuthor                        = "get_git_last_author"
+	FuncNameGitChangedSince                         = "git_changed_since"
 	FuncNameGetTerragruntDir                        = "get_terragrunt_dir"
 	FuncNameGetOriginalTerragruntDir                = "get_original_terragrunt_dir"
 	FuncNameGetTerraformCommand                     = "get_terraform_command"
@@ -74,6 +83,9 @@ const (
 	FuncNameStrContains                             = "strcontains"
 	FuncNameTimeCmp                                 = "timecmp"
 	FuncNameMarkAsRead                              = "mark_as_read"
+	FuncNameGetErrorSignals                         = "get_error_signals"
+	FuncNameGetSecret                               = "get_secret"
+	FuncNameNonsensitive                            = "nonsensitive"
 
 	sopsCacheName = "sopsCache"
 )
@@ -145,43 +157,9 @@ func createTerragruntEvalContext(ctx *ParsingContext, configPath string) (*hcl.E
 		BaseDir: filepath.Dir(configPath),
 	}
 
-	terragruntFunctions := map[string]function.Function{
-		FuncNameFindInParentFolders:                     wrapStringSliceToStringAsFuncImpl(ctx, FindInParentFolders),
-		FuncNamePathRelativeToInclude:                   wrapStringSliceToStringAsFuncImpl(ctx, PathRelativeToInclude),
-		FuncNamePathRelativeFromInclude:                 wrapStringSliceToStringAsFuncImpl(ctx, PathRelativeFromInclude),
-		FuncNameGetEnv:                                  wrapStringSliceToStringAsFuncImpl(ctx, getEnvironmentVariable),
-		FuncNameRunCmd:                                  wrapStringSliceToStringAsFuncImpl(ctx, RunCommand),
-		FuncNameReadTerragruntConfig:                    readTerragruntConfigAsFuncImpl(ctx),
-		FuncNameGetPlatform:                             wrapVoidToStringAsFuncImpl(ctx, getPlatform),
-		FuncNameGetRepoRoot:                             wrapVoidToStringAsFuncImpl(ctx, getRepoRoot),
-		FuncNameGetPathFromRepoRoot:                     wrapVoidToStringAsFuncImpl(ctx, getPathFromRepoRoot),
-		FuncNameGetPathToRepoRoot:                       wrapVoidToStringAsFuncImpl(ctx, getPathToRepoRoot),
-		FuncNameGetTerragruntDir:                        wrapVoidToStringAsFuncImpl(ctx, GetTerragruntDir),
-		FuncNameGetOriginalTerragruntDir:                wrapVoidToStringAsFuncImpl(ctx, getOriginalTerragruntDir),
-		FuncNameGetTerraformCommand:                     wrapVoidToStringAsFuncImpl(ctx, getTerraformCommand),
-		FuncNameGetTerraformCLIArgs:                     wrapVoidToStringSliceAsFuncImpl(ctx, getTerraformCliArgs),
-		FuncNameGetParentTerragruntDir:                  wrapStringSliceToStringAsFuncImpl(ctx, GetParentTerragruntDir),
-		FuncNameGetAWSAccountAlias:                      wrapVoidToStringAsFuncImpl(ctx, getAWSAccountAlias),
-		FuncNameGetAWSAccountID:                         wrapVoidToStringAsFuncImpl(ctx, getAWSAccountID),
-		FuncNameGetAWSCallerIdentityArn:                 wrapVoidToStringAsFuncImpl(ctx, getAWSCallerIdentityARN),
-		FuncNameGetAWSCallerIdentityUserID:              wrapVoidToStringAsFuncImpl(ctx, getAWSCallerIdentityUserID),
-		FuncNameGetTerraformCommandsThatNeedVars:        wrapStaticValueToStringSliceAsFuncImpl(TerraformCommandsNeedVars),
-		FuncNameGetTerraformCommandsThatNeedLocking:     wrapStaticValueToStringSliceAsFuncImpl(TerraformCommandsNeedLocking),
-		FuncNameGetTerraformCommandsThatNeedInput:       wrapStaticValueToStringSliceAsFuncImpl(TerraformCommandsNeedInput),
-		FuncNameGetTerraformCommandsThatNeedParallelism: wrapStaticValueToStringSliceAsFuncImpl(TerraformCommandsNeedParallelism),
-		FuncNameSopsDecryptFile:                         wrapStringSliceToStringAsFuncImpl(ctx, sopsDecryptFile),
-		FuncNameGetTerragruntSourceCLIFlag:              wrapVoidToStringAsFuncImpl(ctx, getTerragruntSourceCliFlag),
-		FuncNameGetDefaultRetryableErrors:               wrapVoidToStringSliceAsFuncImpl(ctx, getDefaultRetryableErrors),
-		FuncNameReadTfvarsFile:                          wrapStringSliceToStringAsFuncImpl(ctx, readTFVarsFile),
-		FuncNameGetWorkingDir:                           wrapVoidToStringAsFuncImpl(ctx, getWorkingDir),
-		FuncNameMarkAsRead:                              wrapStringSliceToStringAsFuncImpl(ctx, markAsRead),
-
-		// Map with HCL functions introduced in Terraform after v0.15.3, since upgrade to a later version is not supported
-		// https://github.com/gruntwork-io/terragrunt/blob/master/go.mod#L22
-		FuncNameStartsWith:  wrapStringSliceToBoolAsFuncImpl(ctx, StartsWith),
-		FuncNameEndsWith:    wrapStringSliceToBoolAsFuncImpl(ctx, EndsWith),
-		FuncNameStrContains: wrapStringSliceToBoolAsFuncImpl(ctx, StrContains),
-		FuncNameTimeCmp:     wrapStringSliceToNumberAsFuncImpl(ctx, TimeCmp),
+	terragruntFuncs := terragruntFunctions(ctx)
+	if telemetry.ProfilerFromContext(ctx) != nil {
+		terragruntFuncs = profiledTerragruntFunctions(ctx)
 	}
 
 	functions := map[string]function.Function{}
@@ -189,7 +167,11 @@ func createTerragruntEvalContext(ctx *ParsingContext, configPath string) (*hcl.E
 		functions[k] = v
 	}
 
-	for k, v := range terragruntFunctions {
+	for k, v := range terragruntFuncs {
+		functions[k] = v
+	}
+
+	for k, v := range ctx.UserFunctions {
 		functions[k] = v
 	}
 
@@ -232,6 +214,191 @@ func createTerragruntEvalContext(ctx *ParsingContext, configPath string) (*hcl.E
 	return evalCtx, nil
 }
 
+// terragruntFunctions returns the Terragrunt functions, evaluated with the given parsing context.
+func terragruntFunctions(ctx *ParsingContext) map[string]function.Function {
+	factories := terragruntFunctionFactories()
+	functions := make(map[string]function.Function, len(factories))
+
+	for name, newFunction := range factories {
+		functions[name] = newFunction(ctx)
+	}
+
+	return functions
+}
+
+// terragruntFunctionFactories returns the constructors of the Terragrunt functions by name, so that a single function
+// can be created with a given parsing context.
+func terragruntFunctionFactories() map[string]func(ctx *ParsingContext) function.Function {
+	return map[string]func(ctx *ParsingContext) function.Function{
+		FuncNameFindInParentFolders: func(ctx *ParsingContext) function.Function {
+			return wrapStringSliceToStringAsFuncImpl(ctx, FindInParentFolders)
+		},
+		FuncNamePathRelativeToInclude: func(ctx *ParsingContext) function.Function {
+			return wrapStringSliceToStringAsFuncImpl(ctx, PathRelativeToInclude)
+		},
+		FuncNamePathRelativeFromInclude: func(ctx *ParsingContext) function.Function {
+			return wrapStringSliceToStringAsFuncImpl(ctx, PathRelativeFromInclude)
+		},
+		FuncNameGetEnv: func(ctx *ParsingContext) function.Function {
+			return wrapSensitiveResult(wrapStringSliceToStringAsFuncImpl(ctx, getEnvironmentVariable), isSensitiveGetEnv)
+		},
+		FuncNameRunCmd: func(ctx *ParsingContext) function.Function {
+			return wrapStringSliceToStringAsFuncImpl(ctx, RunCommand)
+		},
+		FuncNameReadTerragruntConfig: func(ctx *ParsingContext) function.Function {
+			return readTerragruntConfigAsFuncImpl(ctx)
+		},
+		FuncNameGetPlatform: func(ctx *ParsingContext) function.Function {
+			return wrapVoidToStringAsFuncImpl(ctx, getPlatform)
+		},
+		FuncNameGetRepoRoot: func(ctx *ParsingContext) function.Function {
+			return wrapVoidToStringAsFuncImpl(ctx, getRepoRoot)
+		},
+		FuncNameGetPathFromRepoRoot: func(ctx *ParsingContext) function.Function {
+			return wrapVoidToStringAsFuncImpl(ctx, getPathFromRepoRoot)
+		},
+		FuncNameGetPathToRepoRoot: func(ctx *ParsingContext) function.Function {
+			return wrapVoidToStringAsFuncImpl(ctx, getPathToRepoRoot)
+		},
+		FuncNameGetGitBranch: func(ctx *ParsingContext) function.Function {
+			return wrapVoidToStringAsFuncImpl(ctx, getGitBranch)
+		},
+		FuncNameGetGitCommit: func(ctx *ParsingContext) function.Function {
+			return wrapVoidToStringAsFuncImpl(ctx, getGitCommit)
+		},
+		FuncNameGetGitTags: func(ctx *ParsingContext) function.Function {
+			return wrapVoidToStringSliceAsFuncImpl(ctx, getGitTags)
+		},
+		FuncNameGetGitDirty: func(ctx *ParsingContext) function.Function {
+			return wrapVoidToBoolAsFuncImpl(ctx, getGitDirty)
+		},
+		FuncNameGetGitLastAuthor: func(ctx *ParsingContext) function.Function {
+			return wrapVoidToStringAsFuncImpl(ctx, getGitLastAuthor)
+		},
+		FuncNameGitChangedSince: func(ctx *ParsingContext) function.Function {
+			return wrapStringSliceToBoolAsFuncImpl(ctx, gitChangedSince)
+		},
+		FuncNameGetTerragruntDir: func(ctx *ParsingContext) function.Function {
+			return wrapVoidToStringAsFuncImpl(ctx, GetTerragruntDir)
+		},
+		FuncNameGetOriginalTerragruntDir: func(ctx *ParsingContext) function.Function {
+			return wrapVoidToStringAsFuncImpl(ctx, getOriginalTerragruntDir)
+		},
+		FuncNameGetTerraformCommand: func(ctx *ParsingContext) function.Function {
+			return wrapVoidToStringAsFuncImpl(ctx, getTerraformCommand)
+		},
+		FuncNameGetTerraformCLIArgs: func(ctx *ParsingContext) function.Function {
+			return wrapVoidToStringSliceAsFuncImpl(ctx, getTerraformCliArgs)
+		},
+		FuncNameGetParentTerragruntDir: func(ctx *ParsingContext) function.Function {
+			return wrapStringSliceToStringAsFuncImpl(ctx, GetParentTerragruntDir)
+		},
+		FuncNameGetAWSAccountAlias: func(ctx *ParsingContext) function.Function {
+			return wrapVoidToStringAsFuncImpl(ctx, getAWSAccountAlias)
+		},
+		FuncNameGetAWSAccountID: func(ctx *ParsingContext) function.Function {
+			return wrapVoidToStringAsFuncImpl(ctx, getAWSAccountID)
+		},
+		FuncNameGetAWSCallerIdentityArn: func(ctx *ParsingContext) function.Function {
+			return wrapVoidToStringAsFuncImpl(ctx, getAWSCallerIdentityARN)
+		},
+		FuncNameGetAWSCallerIdentityUserID: func(ctx *ParsingContext) function.Function {
+			return wrapVoidToStringAsFuncImpl(ctx, getAWSCallerIdentityUserID)
+		},
+		FuncNameGetTerraformCommandsThatNeedVars: func(_ *ParsingContext) function.Function {
+			return wrapStaticValueToStringSliceAsFuncImpl(TerraformCommandsNeedVars)
+		},
+		FuncNameGetTerraformCommandsThatNeedLocking: func(_ *ParsingContext) function.Function {
+			return wrapStaticValueToStringSliceAsFuncImpl(TerraformCommandsNeedLocking)
+		},
+		FuncNameGetTerraformCommandsThatNeedInput: func(_ *ParsingContext) function.Function {
+			return wrapStaticValueToStringSliceAsFuncImpl(TerraformCommandsNeedInput)
+		},
+		FuncNameGetTerraformCommandsThatNeedParallelism: func(_ *ParsingContext) function.Function {
+			return wrapStaticValueToStringSliceAsFuncImpl(TerraformCommandsNeedParallelism)
+		},
+		FuncNameSopsDecryptFile: func(ctx *ParsingContext) function.Function {
+			return wrapSensitiveResult(wrapStringSliceToStringAsFuncImpl(ctx, sopsDecryptFile), isSensitiveSopsDecryptFile)
+		},
+		FuncNameGetTerragruntSourceCLIFlag: func(ctx *ParsingContext) function.Function {
+			return wrapVoidToStringAsFuncImpl(ctx, getTerragruntSourceCliFlag)
+		},
+		FuncNameGetDefaultRetryableErrors: func(ctx *ParsingContext) function.Function {
+			return wrapVoidToStringSliceAsFuncImpl(ctx, getDefaultRetryableErrors)
+		},
+		FuncNameReadTfvarsFile: func(ctx *ParsingContext) function.Function {
+			return wrapStringSliceToStringAsFuncImpl(ctx, readTFVarsFile)
+		},
+		FuncNameGetWorkingDir: func(ctx *ParsingContext) function.Function {
+			return wrapVoidToStringAsFuncImpl(ctx, getWorkingDir)
+		},
+		FuncNameMarkAsRead: func(ctx *ParsingContext) function.Function {
+			return wrapStringSliceToStringAsFuncImpl(ctx, markAsRead)
+		},
+		FuncNameGetErrorSignals: func(ctx *ParsingContext) function.Function {
+			return getErrorSignalsAsFuncImpl(ctx)
+		},
+		FuncNameGetSecret: func(ctx *ParsingContext) function.Function {
+			return getSecretAsFuncImpl(ctx)
+		},
+		FuncNameNonsensitive: func(_ *ParsingContext) function.Function {
+			return nonsensitiveAsFuncImpl()
+		},
+
+		// Map with HCL functions introduced in Terraform after v0.15.3, since upgrade to a later version is not supported
+		// https://github.com/gruntwork-io/terragrunt/blob/master/go.mod#L22
+		FuncNameStartsWith: func(ctx *ParsingContext) function.Function {
+			return wrapStringSliceToBoolAsFuncImpl(ctx, StartsWith)
+		},
+		FuncNameEndsWith: func(ctx *ParsingContext) function.Function {
+			return wrapStringSliceToBoolAsFuncImpl(ctx, EndsWith)
+		},
+		FuncNameStrContains: func(ctx *ParsingContext) function.Function {
+			return wrapStringSliceToBoolAsFuncImpl(ctx, StrContains)
+		},
+		FuncNameTimeCmp: func(ctx *ParsingContext) function.Function {
+			return wrapStringSliceToNumberAsFuncImpl(ctx, TimeCmp)
+		},
+	}
+}
+
+// profiledTerragruntFunctions returns the Terragrunt functions, with each call run in a telemetry span, so that the
+// calls are recorded by the config profiler. For each call, the called function is created again with the context of
+// its span, so that the configs it parses, such as with `read_terragrunt_config`, are nested in the span of the call.
+func profiledTerragruntFunctions(ctx *ParsingContext) map[string]function.Function {
+	factories := terragruntFunctionFactories()
+	functions := make(map[string]function.Function, len(factories))
+
+	for name, newFunction := range factories {
+		fn := newFunction(ctx)
+
+		functions[name] = function.New(&function.Spec{
+			Params:   fn.Params(),
+			VarParam: fn.VarParam(),
+			Type:     fn.ReturnTypeForValues,
+			Impl: func(args []cty.Value, _ cty.Type) (cty.Value, error) {
+				var result cty.Value
+
+				err := telemetry.Telemetry(ctx, ctx.TerragruntOptions, "function", map[string]interface{}{
+					"function":            name,
+					"config_path":         ctx.TerragruntOptions.TerragruntConfigPath,
+					telemetry.SubjectAttr: name,
+				}, func(childCtx context.Context) error {
+					var err error
+
+					result, err = newFunction(ctx.WithContext(childCtx)).Call(args)
+
+					return err
+				})
+
+				return result, err
+			},
+		})
+	}
+
+	return functions
+}
+
 // Return the OS platform
 func getPlatform(ctx *ParsingContext) (string, error) {
 	return runtime.GOOS, nil
@@ -272,6 +439,49 @@ func getPathToRepoRoot(ctx *ParsingContext) (string, error) {
 	return filepath.ToSlash(strings.TrimSpace(repoRootPathAbs)), nil
 }
 
+// Return the branch checked out in the repository, or an empty string if HEAD is detached
+func getGitBranch(ctx *ParsingContext) (string, error) {
+	return shell.GitBranch(ctx, ctx.TerragruntOptions, ctx.TerragruntOptions.WorkingDir)
+}
+
+// Return the SHA of the commit checked out in the repository
+func getGitCommit(ctx *ParsingContext) (string, error) {
+	return shell.GitCommit(ctx, ctx.TerragruntOptions, ctx.TerragruntOptions.WorkingDir)
+}
+
+// Return the tags pointing at the commit checked out in the repository
+func getGitTags(ctx *ParsingContext) ([]string, error) {
+	return shell.GitTags(ctx, ctx.TerragruntOptions, ctx.TerragruntOptions.WorkingDir)
+}
+
+// Return true if the repository has uncommitted changes
+func getGitDirty(ctx *ParsingContext) (bool, error) {
+	return shell.GitIsDirty(ctx, ctx.TerragruntOptions, ctx.TerragruntOptions.WorkingDir)
+}
+
+// Return the author of the last commit changing the unit
+func getGitLastAuthor(ctx *ParsingContext) (string, error) {
+	return shell.GitLastAuthor(ctx, ctx.TerragruntOptions, filepath.Dir(ctx.TerragruntOptions.TerragruntConfigPath))
+}
+
+// Return true if the files of the unit differ from the given git ref
+func gitChangedSince(ctx *ParsingContext, params []string) (bool, error) {
+	if numParams := len(params); numParams != 1 {
+		return false, errors.New(WrongNumberOfParamsError{Func: FuncNameGitChangedSince, Expected: "1", Actual: numParams})
+	}
+
+	if params[0] == "" {
+		return false, errors.New(EmptyStringNotAllowedError("parameter to the git_changed_since function"))
+	}
+
+	// The ref is passed to git as an argument, so it must not be mistaken for an option.
+	if strings.HasPrefix(params[0], "-") {
+		return false, errors.New(InvalidArgError(fmt.Sprintf("Invalid git ref %q passed to the git_changed_since function", params[0])))
+	}
+
+	return shell.GitChangedSince(ctx, ctx.TerragruntOptions, filepath.Dir(ctx.TerragruntOptions.TerragruntConfigPath), params[0])
+}
+
 // GetTerragruntDir returns the directory where the Terragrunt configuration file lives.
 func GetTerragruntDir(ctx *ParsingContext) (string, error) {
 	terragruntConfigFileAbsPath, err := filepath.Abs(ctx.TerragruntOptions.TerragruntConfigPath)
@@ -349,9 +559,14 @@ func RunCommand(ctx *ParsingContext, args []string) (string, error) {
 	currentPath := filepath.Dir(ctx.TerragruntOptions.TerragruntConfigPath)
 	cachePath := currentPath
 
+	var (
+		cacheTTL     time.Duration
+		cacheEnvVars []string
+	)
+
 	checkOptions := true
 	for checkOptions && len(args) > 0 {
-		switch args[0] {
+		switch name, value, _ := strings.Cut(args[0], "="); name {
 		case "--terragrunt-quiet":
 			suppressOutput = true
 
@@ -359,12 +574,44 @@ func RunCommand(ctx *ParsingContext, args []string) (string, error) {
 		case "--terragrunt-global-cache":
 			cachePath = "_global_"
 
+			args = append(args[:0], args[1:]...)
+		case runCmdCacheTTLOption:
+			ttl, err := time.ParseDuration(value)
+			if err != nil || ttl <= 0 {
+				return "", errors.New(InvalidRunCmdOptionError{Option: args[0], Reason: "the TTL must be a positive duration, such as 10m or 1h"})
+			}
+
+			cacheTTL = ttl
+
+			args = append(args[:0], args[1:]...)
+		case runCmdCacheEnvOption:
+			if value == "" {
+				return "", errors.New(InvalidRunCmdOptionError{Option: args[0], Reason: "the names of the environment variables are missing"})
+			}
+
+			cacheEnvVars = append(cacheEnvVars, strings.Split(value, ",")...)
+
 			args = append(args[:0], args[1:]...)
 		default:
 			checkOptions = false
 		}
 	}
 
+	if len(args) == 0 {
+		return "", errors.New(EmptyStringNotAllowedError("command to run in the run_cmd function"))
+	}
+
+	if ctx.TerragruntOptions.NoRunCmd {
+		value := runCmdPlaceholder(ctx.TerragruntOptions, args[0])
+		ctx.TerragruntOptions.Logger.Debugf("run_cmd disabled by --no-run-cmd, returning placeholder [%s] for %s", value, args[0])
+
+		return value, nil
+	}
+
+	if err := checkRunCmdAllowed(ctx.TerragruntOptions, currentPath, args[0]); err != nil {
+		return "", err
+	}
+
 	// To avoid re-run of the same run_cmd command, is used in memory cache for command results, with caching key path + arguments
 	// see: https://github.com/gruntwork-io/terragrunt/issues/1427
 	cacheKey := fmt.Sprintf("%v-%v", cachePath, args)
@@ -380,7 +627,49 @@ func RunCommand(ctx *ParsingContext, args []string) (string, error) {
 		return cachedValue, nil
 	}
 
-	cmdOutput, err := shell.RunCommandWithOutput(ctx, ctx.TerragruntOptions, currentPath, suppressOutput, false, args[0], args[1:]...)
+	var diskCache *runCmdDiskCache
+
+	// The results of the commands called with a TTL are cached on disk as well, to be reused by the other units and the
+	// next runs of Terragrunt.
+	if cacheTTL > 0 {
+		var err error
+
+		if diskCache, err = newRunCmdDiskCache(ctx, cachePath, args, cacheEnvVars, cacheTTL); err != nil {
+			return "", err
+		}
+
+		if value, found := diskCache.get(ctx); found {
+			if suppressOutput {
+				ctx.TerragruntOptions.Logger.Debugf("run_cmd, output cached on disk: [REDACTED]")
+			} else {
+				ctx.TerragruntOptions.Logger.Debugf("run_cmd, output cached on disk: [%s]", value)
+			}
+
+			runCommandCache.Put(ctx, cacheKey, value)
+
+			return value, nil
+		}
+	}
+
+	var (
+		runCtx context.Context = ctx
+		cancel                 = func() {}
+	)
+
+	if timeout := ctx.TerragruntOptions.RunCmdTimeout; timeout > 0 {
+		runCtx, cancel = context.WithTimeout(ctx, timeout)
+	}
+
+	defer cancel()
+
+	cmdOutput, err := shell.RunCommandWithOutput(runCtx, runCmdOptions(ctx.TerragruntOptions), currentPath, suppressOutput, false, args[0], args[1:]...)
+
+	// A command that outlives its timeout is killed, even if it exits successfully after trapping the interrupt signal,
+	// since its output may be partial.
+	if errors.Is(runCtx.Err(), context.DeadlineExceeded) {
+		return "", errors.New(RunCmdTimeoutError{Command: args[0], Timeout: ctx.TerragruntOptions.RunCmdTimeout})
+	}
+
 	if err != nil {
 		return "", errors.New(err)
 	}
@@ -397,6 +686,10 @@ func RunCommand(ctx *ParsingContext, args []string) (string, error) {
 	// see: https://github.com/gruntwork-io/terragrunt/issues/1427
 	runCommandCache.Put(ctx, cacheKey, value)
 
+	if diskCache != nil {
+		diskCache.put(ctx, value)
+	}
+
 	return value, nil
 }
 
@@ -709,7 +1002,13 @@ func ParseTerragruntConfig(ctx *ParsingContext, configPath string, defaultVal *c
 		}
 	}
 
-	return TerragruntConfigAsCty(config)
+	configCty, err := TerragruntConfigAsCty(config)
+	if err != nil {
+		return cty.NilVal, err
+	}
+
+	// The values that are sensitive in the config being read stay sensitive in the reading config.
+	return config.MarkSensitiveValues(configCty), nil
 }
 
 // Create a cty Function that can be used to for calling read_terragrunt_config.
@@ -744,6 +1043,39 @@ func readTerragruntConfigAsFuncImpl(ctx *ParsingContext) function.Function {
 	})
 }
 
+// Create a cty Function that can be used to for calling get_error_signals.
+func getErrorSignalsAsFuncImpl(ctx *ParsingContext) function.Function {
+	return function.New(&function.Spec{
+		// Takes one required string param, the path to the unit
+		Params: []function.Parameter{{Type: cty.String}},
+		// The signals are arbitrary values, so we use a dynamic type
+		Type: function.StaticReturnType(cty.DynamicPseudoType),
+		Impl: func(args []cty.Value, retType cty.Type) (cty.Value, error) {
+			return getErrorSignals(ctx, args[0].AsString())
+		},
+	})
+}
+
+// getErrorSignals returns the signals of the errors ignored during the last run of the unit located in `unitPath`, relative to the current
+// configuration, or an empty object if the unit did not ignore any error.
+func getErrorSignals(ctx *ParsingContext, unitPath string) (cty.Value, error) {
+	unitDir, err := util.CanonicalPath(unitPath, filepath.Dir(ctx.TerragruntOptions.TerragruntConfigPath))
+	if err != nil {
+		return cty.NilVal, errors.New(err)
+	}
+
+	signals, err := options.ReadErrorSignals(unitDir)
+	if err != nil {
+		return cty.NilVal, err
+	}
+
+	if len(signals) == 0 {
+		return cty.EmptyObjectVal, nil
+	}
+
+	return convertToCtyWithJSON(signals)
+}
+
 // Returns a cleaned path to the target config (the `terragrunt.hcl` or `terragrunt.hcl.json` file), handling relative
 // paths correctly. This will automatically append `terragrunt.hcl` or `terragrunt.hcl.json` to the path if the target
 // path is a directory.
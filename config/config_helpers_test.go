@@ -4,12 +4,17 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/gruntwork-io/terragrunt/config"
 	"github.com/gruntwork-io/terragrunt/internal/errors"
 	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/shell"
+	"github.com/gruntwork-io/terragrunt/telemetry"
 	"github.com/gruntwork-io/terragrunt/test/helpers"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -237,6 +242,210 @@ func TestRunCommand(t *testing.T) {
 	}
 }
 
+func TestRunCommandDiskCache(t *testing.T) {
+	t.Parallel()
+
+	workingDir := t.TempDir()
+	cacheDir := t.TempDir()
+
+	// The command counts its calls, so that the calls served by the cache are noticed.
+	runCmd := func(t *testing.T, env map[string]string, refresh bool, params ...string) (string, error) {
+		t.Helper()
+
+		opts := terragruntOptionsForTest(t, filepath.Join(workingDir, config.DefaultTerragruntConfigPath))
+		opts.RunCmdCacheDir = cacheDir
+		opts.RunCmdCacheRefresh = refresh
+		opts.Env = env
+
+		ctx := config.NewParsingContext(context.Background(), opts)
+
+		return config.RunCommand(ctx, append(params, "/bin/bash", "-c", "echo >> calls; wc -l < calls | tr -d ' '"))
+	}
+
+	output, err := runCmd(t, nil, false, "--terragrunt-cache-ttl=10m")
+	require.NoError(t, err)
+	assert.Equal(t, "1", output)
+
+	output, err = runCmd(t, nil, false, "--terragrunt-cache-ttl=10m")
+	require.NoError(t, err)
+	assert.Equal(t, "1", output)
+
+	// The environment variables passed with --terragrunt-cache-env are part of the cache key.
+	output, err = runCmd(t, map[string]string{"REGION": "us-east-1"}, false, "--terragrunt-cache-ttl=10m", "--terragrunt-cache-env=REGION")
+	require.NoError(t, err)
+	assert.Equal(t, "2", output)
+
+	output, err = runCmd(t, map[string]string{"REGION": "us-east-1"}, false, "--terragrunt-cache-env=REGION", "--terragrunt-cache-ttl=10m")
+	require.NoError(t, err)
+	assert.Equal(t, "2", output)
+
+	output, err = runCmd(t, nil, true, "--terragrunt-cache-ttl=10m")
+	require.NoError(t, err)
+	assert.Equal(t, "3", output)
+
+	output, err = runCmd(t, nil, false, "--terragrunt-cache-ttl=10m")
+	require.NoError(t, err)
+	assert.Equal(t, "3", output)
+
+	// Without TTL, the result is not cached on disk.
+	output, err = runCmd(t, nil, false)
+	require.NoError(t, err)
+	assert.Equal(t, "4", output)
+
+	_, err = runCmd(t, nil, false, "--terragrunt-cache-ttl=soon")
+
+	var optionErr config.InvalidRunCmdOptionError
+	require.ErrorAs(t, err, &optionErr)
+	assert.Equal(t, "--terragrunt-cache-ttl=soon", optionErr.Option)
+
+	// The output of --terragrunt-quiet commands is cached as well.
+	output, err = runCmd(t, nil, true, "--terragrunt-quiet", "--terragrunt-cache-ttl=10m")
+	require.NoError(t, err)
+	assert.Equal(t, "5", output)
+
+	output, err = runCmd(t, nil, false, "--terragrunt-quiet", "--terragrunt-cache-ttl=10m")
+	require.NoError(t, err)
+	assert.Equal(t, "5", output)
+
+	// Once the cache is cleared, the commands are run again.
+	opts := terragruntOptionsForTest(t, filepath.Join(workingDir, config.DefaultTerragruntConfigPath))
+	opts.RunCmdCacheDir = cacheDir
+	require.NoError(t, config.ClearRunCmdCache(context.Background(), opts))
+
+	output, err = runCmd(t, nil, false, "--terragrunt-cache-ttl=10m")
+	require.NoError(t, err)
+	assert.Equal(t, "6", output)
+}
+
+func TestRunCommandPolicy(t *testing.T) {
+	t.Parallel()
+
+	workingDir := t.TempDir()
+
+	newCtx := func(t *testing.T, setOpts func(opts *options.TerragruntOptions)) *config.ParsingContext {
+		t.Helper()
+
+		opts := terragruntOptionsForTest(t, filepath.Join(workingDir, config.DefaultTerragruntConfigPath))
+		opts.WorkingDir = workingDir
+		opts.Env = map[string]string{"PATH": os.Getenv("PATH"), "REGION": "us-east-1", "SECRET_TOKEN": "s3cr3t"}
+		setOpts(opts)
+
+		return config.NewParsingContext(context.Background(), opts)
+	}
+
+	t.Run("allowed", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := newCtx(t, func(opts *options.TerragruntOptions) {
+			opts.RunCmdAllow = []string{"echo", "./scripts/*.sh"}
+		})
+
+		output, err := config.RunCommand(ctx, []string{"--terragrunt-quiet", "echo", "hello"})
+		require.NoError(t, err)
+		assert.Equal(t, "hello", output)
+
+		_, err = config.RunCommand(ctx, []string{"/bin/echo", "hello"})
+
+		var notAllowedErr config.RunCmdNotAllowedError
+		require.ErrorAs(t, err, &notAllowedErr)
+		assert.Equal(t, "/bin/echo", notAllowedErr.Command)
+
+		_, err = config.RunCommand(ctx, []string{"./scripts/other/get.sh"})
+		require.ErrorAs(t, err, &notAllowedErr)
+	})
+
+	t.Run("not allowed in config", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := newCtx(t, func(opts *options.TerragruntOptions) {
+			opts.RunCmdAllow = []string{"echo"}
+		})
+
+		_, err := config.ParseConfigString(ctx, config.DefaultTerragruntConfigPath, `
+inputs = {
+  region = run_cmd("curl", "https://example.com")
+}
+`, nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), config.DefaultTerragruntConfigPath+":3,")
+		assert.Contains(t, err.Error(), "The command curl is not allowed to be run by run_cmd")
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := newCtx(t, func(opts *options.TerragruntOptions) {
+			opts.RunCmdTimeout = 100 * time.Millisecond
+		})
+
+		_, err := config.RunCommand(ctx, []string{"sleep", "5"})
+
+		var timeoutErr config.RunCmdTimeoutError
+		require.ErrorAs(t, err, &timeoutErr)
+		assert.Equal(t, "sleep", timeoutErr.Command)
+	})
+
+	t.Run("timeout with trapped signals", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := newCtx(t, func(opts *options.TerragruntOptions) {
+			opts.RunCmdTimeout = 100 * time.Millisecond
+		})
+
+		// The command ignores the interrupt signal, so it is killed after the grace period.
+		start := time.Now()
+		_, err := config.RunCommand(ctx, []string{"/bin/bash", "-c", "trap '' INT TERM; sleep 30; echo done"})
+
+		var timeoutErr config.RunCmdTimeoutError
+		require.ErrorAs(t, err, &timeoutErr)
+		assert.Less(t, time.Since(start), shell.TimeoutKillDelay+5*time.Second)
+	})
+
+	t.Run("strip env", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := newCtx(t, func(opts *options.TerragruntOptions) {
+			opts.RunCmdStripEnv = true
+			opts.RunCmdKeepEnv = []string{"REGION"}
+		})
+
+		output, err := config.RunCommand(ctx, []string{"/bin/bash", "-c", "echo ${REGION}-${SECRET_TOKEN}"})
+		require.NoError(t, err)
+		assert.Equal(t, "us-east-1-", output)
+	})
+
+	t.Run("strip env without variables", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := newCtx(t, func(opts *options.TerragruntOptions) {
+			opts.Env = map[string]string{}
+			opts.RunCmdStripEnv = true
+		})
+
+		// The command must not inherit the environment of Terragrunt when none of its variables is kept.
+		output, err := config.RunCommand(ctx, []string{"/usr/bin/env"})
+		require.NoError(t, err)
+		assert.Empty(t, output)
+	})
+
+	t.Run("no run_cmd", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := newCtx(t, func(opts *options.TerragruntOptions) {
+			opts.NoRunCmd = true
+			opts.RunCmdPlaceholders = map[string]string{"git": "main", "*": "placeholder"}
+		})
+
+		output, err := config.RunCommand(ctx, []string{"--terragrunt-quiet", "git", "rev-parse", "--abbrev-ref", "HEAD"})
+		require.NoError(t, err)
+		assert.Equal(t, "main", output)
+
+		output, err = config.RunCommand(ctx, []string{"./get-vpc-id.sh"})
+		require.NoError(t, err)
+		assert.Equal(t, "placeholder", output)
+	})
+}
+
 func absPath(t *testing.T, path string) string {
 	t.Helper()
 
@@ -1111,6 +1320,71 @@ func TestStartsWith(t *testing.T) {
 	}
 }
 
+func TestGitChangedSinceInvalidRef(t *testing.T) {
+	t.Parallel()
+
+	testCases := []string{
+		`git_changed_since()`,
+		`git_changed_since("")`,
+		`git_changed_since("--output=/tmp/diff")`,
+		`git_changed_since("main", "v1.0.0")`,
+	}
+
+	for _, call := range testCases {
+		t.Run(call, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := config.NewParsingContext(context.Background(), terragruntOptionsForTest(t, config.DefaultTerragruntConfigPath))
+			_, err := config.ParseConfigString(ctx, config.DefaultTerragruntConfigPath, "inputs = { changed = "+call+" }", nil)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestGitFunctionsResolveUnitFromConfigPath(t *testing.T) {
+	t.Parallel()
+
+	repoDir := t.TempDir()
+	unitDir := filepath.Join(repoDir, "unit")
+	otherDir := filepath.Join(repoDir, "other")
+	require.NoError(t, os.MkdirAll(unitDir, 0755))
+	require.NoError(t, os.MkdirAll(otherDir, 0755))
+
+	git := func(author string, args ...string) {
+		t.Helper()
+
+		cmd := exec.Command("git", append([]string{"-c", "user.name=" + author, "-c", "user.email=dev@example.com"}, args...)...)
+		cmd.Dir = repoDir
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+
+	git("", "init")
+	require.NoError(t, os.WriteFile(filepath.Join(otherDir, "main.tf"), []byte(""), 0644))
+	git("Jane Doe", "add", ".")
+	git("Jane Doe", "commit", "-m", "first")
+	git("Jane Doe", "tag", "v1.0.0")
+	require.NoError(t, os.WriteFile(filepath.Join(unitDir, config.DefaultTerragruntConfigPath), []byte(""), 0644))
+	git("John Roe", "add", ".")
+	git("John Roe", "commit", "-m", "second")
+
+	// Terragrunt is run from another directory than the one of the unit.
+	opts := terragruntOptionsForTest(t, filepath.Join(unitDir, config.DefaultTerragruntConfigPath))
+	opts.WorkingDir = otherDir
+
+	ctx := config.NewParsingContext(context.Background(), opts)
+	cfg, err := config.ParseConfigString(ctx, opts.TerragruntConfigPath, `
+inputs = {
+  author  = get_git_last_author()
+  changed = git_changed_since("v1.0.0")
+}
+`, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, "John Roe", cfg.Inputs["author"])
+	assert.Equal(t, true, cfg.Inputs["changed"])
+}
+
 func TestEndsWith(t *testing.T) {
 	t.Parallel()
 
@@ -1242,6 +1516,40 @@ func TestReadTFVarsFiles(t *testing.T) {
 	assert.False(t, locals["json_bool_var"].(bool))
 }
 
+func TestGetErrorSignals(t *testing.T) {
+	t.Parallel()
+
+	tmpDir := t.TempDir()
+
+	vpcDir := filepath.Join(tmpDir, "vpc")
+	require.NoError(t, os.MkdirAll(vpcDir, os.ModePerm))
+	require.NoError(t, os.WriteFile(filepath.Join(vpcDir, options.DefaultSignalsFile), []byte(`{"safe_to_revert": true, "reason": "quota"}`), 0644))
+
+	appDir := filepath.Join(tmpDir, "app")
+	require.NoError(t, os.MkdirAll(appDir, os.ModePerm))
+
+	configPath := filepath.Join(appDir, config.DefaultTerragruntConfigPath)
+	require.NoError(t, os.WriteFile(configPath, []byte(`
+locals {
+  vpc_signals = get_error_signals("../vpc")
+  db_signals  = get_error_signals("../db")
+}
+`), 0644))
+
+	opts := terragruntOptionsForTest(t, configPath)
+	ctx := config.NewParsingContext(context.Background(), opts)
+	tgConfigCty, err := config.ParseTerragruntConfig(ctx, configPath, nil)
+	require.NoError(t, err)
+
+	tgConfigMap, err := config.ParseCtyValueToMap(tgConfigCty)
+	require.NoError(t, err)
+
+	locals := tgConfigMap["locals"].(map[string]interface{})
+
+	assert.Equal(t, map[string]interface{}{"safe_to_revert": true, "reason": "quota"}, locals["vpc_signals"])
+	assert.Equal(t, map[string]interface{}{}, locals["db_signals"])
+}
+
 func mockConfigWithSource(sourceURL string) *config.TerragruntConfig {
 	cfg := config.TerragruntConfig{IsPartial: true}
 	cfg.Terraform = &config.TerraformConfig{Source: &sourceURL}
@@ -1276,3 +1584,49 @@ func getTrackIncludeFromTestData(includeMap map[string]config.IncludeConfig, par
 	}
 	return trackInclude
 }
+
+func TestProfileConfigFunctionCalls(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "common.hcl"), []byte(`
+locals {
+  env = run_cmd("--terragrunt-quiet", "echo", "dev")
+}
+`), 0644))
+
+	configPath := filepath.Join(dir, config.DefaultTerragruntConfigPath)
+	require.NoError(t, os.WriteFile(configPath, []byte(`
+locals {
+  common = read_terragrunt_config("common.hcl")
+}
+
+inputs = {
+  env = local.common.locals.env
+}
+`), 0644))
+
+	profiler := telemetry.NewProfiler(dir)
+	ctx := config.NewParsingContext(telemetry.ContextWithProfiler(context.Background(), profiler), mockOptionsForTestWithConfigPath(t, configPath))
+
+	terragruntConfig, err := config.ParseConfigFile(ctx, configPath, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "dev", terragruntConfig.Inputs["env"])
+
+	calls := map[string]int{}
+	for _, stat := range profiler.Top(0) {
+		calls[stat.Name+" "+stat.Subject] = stat.Calls
+	}
+
+	assert.Equal(t, 1, calls["parse_config_file terragrunt.hcl"])
+	assert.Positive(t, calls["function read_terragrunt_config"])
+	assert.Positive(t, calls["parse_config_file common.hcl"])
+	assert.Positive(t, calls["function run_cmd"])
+
+	var folded strings.Builder
+	require.NoError(t, profiler.WriteFolded(&folded))
+
+	// The config read by read_terragrunt_config is nested in the call, and its run_cmd call in its parsing.
+	assert.Contains(t, folded.String(), "parse_config_file terragrunt.hcl;function read_terragrunt_config;parse_config_file common.hcl;function run_cmd")
+}
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -18,6 +19,7 @@ import (
 
 	"github.com/gruntwork-io/terragrunt/config/hclparse"
 	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/telemetry"
 	"github.com/gruntwork-io/terragrunt/util"
 )
 
@@ -37,6 +39,7 @@ const (
 	FeatureFlagsBlock
 	ExcludeBlock
 	ErrorsBlock
+	StackHooksBlock
 )
 
 // terragruntIncludeMultiple is a struct that can be used to only decode the include block with labels.
@@ -57,6 +60,12 @@ type terragruntFeatureFlags struct {
 	Remain       hcl.Body     `hcl:",remain"`
 }
 
+// terragruntStackHooks struct to decode stack_hooks block
+type terragruntStackHooks struct {
+	StackHooks *StackHooksConfig `hcl:"stack_hooks,block"`
+	Remain     hcl.Body          `hcl:",remain"`
+}
+
 // terragruntErrors struct to decode errors block
 type terragruntErrors struct {
 	Errors *ErrorsConfig `hcl:"errors,block"`
@@ -124,7 +133,11 @@ type terragruntInputs struct {
 // - locals
 // - features
 // - include
+// - function
 func DecodeBaseBlocks(ctx *ParsingContext, file *hclparse.File, includeFromChild *IncludeConfig) (*DecodedBaseBlocks, error) {
+	// The user functions of the config are not decoded yet, and those of the context belong to another config.
+	ctx = ctx.WithUserFunctions(nil)
+
 	evalParsingContext, err := createTerragruntEvalContext(ctx, file.ConfigPath)
 	if err != nil {
 		return nil, err
@@ -144,6 +157,20 @@ func DecodeBaseBlocks(ctx *ParsingContext, file *hclparse.File, includeFromChild
 		return nil, err
 	}
 
+	// Decode the `function` blocks, which may be called by the locals.
+	userFunctions, err := decodeUserFunctions(ctx, file, evalParsingContext, trackInclude)
+	if err != nil {
+		return nil, err
+	}
+
+	for name, fn := range userFunctions {
+		if _, ok := evalParsingContext.Functions[name]; ok {
+			return nil, errors.New(UserFunctionNameConflictError{ConfigPath: fn.ConfigPath, Name: name})
+		}
+	}
+
+	ctx = ctx.WithUserFunctions(userFunctions.Functions())
+
 	// set feature flags
 	tgFlags := terragruntFeatureFlags{}
 	// load default feature flags
@@ -182,9 +209,10 @@ func DecodeBaseBlocks(ctx *ParsingContext, file *hclparse.File, includeFromChild
 	}
 
 	return &DecodedBaseBlocks{
-		TrackInclude: trackInclude,
-		Locals:       &localsAsCtyVal,
-		FeatureFlags: &flagsAsCtyVal,
+		TrackInclude:  trackInclude,
+		Locals:        &localsAsCtyVal,
+		FeatureFlags:  &flagsAsCtyVal,
+		UserFunctions: ctx.UserFunctions,
 	}, nil
 }
 
@@ -262,28 +290,45 @@ func cliFlagsToCty(ctx *ParsingContext, flagByName map[string]*FeatureFlag) (map
 }
 
 func PartialParseConfigFile(ctx *ParsingContext, configPath string, include *IncludeConfig) (*TerragruntConfig, error) {
-	hclCache := cache.ContextCache[*hclparse.File](ctx, HclCacheContextKey)
+	var config *TerragruntConfig
 
-	fileInfo, err := os.Stat(configPath)
-	if err != nil {
-		return nil, errors.New(err)
-	}
+	hclCache := cache.ContextCache[*hclparse.File](ctx, HclCacheContextKey)
 
-	var (
-		file     *hclparse.File
-		cacheKey = fmt.Sprintf("configPath-%v-modTime-%v", configPath, fileInfo.ModTime().UnixMicro())
-	)
+	err := telemetry.Telemetry(ctx, ctx.TerragruntOptions, "partial_parse_config_file", map[string]interface{}{
+		"config_path":         configPath,
+		"decode_list":         fmt.Sprintf("%v", ctx.PartialParseDecodeList),
+		telemetry.SubjectAttr: configPath,
+	}, func(childCtx context.Context) error {
+		ctx := ctx.WithContext(childCtx)
 
-	if cacheConfig, found := hclCache.Get(ctx, cacheKey); found {
-		file = cacheConfig
-	} else {
-		file, err = hclparse.NewParser(ctx.ParserOptions...).ParseFromFile(configPath)
+		fileInfo, err := os.Stat(configPath)
 		if err != nil {
-			return nil, err
+			return errors.New(err)
+		}
+
+		var (
+			file     *hclparse.File
+			cacheKey = fmt.Sprintf("configPath-%v-modTime-%v", configPath, fileInfo.ModTime().UnixMicro())
+		)
+
+		if cacheConfig, found := hclCache.Get(ctx, cacheKey); found {
+			file = cacheConfig
+		} else {
+			file, err = hclparse.NewParser(ctx.ParserOptions...).ParseFromFile(configPath)
+			if err != nil {
+				return err
+			}
 		}
+
+		config, err = TerragruntConfigFromPartialConfig(ctx, file, include)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return TerragruntConfigFromPartialConfig(ctx, file, include)
+	return config, nil
 }
 
 // TerragruntConfigFromPartialConfig is a wrapper of PartialParseConfigString which checks for cached configs.
@@ -330,6 +375,7 @@ func TerragruntConfigFromPartialConfig(ctx *ParsingContext, file *hclparse.File,
 //   - RemoteStateBlock: Parses the `remote_state` block in the config
 //   - FeatureFlagsBlock: Parses the `feature` block in the config
 //   - ExcludeBlock : Parses the `exclude` block in the config
+//   - StackHooksBlock : Parses the `stack_hooks` block in the config
 //
 // Note that the following blocks are always decoded:
 // - locals
@@ -350,7 +396,7 @@ func PartialParseConfig(ctx *ParsingContext, file *hclparse.File, includeFromChi
 
 	// read unit files and add to context
 	if ctx.TerragruntOptions.Experiments.Evaluate(experiment.Stacks) {
-		unitValues, err := ReadUnitValues(ctx.Context, ctx.TerragruntOptions, filepath.Dir(file.ConfigPath))
+		unitValues, err := readCheckedUnitValues(ctx, file)
 		if err != nil {
 			return nil, err
 		}
@@ -367,6 +413,7 @@ func PartialParseConfig(ctx *ParsingContext, file *hclparse.File, includeFromChi
 
 	ctx = ctx.WithTrackInclude(baseBlocks.TrackInclude)
 	ctx = ctx.WithFeatures(baseBlocks.FeatureFlags)
+	ctx = ctx.WithUserFunctions(baseBlocks.UserFunctions)
 	ctx = ctx.WithLocals(baseBlocks.Locals)
 
 	// Set parsed Locals on the parsed config
@@ -598,6 +645,22 @@ func PartialParseConfig(ctx *ParsingContext, file *hclparse.File, includeFromChi
 				output.Errors = decoded.Errors
 			}
 
+		case StackHooksBlock:
+			decoded := terragruntStackHooks{}
+			err := file.Decode(&decoded, evalParsingContext)
+
+			if err != nil {
+				return nil, err
+			}
+
+			decoded.StackHooks.resolveWorkingDirs(filepath.Dir(file.ConfigPath))
+
+			if output.StackHooks != nil {
+				output.StackHooks.Merge(decoded.StackHooks)
+			} else {
+				output.StackHooks = decoded.StackHooks
+			}
+
 		default:
 			return nil, InvalidPartialBlockName{decode}
 		}
@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/gruntwork-io/terragrunt/config"
 	"github.com/gruntwork-io/terragrunt/internal/errors"
@@ -250,6 +251,238 @@ func TestParseTerragruntJsonConfigRetryConfiguration(t *testing.T) {
 	}
 }
 
+func TestParseTerragruntConfigHookTimeoutEnvAndOutputFile(t *testing.T) {
+	t.Parallel()
+
+	cfg := `
+terraform {
+  before_hook "policy" {
+    commands    = ["plan"]
+    execute     = ["./check.sh"]
+    timeout     = "5m"
+    env         = { POLICY_DIR = "policies" }
+    output_file = "policy.json"
+  }
+}
+`
+	ctx := config.NewParsingContext(context.Background(), mockOptionsForTest(t))
+	terragruntConfig, err := config.ParseConfigString(ctx, config.DefaultTerragruntConfigPath, cfg, nil)
+	require.NoError(t, err)
+
+	hooks := terragruntConfig.Terraform.GetBeforeHooks()
+	require.Len(t, hooks, 1)
+	assert.Equal(t, 5*time.Minute, hooks[0].GetTimeout())
+	assert.Equal(t, map[string]string{"POLICY_DIR": "policies"}, hooks[0].GetEnv())
+	assert.Equal(t, "policy.json", *hooks[0].OutputFile)
+}
+
+func TestParseTerragruntConfigHookInvalidTimeout(t *testing.T) {
+	t.Parallel()
+
+	cfg := `
+terraform {
+  after_hook "slow" {
+    commands = ["apply"]
+    execute  = ["./slow.sh"]
+    timeout  = "forever"
+  }
+}
+`
+	ctx := config.NewParsingContext(context.Background(), mockOptionsForTest(t))
+	_, err := config.ParseConfigString(ctx, config.DefaultTerragruntConfigPath, cfg, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `Invalid 'timeout' "forever"`)
+}
+
+func TestParseTerragruntConfigErrorHookTimeoutAndEnv(t *testing.T) {
+	t.Parallel()
+
+	cfg := `
+terraform {
+  error_hook "notify" {
+    commands  = ["apply"]
+    execute   = ["./notify.sh"]
+    on_errors = [".*"]
+    timeout   = "30s"
+    env       = { CHANNEL = "alerts" }
+  }
+}
+`
+	ctx := config.NewParsingContext(context.Background(), mockOptionsForTest(t))
+	terragruntConfig, err := config.ParseConfigString(ctx, config.DefaultTerragruntConfigPath, cfg, nil)
+	require.NoError(t, err)
+
+	hooks := terragruntConfig.Terraform.GetErrorHooks()
+	require.Len(t, hooks, 1)
+	assert.Equal(t, 30*time.Second, hooks[0].GetTimeout())
+	assert.Equal(t, map[string]string{"CHANNEL": "alerts"}, hooks[0].GetEnv())
+}
+
+func TestParseTerragruntConfigStackHooksRelativeWorkingDir(t *testing.T) {
+	t.Parallel()
+
+	cfg := `
+stack_hooks {
+  before_all "lock" {
+    execute     = ["./lock.sh"]
+    working_dir = "scripts"
+  }
+}
+`
+	configPath := filepath.Join(t.TempDir(), "root.hcl")
+
+	ctx := config.NewParsingContext(context.Background(), mockOptionsForTest(t))
+	terragruntConfig, err := config.ParseConfigString(ctx, configPath, cfg, nil)
+	require.NoError(t, err)
+
+	beforeAll := terragruntConfig.StackHooks.GetBeforeAll()
+	require.Len(t, beforeAll, 1)
+	require.NotNil(t, beforeAll[0].WorkingDir)
+	assert.Equal(t, filepath.Join(filepath.Dir(configPath), "scripts"), *beforeAll[0].WorkingDir)
+}
+
+func TestParseTerragruntConfigPlanJSONHookRequiresPlan(t *testing.T) {
+	t.Parallel()
+
+	cfg := `
+terraform {
+  after_hook "policy" {
+    commands  = ["apply"]
+    execute   = ["./check.sh"]
+    plan_json = true
+  }
+}
+`
+	ctx := config.NewParsingContext(context.Background(), mockOptionsForTest(t))
+	_, err := config.ParseConfigString(ctx, config.DefaultTerragruntConfigPath, cfg, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "'plan_json' requires the hook to run after the 'plan' command")
+}
+
+func TestParseTerragruntConfigStackHooks(t *testing.T) {
+	t.Parallel()
+
+	cfg := `
+stack_hooks {
+  before_all "lock" {
+    commands = ["apply", "destroy"]
+    execute  = ["./lock.sh"]
+  }
+
+  after_all "notify" {
+    execute     = ["./notify.sh", "--channel", "infra"]
+    working_dir = "/tmp"
+  }
+
+  on_error_all "page" {
+    execute = ["./page.sh"]
+  }
+}
+`
+	ctx := config.NewParsingContext(context.Background(), mockOptionsForTest(t))
+	terragruntConfig, err := config.ParseConfigString(ctx, config.DefaultTerragruntConfigPath, cfg, nil)
+	require.NoError(t, err)
+	require.NotNil(t, terragruntConfig.StackHooks)
+
+	beforeAll := terragruntConfig.StackHooks.GetBeforeAll()
+	require.Len(t, beforeAll, 1)
+	assert.Equal(t, "lock", beforeAll[0].Label)
+	assert.True(t, beforeAll[0].RunsFor("apply"))
+	assert.False(t, beforeAll[0].RunsFor("plan"))
+
+	afterAll := terragruntConfig.StackHooks.GetAfterAll()
+	require.Len(t, afterAll, 1)
+	assert.Equal(t, []string{"./notify.sh", "--channel", "infra"}, afterAll[0].Execute)
+	assert.True(t, afterAll[0].RunsFor("plan"))
+	require.NotNil(t, afterAll[0].WorkingDir)
+	assert.Equal(t, "/tmp", *afterAll[0].WorkingDir)
+
+	onErrorAll := terragruntConfig.StackHooks.GetOnErrorAll()
+	require.Len(t, onErrorAll, 1)
+	assert.Equal(t, "page", onErrorAll[0].Label)
+}
+
+func TestParseTerragruntConfigStackHooksEmptyExecute(t *testing.T) {
+	t.Parallel()
+
+	cfg := `
+stack_hooks {
+  before_all "lock" {
+    execute = []
+  }
+}
+`
+	ctx := config.NewParsingContext(context.Background(), mockOptionsForTest(t))
+	_, err := config.ParseConfigString(ctx, config.DefaultTerragruntConfigPath, cfg, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Error with stack hook lock")
+}
+
+func TestParseTerragruntConfigErrorsRetryBackoff(t *testing.T) {
+	t.Parallel()
+
+	cfg := `
+errors {
+  retry "rate_limit" {
+    retryable_errors     = [".*Throttling.*"]
+    max_attempts         = 6
+    sleep_interval_sec   = 5
+    backoff              = "exponential"
+    max_sleep_sec        = 30
+    before_retry         = ["init", "-reconfigure"]
+    before_retry_execute = ["./refresh-credentials.sh", "--profile", "dev"]
+  }
+}
+`
+	ctx := config.NewParsingContext(context.Background(), mockOptionsForTest(t))
+	terragruntConfig, err := config.ParseConfigString(ctx, config.DefaultTerragruntConfigPath, cfg, nil)
+	require.NoError(t, err)
+
+	errorsConfig, err := terragruntConfig.ErrorsConfig()
+	require.NoError(t, err)
+
+	retry := errorsConfig.Retry["rate_limit"]
+	require.NotNil(t, retry)
+	assert.Equal(t, options.ExponentialBackoff, retry.Backoff)
+	assert.Equal(t, []string{"init", "-reconfigure"}, retry.BeforeRetry)
+	assert.Equal(t, []string{"./refresh-credentials.sh", "--profile", "dev"}, retry.BeforeRetryExec)
+
+	expected := []time.Duration{5 * time.Second, 10 * time.Second, 20 * time.Second, 30 * time.Second, 30 * time.Second}
+	for i, interval := range expected {
+		assert.Equal(t, interval, retry.SleepInterval(i+1))
+	}
+
+	retry.Jitter = true
+	for attempt := 1; attempt <= 5; attempt++ {
+		assert.GreaterOrEqual(t, retry.SleepInterval(attempt), expected[attempt-1]/2)
+		assert.LessOrEqual(t, retry.SleepInterval(attempt), expected[attempt-1])
+	}
+}
+
+func TestParseTerragruntConfigErrorsRetryInvalidBackoff(t *testing.T) {
+	t.Parallel()
+
+	cfg := `
+errors {
+  retry "rate_limit" {
+    retryable_errors   = [".*Throttling.*"]
+    max_attempts       = 3
+    sleep_interval_sec = 5
+    backoff            = "fibonacci"
+  }
+}
+`
+	ctx := config.NewParsingContext(context.Background(), mockOptionsForTest(t))
+	terragruntConfig, err := config.ParseConfigString(ctx, config.DefaultTerragruntConfigPath, cfg, nil)
+	require.NoError(t, err)
+
+	_, err = terragruntConfig.ErrorsConfig()
+
+	var backoffErr config.InvalidRetryBackoffError
+	require.ErrorAs(t, err, &backoffErr)
+	assert.Equal(t, "fibonacci", backoffErr.Backoff)
+}
+
 func TestParseIamRole(t *testing.T) {
 	t.Parallel()
 
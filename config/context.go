@@ -14,11 +14,13 @@ const (
 	TerragruntConfigCacheContextKey configKey = iota
 	RunCmdCacheContextKey           configKey = iota
 	DependencyOutputCacheContextKey configKey = iota
+	SecretCacheContextKey           configKey = iota
 
 	hclCacheName              = "hclCache"
 	configCacheName           = "configCache"
 	runCmdCacheName           = "runCmdCache"
 	dependencyOutputCacheName = "dependencyOutputCache"
+	secretCacheName           = "secretCache"
 )
 
 // WithConfigValues add to context default values for configuration.
@@ -27,6 +29,7 @@ func WithConfigValues(ctx context.Context) context.Context {
 	ctx = context.WithValue(ctx, TerragruntConfigCacheContextKey, cache.NewCache[*TerragruntConfig](configCacheName))
 	ctx = context.WithValue(ctx, RunCmdCacheContextKey, cache.NewCache[string](runCmdCacheName))
 	ctx = context.WithValue(ctx, DependencyOutputCacheContextKey, cache.NewCache[*dependencyOutputCache](dependencyOutputCacheName))
+	ctx = context.WithValue(ctx, SecretCacheContextKey, cache.NewCache[string](secretCacheName))
 
 	return ctx
 }
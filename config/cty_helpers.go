@@ -97,6 +97,24 @@ func wrapVoidToStringAsFuncImpl(
 	})
 }
 
+// Create a cty Function that takes no input parameters and returns as output a bool. The implementation of the
+// function calls the given toWrap function, passing it the given include and terragruntOptions.
+func wrapVoidToBoolAsFuncImpl(
+	ctx *ParsingContext,
+	toWrap func(ctx *ParsingContext) (bool, error),
+) function.Function {
+	return function.New(&function.Spec{
+		Type: function.StaticReturnType(cty.Bool),
+		Impl: func(args []cty.Value, retType cty.Type) (cty.Value, error) {
+			out, err := toWrap(ctx)
+			if err != nil {
+				return cty.BoolVal(false), err
+			}
+			return cty.BoolVal(out), nil
+		},
+	})
+}
+
 // Create a cty Function that takes no input parameters and returns as output an empty string.
 func wrapVoidToEmptyStringAsFuncImpl() function.Function {
 	return function.New(&function.Spec{
@@ -230,6 +248,9 @@ func deepMergeCtyMapsMapOnly(target cty.Value, source cty.Value, opts ...func(*m
 // we convert the given value to JSON using cty's JSON library and then convert the JSON back to a
 // map[string]interface{} using the Go json library.
 func ParseCtyValueToMap(value cty.Value) (map[string]interface{}, error) {
+	// The marks, such as the sensitive marks, cannot be serialized as JSON.
+	value, _ = value.UnmarkDeep()
+
 	updatedValue, err := UpdateUnknownCtyValValues(value)
 	if err != nil {
 		return nil, err
@@ -337,7 +358,7 @@ func includeConfigAsCtyVal(ctx *ParsingContext, includeConfig IncludeConfig) (ct
 			return cty.NilVal, err
 		}
 
-		return parsedIncludedCty, nil
+		return parsedIncluded.MarkSensitiveValues(parsedIncludedCty), nil
 	}
 
 	return cty.NilVal, nil
@@ -3,6 +3,7 @@ package config
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -32,6 +33,7 @@ import (
 	"github.com/gruntwork-io/terragrunt/internal/errors"
 	"github.com/gruntwork-io/terragrunt/options"
 	"github.com/gruntwork-io/terragrunt/remote"
+	"github.com/gruntwork-io/terragrunt/telemetry"
 	"github.com/gruntwork-io/terragrunt/tf"
 	"github.com/gruntwork-io/terragrunt/util"
 )
@@ -161,12 +163,25 @@ func (dep *Dependency) setRenderedOutputs(ctx *ParsingContext) error {
 	}
 
 	if dep.shouldGetOutputs(ctx) || dep.shouldReturnMockOutputs(ctx) {
-		outputVal, err := getTerragruntOutputIfAppliedElseConfiguredDefault(ctx, *dep)
-		if err != nil {
-			return err
+		var targetConfigPath string
+		if dep.ConfigPath.IsKnown() && !dep.ConfigPath.IsNull() && dep.ConfigPath.Type().Equals(cty.String) {
+			targetConfigPath = getCleanedTargetConfigPath(dep.ConfigPath.AsString(), ctx.TerragruntOptions.TerragruntConfigPath)
 		}
 
-		dep.RenderedOutputs = outputVal
+		return telemetry.Telemetry(ctx, ctx.TerragruntOptions, "dependency_output", map[string]interface{}{
+			"dependency":          dep.Name,
+			"config_path":         targetConfigPath,
+			telemetry.SubjectAttr: targetConfigPath,
+		}, func(childCtx context.Context) error {
+			outputVal, err := getTerragruntOutputIfAppliedElseConfiguredDefault(ctx.WithContext(childCtx), *dep)
+			if err != nil {
+				return err
+			}
+
+			dep.RenderedOutputs = outputVal
+
+			return nil
+		})
 	}
 
 	return nil
@@ -480,16 +495,28 @@ func getTerragruntOutputIfAppliedElseConfiguredDefault(ctx *ParsingContext, depe
 			mockMergeStrategy := dependencyConfig.getMockOutputsMergeStrategy()
 
 			// TODO: Make this exhaustive
+			var (
+				mergedVal *cty.Value
+				err       error
+			)
+
 			switch mockMergeStrategy { // nolint:exhaustive
 			case NoMerge:
 				return outputVal, nil
 			case ShallowMerge:
-				return shallowMergeCtyMaps(*outputVal, *dependencyConfig.MockOutputs)
+				mergedVal, err = shallowMergeCtyMaps(*outputVal, *dependencyConfig.MockOutputs)
 			case DeepMergeMapOnly:
-				return deepMergeCtyMapsMapOnly(*dependencyConfig.MockOutputs, *outputVal)
+				mergedVal, err = deepMergeCtyMapsMapOnly(*dependencyConfig.MockOutputs, *outputVal)
 			default:
 				return nil, errors.New(InvalidMergeStrategyTypeError(mockMergeStrategy))
 			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			// The merge goes through JSON, which drops the marks of the sensitive outputs.
+			return markSensitiveOutputs(*mergedVal, *outputVal), nil
 		} else if !isEmpty {
 			return outputVal, err
 		}
@@ -851,7 +878,7 @@ func getTerragruntOutputJSONFromInitFolder(ctx *ParsingContext, terraformWorking
 	jsonString := strings.TrimSpace(out.Stdout.String())
 	jsonBytes := []byte(jsonString)
 
-	ctx.TerragruntOptions.Logger.Debugf("Retrieved output from %s as json: %s", targetConfigPath, jsonString)
+	ctx.TerragruntOptions.Logger.Debugf("Retrieved output from %s as json: %s", targetConfigPath, redactOutputJSON(jsonBytes))
 
 	return jsonBytes, nil
 }
@@ -913,7 +940,7 @@ func getTerragruntOutputJSONFromRemoteState(
 				return nil, err
 			}
 
-			ctx.TerragruntOptions.Logger.Debugf("Retrieved output from %s as json: %s using s3 bucket", targetTGOptions.TerragruntConfigPath, jsonBytes)
+			ctx.TerragruntOptions.Logger.Debugf("Retrieved output from %s as json: %s using s3 bucket", targetTGOptions.TerragruntConfigPath, redactOutputJSON(jsonBytes))
 
 			return jsonBytes, nil
 		default:
@@ -957,7 +984,7 @@ func getTerragruntOutputJSONFromRemoteState(
 
 	jsonString := strings.TrimSpace(out.Stdout.String())
 	jsonBytes := []byte(jsonString)
-	ctx.TerragruntOptions.Logger.Debugf("Retrieved output from %s as json: %s", targetConfigPath, jsonString)
+	ctx.TerragruntOptions.Logger.Debugf("Retrieved output from %s as json: %s", targetConfigPath, redactOutputJSON(jsonBytes))
 
 	return jsonBytes, nil
 }
@@ -1072,13 +1099,13 @@ func runTerragruntOutputJSON(ctx *ParsingContext, targetConfig string) ([]byte,
 	jsonString := strings.TrimSpace(stdoutBuffer.String())
 	jsonBytes := []byte(jsonString)
 
-	ctx.TerragruntOptions.Logger.Debugf("Retrieved output from %s as json: %s", targetConfig, jsonString)
+	ctx.TerragruntOptions.Logger.Debugf("Retrieved output from %s as json: %s", targetConfig, redactOutputJSON(jsonBytes))
 
 	return jsonBytes, nil
 }
 
 // TerraformOutputJSONToCtyValueMap takes the terraform output json and converts to a mapping between output keys to the
-// parsed cty.Value encoding of the json objects.
+// parsed cty.Value encoding of the json objects. The sensitive outputs are marked with MarkSensitive.
 func TerraformOutputJSONToCtyValueMap(targetConfigPath string, jsonBytes []byte) (map[string]cty.Value, error) {
 	// When getting all outputs, terraform returns a json with the data containing metadata about the types, so we
 	// can't quite return the data directly. Instead, we will need further processing to get the output we want.
@@ -1109,12 +1136,58 @@ func TerraformOutputJSONToCtyValueMap(targetConfigPath string, jsonBytes []byte)
 			return nil, errors.New(TerragruntOutputParsingError{Path: targetConfigPath, Err: err})
 		}
 
+		if v.Sensitive {
+			outputVal = outputVal.Mark(MarkSensitive)
+		}
+
 		flattenedOutput[k] = outputVal
 	}
 
 	return flattenedOutput, nil
 }
 
+// markSensitiveOutputs marks the outputs of the merged value that are sensitive in the outputs read from the state.
+func markSensitiveOutputs(mergedVal cty.Value, outputVal cty.Value) *cty.Value {
+	if !mergedVal.Type().IsObjectType() || !outputVal.Type().IsObjectType() {
+		return &mergedVal
+	}
+
+	outputs := mergedVal.AsValueMap()
+
+	for name, val := range outputVal.AsValueMap() {
+		if merged, ok := outputs[name]; ok && val.HasMark(MarkSensitive) {
+			outputs[name] = merged.Mark(MarkSensitive)
+		}
+	}
+
+	mergedVal = cty.ObjectVal(outputs)
+
+	return &mergedVal
+}
+
+// redactOutputJSON returns the terraform output json with the values of the sensitive outputs replaced with
+// RedactedValue, to be logged.
+func redactOutputJSON(jsonBytes []byte) string {
+	var outputs map[string]map[string]any
+
+	if err := json.Unmarshal(jsonBytes, &outputs); err != nil {
+		return string(jsonBytes)
+	}
+
+	for _, output := range outputs {
+		if sensitive, _ := output["sensitive"].(bool); sensitive {
+			output["value"] = RedactedValue
+		}
+	}
+
+	redacted, err := json.Marshal(outputs)
+	if err != nil {
+		return string(jsonBytes)
+	}
+
+	return string(redacted)
+}
+
 // ClearOutputCache clears the output cache. Useful during testing.
 func ClearOutputCache() {
 	jsonOutputCache = sync.Map{}
@@ -6,19 +6,21 @@ import (
 
 // EngineConfig represents the structure of the HCL data
 type EngineConfig struct {
-	Source  string     `hcl:"source,attr" cty:"source"`
-	Version *string    `hcl:"version,attr" cty:"version"`
-	Type    *string    `hcl:"type,attr" cty:"type"`
-	Meta    *cty.Value `hcl:"meta,attr" cty:"meta"`
+	Source   string     `hcl:"source,attr" cty:"source"`
+	Version  *string    `hcl:"version,attr" cty:"version"`
+	Type     *string    `hcl:"type,attr" cty:"type"`
+	Checksum *string    `hcl:"checksum,attr" cty:"checksum"`
+	Meta     *cty.Value `hcl:"meta,attr" cty:"meta"`
 }
 
 // Clone returns a copy of the EngineConfig used in deep copy
 func (c *EngineConfig) Clone() *EngineConfig {
 	return &EngineConfig{
-		Source:  c.Source,
-		Version: c.Version,
-		Type:    c.Type,
-		Meta:    c.Meta,
+		Source:   c.Source,
+		Version:  c.Version,
+		Type:     c.Type,
+		Checksum: c.Checksum,
+		Meta:     c.Meta,
 	}
 }
 
@@ -36,6 +38,10 @@ func (c *EngineConfig) Merge(engine *EngineConfig) {
 		c.Type = engine.Type
 	}
 
+	if engine.Checksum != nil {
+		c.Checksum = engine.Checksum
+	}
+
 	if engine.Meta != nil {
 		c.Meta = engine.Meta
 	}
@@ -3,6 +3,12 @@ package config
 import (
 	"fmt"
 	"strings"
+	"time"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/gruntwork-io/terragrunt/options"
 )
 
 // Custom error types
@@ -48,6 +54,15 @@ func (err InvalidMergeStrategyTypeError) Error() string {
 	)
 }
 
+type InvalidRetryBackoffError struct {
+	Block   string
+	Backoff string
+}
+
+func (err InvalidRetryBackoffError) Error() string {
+	return fmt.Sprintf("Retry block %q has unknown backoff %q. Valid backoffs are: %s, %s, %s", err.Block, err.Backoff, options.ConstantBackoff, options.LinearBackoff, options.ExponentialBackoff)
+}
+
 type DependencyDirNotFoundError struct {
 	Dir []string
 }
@@ -272,3 +287,209 @@ type DependencyCycleError []string
 func (err DependencyCycleError) Error() string {
 	return "Found a dependency cycle between modules: " + strings.Join([]string(err), " -> ")
 }
+
+// InputTypeError is returned when an input does not match the type constraint of its variable.
+type InputTypeError struct {
+	Name    string
+	Path    cty.Path
+	Message string
+}
+
+func (err InputTypeError) Error() string {
+	return fmt.Sprintf("%s.%s%s: %s", MetadataInputs, err.Name, formatCtyPath(err.Path), err.Message)
+}
+
+// InputValidationError is returned when an input does not match a validation of its variable.
+type InputValidationError struct {
+	Name    string
+	Message string
+}
+
+func (err InputValidationError) Error() string {
+	return fmt.Sprintf("%s.%s: %s", MetadataInputs, err.Name, err.Message)
+}
+
+// formatCtyPath formats the path in the HCL syntax, such as `.node_groups[2].instance_type`.
+func formatCtyPath(path cty.Path) string {
+	var sb strings.Builder
+
+	for _, step := range path {
+		switch step := step.(type) {
+		case cty.GetAttrStep:
+			sb.WriteString("." + step.Name)
+		case cty.IndexStep:
+			switch {
+			case !step.Key.IsKnown() || step.Key.IsNull():
+				sb.WriteString("[...]")
+			case step.Key.Type() == cty.String:
+				fmt.Fprintf(&sb, "[%q]", step.Key.AsString())
+			case step.Key.Type() == cty.Number:
+				fmt.Fprintf(&sb, "[%s]", step.Key.AsBigFloat().Text('f', -1))
+			default:
+				sb.WriteString("[...]")
+			}
+		}
+	}
+
+	return sb.String()
+}
+
+// InvalidUserFunctionError is returned when a `function` block is not valid.
+type InvalidUserFunctionError struct {
+	ConfigPath string
+	Name       string
+	Reason     string
+}
+
+func (err InvalidUserFunctionError) Error() string {
+	return fmt.Sprintf("Invalid function %q in %s: %s", err.Name, err.ConfigPath, err.Reason)
+}
+
+// UserFunctionCycleError is returned when user functions call themselves, directly or through other functions.
+type UserFunctionCycleError []string
+
+func (err UserFunctionCycleError) Error() string {
+	return "Found a cycle between functions: " + strings.Join([]string(err), " -> ")
+}
+
+// UserFunctionNameConflictError is returned when a user function has the name of a built-in function.
+type UserFunctionNameConflictError struct {
+	ConfigPath string
+	Name       string
+}
+
+func (err UserFunctionNameConflictError) Error() string {
+	return fmt.Sprintf("Function %q declared in %s conflicts with the built-in function of the same name", err.Name, err.ConfigPath)
+}
+
+// InvalidRunCmdOptionError is returned when an option of `run_cmd`, such as `--terragrunt-cache-ttl`, is not valid.
+type InvalidRunCmdOptionError struct {
+	Option string
+	Reason string
+}
+
+func (err InvalidRunCmdOptionError) Error() string {
+	return fmt.Sprintf("Invalid run_cmd option %s: %s", err.Option, err.Reason)
+}
+
+type UnsupportedIncludeMergeRuleError struct {
+	Path      string
+	Supported []string
+}
+
+func (err UnsupportedIncludeMergeRuleError) Error() string {
+	return fmt.Sprintf("The merge_rules of include do not support %s. Supported attributes and blocks: %s", err.Path, strings.Join(err.Supported, ", "))
+}
+
+type InvalidIncludeMergeRuleError struct {
+	Path      string
+	Strategy  string
+	Supported []string
+}
+
+func (err InvalidIncludeMergeRuleError) Error() string {
+	return fmt.Sprintf("Invalid merge strategy %q for %s in the merge_rules of include. Supported strategies: %s", err.Strategy, err.Path, strings.Join(err.Supported, ", "))
+}
+
+type InvalidIncludeRemoveBlockError struct {
+	Block     string
+	Supported []string
+}
+
+func (err InvalidIncludeRemoveBlockError) Error() string {
+	return fmt.Sprintf("Invalid block %s in the remove_blocks of include. Expected <block type>.<name>, where the supported block types are: %s", err.Block, strings.Join(err.Supported, ", "))
+}
+
+type ConfigValueNotSetError struct {
+	Path string
+}
+
+func (err ConfigValueNotSetError) Error() string {
+	return fmt.Sprintf("%s is not set in the configuration", err.Path)
+}
+
+type RunCmdNotAllowedError struct {
+	Command string
+	Allowed []string
+}
+
+func (err RunCmdNotAllowedError) Error() string {
+	return fmt.Sprintf("The command %s is not allowed to be run by run_cmd. Allowed executables with --run-cmd-allow: %s", err.Command, strings.Join(err.Allowed, ", "))
+}
+
+type RunCmdTimeoutError struct {
+	Command string
+	Timeout time.Duration
+}
+
+func (err RunCmdTimeoutError) Error() string {
+	return fmt.Sprintf("The command %s run by run_cmd did not complete within the timeout of %s set with --run-cmd-timeout", err.Command, err.Timeout)
+}
+
+// UnitValuesError is returned when the values of a unit do not match the `values` schema of the unit. The range is
+// the one of the `unit` block of the stack file setting the values, if known.
+type UnitValuesError struct {
+	Unit  string
+	Range hcl.Range
+	Errs  []error
+}
+
+func (err UnitValuesError) Error() string {
+	msgs := make([]string, 0, len(err.Errs))
+
+	for _, valueErr := range err.Errs {
+		msgs = append(msgs, valueErr.Error())
+	}
+
+	if err.Unit == "" {
+		return fmt.Sprintf("%s: invalid unit values:\n  %s", err.Range, strings.Join(msgs, "\n  "))
+	}
+
+	return fmt.Sprintf("%s: invalid values for unit %q:\n  %s", err.Range, err.Unit, strings.Join(msgs, "\n  "))
+}
+
+// UnitValueTypeError is returned when a value of a unit does not match its type in the `values` schema of the unit.
+type UnitValueTypeError struct {
+	Name    string
+	Path    cty.Path
+	Message string
+}
+
+func (err UnitValueTypeError) Error() string {
+	return fmt.Sprintf("%s.%s%s: %s", MetadataValues, err.Name, formatCtyPath(err.Path), err.Message)
+}
+
+// UnitValueValidationError is returned when a value of a unit does not match a validation in the `values` schema of the unit.
+type UnitValueValidationError struct {
+	Name    string
+	Message string
+}
+
+func (err UnitValueValidationError) Error() string {
+	return fmt.Sprintf("%s.%s: %s", MetadataValues, err.Name, err.Message)
+}
+
+type UnitValueRequiredError struct {
+	Name string
+}
+
+func (err UnitValueRequiredError) Error() string {
+	return fmt.Sprintf("%s.%s: the value is required by the unit, but is not set", MetadataValues, err.Name)
+}
+
+type UnitValueNotDeclaredError struct {
+	Name string
+}
+
+func (err UnitValueNotDeclaredError) Error() string {
+	return fmt.Sprintf("%s.%s: the value is not declared in the values block of the unit", MetadataValues, err.Name)
+}
+
+type UnitValueInvalidConditionError struct {
+	Name  string
+	Range hcl.Range
+}
+
+func (err UnitValueInvalidConditionError) Error() string {
+	return fmt.Sprintf("%s: the condition of the validation of %s.%s must be a known bool", err.Range, MetadataValues, err.Name)
+}
@@ -17,6 +17,11 @@ type RetryBlock struct {
 	RetryableErrors  []string `cty:"retryable_errors" hcl:"retryable_errors"`
 	MaxAttempts      int      `cty:"max_attempts" hcl:"max_attempts"`
 	SleepIntervalSec int      `cty:"sleep_interval_sec" hcl:"sleep_interval_sec"`
+	Backoff          *string  `cty:"backoff" hcl:"backoff,optional"`
+	MaxSleepSec      *int     `cty:"max_sleep_sec" hcl:"max_sleep_sec,optional"`
+	Jitter           *bool    `cty:"jitter" hcl:"jitter,optional"`
+	BeforeRetry      []string `cty:"before_retry" hcl:"before_retry,optional"`
+	BeforeRetryExec  []string `cty:"before_retry_execute" hcl:"before_retry_execute,optional"`
 }
 
 // IgnoreBlock represents a labeled ignore block
@@ -84,6 +89,26 @@ func (c *ErrorsConfig) Merge(other *ErrorsConfig) {
 			if otherBlock.SleepIntervalSec > 0 {
 				existing.SleepIntervalSec = otherBlock.SleepIntervalSec
 			}
+
+			if otherBlock.Backoff != nil {
+				existing.Backoff = otherBlock.Backoff
+			}
+
+			if otherBlock.MaxSleepSec != nil {
+				existing.MaxSleepSec = otherBlock.MaxSleepSec
+			}
+
+			if otherBlock.Jitter != nil {
+				existing.Jitter = otherBlock.Jitter
+			}
+
+			if otherBlock.BeforeRetry != nil {
+				existing.BeforeRetry = otherBlock.BeforeRetry
+			}
+
+			if otherBlock.BeforeRetryExec != nil {
+				existing.BeforeRetryExec = otherBlock.BeforeRetryExec
+			}
 		} else {
 			// Add new block
 			retryMap[otherBlock.Label] = otherBlock
@@ -136,6 +161,9 @@ func (r *RetryBlock) Clone() *RetryBlock {
 		Label:            r.Label,
 		MaxAttempts:      r.MaxAttempts,
 		SleepIntervalSec: r.SleepIntervalSec,
+		Backoff:          r.Backoff,
+		MaxSleepSec:      r.MaxSleepSec,
+		Jitter:           r.Jitter,
 	}
 
 	// Deep copy RetryableErrors slice
@@ -144,6 +172,16 @@ func (r *RetryBlock) Clone() *RetryBlock {
 		copy(clone.RetryableErrors, r.RetryableErrors)
 	}
 
+	if r.BeforeRetry != nil {
+		clone.BeforeRetry = make([]string, len(r.BeforeRetry))
+		copy(clone.BeforeRetry, r.BeforeRetry)
+	}
+
+	if r.BeforeRetryExec != nil {
+		clone.BeforeRetryExec = make([]string, len(r.BeforeRetryExec))
+		copy(clone.BeforeRetryExec, r.BeforeRetryExec)
+	}
+
 	return clone
 }
 
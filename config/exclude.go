@@ -106,6 +106,9 @@ func evaluateExcludeBlocks(ctx *ParsingContext, file *hclparse.File) (*ExcludeCo
 			return nil, err
 		}
 
+		// The exclude block only holds flags and actions, so the sensitive marks are not kept.
+		value, _ = value.UnmarkDeep()
+
 		evaluatedAttrs[attr.Name] = value
 	}
 
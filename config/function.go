@@ -0,0 +1,381 @@
+package config
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/ext/typeexpr"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	tflang "github.com/hashicorp/terraform/lang"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+	"github.com/zclconf/go-cty/cty/function"
+
+	"github.com/gruntwork-io/terragrunt/config/hclparse"
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/util"
+)
+
+const (
+	MetadataFunction        = "function"
+	MetadataImportFunctions = "import_functions"
+
+	functionParamsAttr     = "params"
+	functionResultAttr     = "result"
+	functionResultTypeAttr = "result_type"
+)
+
+// terragruntFunctionIgnore is used to accept the `function` blocks when decoding the whole configuration, since they
+// are decoded with the base blocks.
+type terragruntFunctionIgnore struct {
+	Name   string   `hcl:"name,label"`
+	Remain hcl.Body `hcl:",remain"`
+}
+
+// terragruntImportFunctions is a struct that can be used to only decode the `import_functions` attribute.
+type terragruntImportFunctions struct {
+	ImportFunctions []string `hcl:"import_functions,optional"`
+	Remain          hcl.Body `hcl:",remain"`
+}
+
+// UserFunction is a function declared by a `function` block:
+//
+//	function "resource_name" {
+//	  params = {
+//	    env       = string
+//	    component = string
+//	  }
+//	  result = "${env}-${component}"
+//	}
+//
+// The parameters are either a list of names, or a map of names to their types. The result can only reference the
+// parameters, and call the OpenTofu/Terraform functions and the other user functions, so that functions are pure.
+type UserFunction struct {
+	Name       string
+	Params     []UserFunctionParam
+	Result     hcl.Expression
+	ResultType cty.Type
+	ConfigPath string
+}
+
+// UserFunctionParam is a parameter of a user function.
+type UserFunctionParam struct {
+	Name string
+	Type cty.Type
+}
+
+// UserFunctions are the user functions available to a configuration, by name.
+type UserFunctions map[string]*UserFunction
+
+// decodeUserFunctions returns the user functions available to the configuration: the ones declared in the configuration,
+// in the files it imports with `import_functions`, and in the configurations it includes. The functions declared in the
+// configuration take precedence over the included ones.
+func decodeUserFunctions(ctx *ParsingContext, file *hclparse.File, evalCtx *hcl.EvalContext, trackInclude *TrackInclude) (UserFunctions, error) {
+	functions := UserFunctions{}
+
+	if trackInclude != nil {
+		for _, include := range trackInclude.CurrentList {
+			includePath := include.Path
+			if !filepath.IsAbs(includePath) {
+				includePath = util.JoinPath(filepath.Dir(file.ConfigPath), includePath)
+			}
+
+			includeFile, err := hclparse.NewParser(ctx.ParserOptions...).ParseFromFile(includePath)
+			if err != nil {
+				return nil, err
+			}
+
+			// The paths of the imports of the included configuration are evaluated relative to it.
+			includeEvalCtx, err := createTerragruntEvalContext(ctx, includePath)
+			if err != nil {
+				return nil, err
+			}
+
+			if err := functions.decodeFileWithImports(ctx, includeFile, includeEvalCtx); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := functions.decodeFileWithImports(ctx, file, evalCtx); err != nil {
+		return nil, err
+	}
+
+	if err := functions.validate(); err != nil {
+		return nil, err
+	}
+
+	return functions, nil
+}
+
+// decodeFileWithImports adds the functions of the files imported by the file, then the functions of the file.
+func (functions UserFunctions) decodeFileWithImports(ctx *ParsingContext, file *hclparse.File, evalCtx *hcl.EvalContext) error {
+	imports := terragruntImportFunctions{}
+	if err := file.Decode(&imports, evalCtx); err != nil {
+		return err
+	}
+
+	for _, importPath := range imports.ImportFunctions {
+		if !filepath.IsAbs(importPath) {
+			importPath = util.JoinPath(filepath.Dir(file.ConfigPath), importPath)
+		}
+
+		importFile, err := hclparse.NewParser(ctx.ParserOptions...).ParseFromFile(importPath)
+		if err != nil {
+			return err
+		}
+
+		if err := functions.decodeFile(importFile); err != nil {
+			return err
+		}
+	}
+
+	return functions.decodeFile(file)
+}
+
+// decodeFile adds the functions declared in the file, overriding the functions with the same name.
+func (functions UserFunctions) decodeFile(file *hclparse.File) error {
+	content, _, diags := file.Body.PartialContent(&hcl.BodySchema{
+		Blocks: []hcl.BlockHeaderSchema{{Type: MetadataFunction, LabelNames: []string{"name"}}},
+	})
+	if err := file.HandleDiagnostics(diags); err != nil {
+		return errors.New(err)
+	}
+
+	for _, block := range content.Blocks {
+		fn, err := decodeUserFunction(file, block)
+		if err != nil {
+			return err
+		}
+
+		functions[fn.Name] = fn
+	}
+
+	return nil
+}
+
+func decodeUserFunction(file *hclparse.File, block *hcl.Block) (*UserFunction, error) {
+	if !hclsyntax.ValidIdentifier(block.Labels[0]) {
+		return nil, errors.New(InvalidUserFunctionError{ConfigPath: file.ConfigPath, Name: block.Labels[0], Reason: "the name of a function must be a valid identifier"})
+	}
+
+	fn := &UserFunction{
+		Name:       block.Labels[0],
+		ResultType: cty.DynamicPseudoType,
+		ConfigPath: file.ConfigPath,
+	}
+
+	content, diags := block.Body.Content(&hcl.BodySchema{
+		Attributes: []hcl.AttributeSchema{
+			{Name: functionParamsAttr},
+			{Name: functionResultAttr, Required: true},
+			{Name: functionResultTypeAttr},
+		},
+	})
+	if err := file.HandleDiagnostics(diags); err != nil {
+		return nil, errors.New(err)
+	}
+
+	if attr, ok := content.Attributes[functionParamsAttr]; ok {
+		params, err := decodeUserFunctionParams(attr.Expr)
+		if err != nil {
+			return nil, errors.New(InvalidUserFunctionError{ConfigPath: file.ConfigPath, Name: fn.Name, Reason: err.Error()})
+		}
+
+		fn.Params = params
+	}
+
+	if attr, ok := content.Attributes[functionResultTypeAttr]; ok {
+		resultType, diags := typeexpr.TypeConstraint(attr.Expr)
+		if err := file.HandleDiagnostics(diags); err != nil {
+			return nil, errors.New(err)
+		}
+
+		fn.ResultType = resultType
+	}
+
+	fn.Result = content.Attributes[functionResultAttr].Expr
+
+	// Functions are pure, so the result can only reference the parameters.
+	for _, traversal := range fn.Result.Variables() {
+		name := traversal.RootName()
+		if !slices.ContainsFunc(fn.Params, func(param UserFunctionParam) bool { return param.Name == name }) {
+			return nil, errors.New(InvalidUserFunctionError{ConfigPath: file.ConfigPath, Name: fn.Name, Reason: fmt.Sprintf("the result references %q, which is not a parameter of the function", name)})
+		}
+	}
+
+	return fn, nil
+}
+
+// decodeUserFunctionParams decodes the parameters, either a list of names, such as `[env, component]`, or a map of
+// names to their types, such as `{ env = string, component = string }`.
+func decodeUserFunctionParams(expr hcl.Expression) ([]UserFunctionParam, error) {
+	var params []UserFunctionParam
+
+	if items, diags := hcl.ExprList(expr); !diags.HasErrors() {
+		for _, item := range items {
+			name := hcl.ExprAsKeyword(item)
+			if name == "" {
+				return nil, errors.Errorf("the parameters must be a list of names or a map of names to types")
+			}
+
+			params = append(params, UserFunctionParam{Name: name, Type: cty.DynamicPseudoType})
+		}
+	} else {
+		pairs, diags := hcl.ExprMap(expr)
+		if diags.HasErrors() {
+			return nil, errors.Errorf("the parameters must be a list of names or a map of names to types")
+		}
+
+		for _, pair := range pairs {
+			name := hcl.ExprAsKeyword(pair.Key)
+			if name == "" {
+				return nil, errors.Errorf("the parameters must be a list of names or a map of names to types")
+			}
+
+			ty, diags := typeexpr.TypeConstraint(pair.Value)
+			if diags.HasErrors() {
+				return nil, errors.Errorf("invalid type of parameter %q: %s", name, diags.Error())
+			}
+
+			params = append(params, UserFunctionParam{Name: name, Type: ty})
+		}
+	}
+
+	for i, param := range params {
+		if slices.ContainsFunc(params[:i], func(prev UserFunctionParam) bool { return prev.Name == param.Name }) {
+			return nil, errors.Errorf("the parameter %q is declared more than once", param.Name)
+		}
+	}
+
+	return params, nil
+}
+
+// validate checks that the functions do not call themselves, directly or through other functions.
+func (functions UserFunctions) validate() error {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	state := map[string]int{}
+
+	var visit func(name string, path []string) error
+
+	visit = func(name string, path []string) error {
+		switch state[name] {
+		case visited:
+			return nil
+		case visiting:
+			return errors.New(UserFunctionCycleError(append(path, name)))
+		}
+
+		state[name] = visiting
+
+		for _, callee := range functions[name].calls() {
+			if _, ok := functions[callee]; ok {
+				if err := visit(callee, append(path, name)); err != nil {
+					return err
+				}
+			}
+		}
+
+		state[name] = visited
+
+		return nil
+	}
+
+	names := make([]string, 0, len(functions))
+	for name := range functions {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	for _, name := range names {
+		if err := visit(name, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// calls returns the names of the functions called by the result of the function.
+func (fn *UserFunction) calls() []string {
+	node, ok := fn.Result.(hclsyntax.Node)
+	if !ok {
+		return nil
+	}
+
+	var names []string
+
+	_ = hclsyntax.VisitAll(node, func(node hclsyntax.Node) hcl.Diagnostics {
+		if call, ok := node.(*hclsyntax.FunctionCallExpr); ok {
+			names = append(names, call.Name)
+		}
+
+		return nil
+	})
+
+	return names
+}
+
+// Functions returns the cty functions of the user functions. The result of a function is evaluated with its parameters,
+// the OpenTofu/Terraform functions and the other user functions.
+func (functions UserFunctions) Functions() map[string]function.Function {
+	ctyFunctions := make(map[string]function.Function, len(functions))
+
+	for name, fn := range functions {
+		ctyFunctions[name] = fn.function(ctyFunctions)
+	}
+
+	return ctyFunctions
+}
+
+func (fn *UserFunction) function(userFunctions map[string]function.Function) function.Function {
+	params := make([]function.Parameter, 0, len(fn.Params))
+	for _, param := range fn.Params {
+		params = append(params, function.Parameter{Name: param.Name, Type: param.Type, AllowNull: true, AllowDynamicType: true})
+	}
+
+	return function.New(&function.Spec{
+		Params: params,
+		Type:   function.StaticReturnType(fn.ResultType),
+		Impl: func(args []cty.Value, _ cty.Type) (cty.Value, error) {
+			scope := &tflang.Scope{BaseDir: filepath.Dir(fn.ConfigPath)}
+
+			evalCtx := &hcl.EvalContext{
+				Variables: map[string]cty.Value{},
+				Functions: scope.Functions(),
+			}
+
+			for name, userFunction := range userFunctions {
+				evalCtx.Functions[name] = userFunction
+			}
+
+			for i, param := range fn.Params {
+				evalCtx.Variables[param.Name] = args[i]
+			}
+
+			result, diags := fn.Result.Value(evalCtx)
+			if diags.HasErrors() {
+				return cty.NilVal, errors.New(diags)
+			}
+
+			if fn.ResultType == cty.DynamicPseudoType {
+				return result, nil
+			}
+
+			result, err := convert.Convert(result, fn.ResultType)
+			if err != nil {
+				return cty.NilVal, errors.New(InvalidUserFunctionError{ConfigPath: fn.ConfigPath, Name: fn.Name, Reason: "the result does not match the result type: " + err.Error()})
+			}
+
+			return result, nil
+		},
+	})
+}
@@ -0,0 +1,202 @@
+package config_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gruntwork-io/terragrunt/config"
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+)
+
+func TestParseTerragruntConfigUserFunctions(t *testing.T) {
+	t.Parallel()
+
+	cfg := `
+function "resource_name" {
+  params = {
+    env       = string
+    component = string
+  }
+  result = "${env}-${component}"
+}
+
+function "prefixed" {
+  params = [name]
+  result = upper(resource_name("dev", name))
+}
+
+locals {
+  name = prefixed("app")
+}
+
+inputs = {
+  name  = local.name
+  count = resource_name("prod", 3)
+}
+`
+
+	ctx := config.NewParsingContext(context.Background(), mockOptionsForTest(t))
+	terragruntConfig, err := config.ParseConfigString(ctx, config.DefaultTerragruntConfigPath, cfg, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, "DEV-APP", terragruntConfig.Inputs["name"])
+	assert.Equal(t, "prod-3", terragruntConfig.Inputs["count"])
+}
+
+func TestParseTerragruntConfigUserFunctionsResultType(t *testing.T) {
+	t.Parallel()
+
+	cfg := `
+function "port" {
+  params      = [value]
+  result      = value
+  result_type = number
+}
+
+inputs = {
+  port = port("8080")
+}
+`
+
+	ctx := config.NewParsingContext(context.Background(), mockOptionsForTest(t))
+	terragruntConfig, err := config.ParseConfigString(ctx, config.DefaultTerragruntConfigPath, cfg, nil)
+	require.NoError(t, err)
+
+	assert.InDelta(t, 8080, terragruntConfig.Inputs["port"], 0)
+}
+
+func TestParseTerragruntConfigUserFunctionsInvalid(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		cfg      string
+		expected error
+	}{
+		{
+			name: "cycle",
+			cfg: `
+function "a" {
+  params = [x]
+  result = b(x)
+}
+
+function "b" {
+  params = [x]
+  result = a(x)
+}
+`,
+			expected: config.UserFunctionCycleError{"a", "b", "a"},
+		},
+		{
+			name: "recursion",
+			cfg: `
+function "a" {
+  params = [x]
+  result = a(x)
+}
+`,
+			expected: config.UserFunctionCycleError{"a", "a"},
+		},
+		{
+			name: "impure",
+			cfg: `
+function "a" {
+  params = [x]
+  result = "${x}-${local.suffix}"
+}
+`,
+			expected: config.InvalidUserFunctionError{
+				ConfigPath: config.DefaultTerragruntConfigPath,
+				Name:       "a",
+				Reason:     `the result references "local", which is not a parameter of the function`,
+			},
+		},
+		{
+			name: "duplicate param",
+			cfg: `
+function "a" {
+  params = [x, x]
+  result = x
+}
+`,
+			expected: config.InvalidUserFunctionError{
+				ConfigPath: config.DefaultTerragruntConfigPath,
+				Name:       "a",
+				Reason:     `the parameter "x" is declared more than once`,
+			},
+		},
+		{
+			name: "built-in name",
+			cfg: `
+function "upper" {
+  params = [x]
+  result = x
+}
+`,
+			expected: config.UserFunctionNameConflictError{ConfigPath: config.DefaultTerragruntConfigPath, Name: "upper"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := config.NewParsingContext(context.Background(), mockOptionsForTest(t))
+			_, err := config.ParseConfigString(ctx, config.DefaultTerragruntConfigPath, tc.cfg, nil)
+			require.Error(t, err)
+			assert.Equal(t, tc.expected, errors.Unwrap(err))
+		})
+	}
+}
+
+func TestParseTerragruntConfigUserFunctionsIncludeAndImport(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	files := map[string]string{
+		"root.hcl": `
+import_functions = ["functions.hcl"]
+
+function "resource_name" {
+  params = [env, component]
+  result = "${prefix(env)}-${component}"
+}
+`,
+		"functions.hcl": `
+function "prefix" {
+  params = [value]
+  result = "tg-${value}"
+}
+`,
+		filepath.Join("app", "terragrunt.hcl"): `
+include "root" {
+  path = find_in_parent_folders("root.hcl")
+}
+
+inputs = {
+  name = resource_name("dev", "app")
+}
+`,
+	}
+
+	for path, content := range files {
+		path = filepath.Join(dir, path)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+
+	configPath := filepath.Join(dir, "app", "terragrunt.hcl")
+
+	ctx := config.NewParsingContext(context.Background(), mockOptionsForTestWithConfigPath(t, configPath))
+	terragruntConfig, err := config.ParseConfigFile(ctx, configPath, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, "tg-dev-app", terragruntConfig.Inputs["name"])
+}
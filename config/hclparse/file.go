@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/gohcl"
 	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/zclconf/go-cty/cty"
 )
 
 const (
@@ -58,19 +59,32 @@ func (file *File) Update(content []byte) error {
 // blocks with labels, requiring the exact number of expected labels in the parsing step.  To handle this restriction,
 // we first see if there are any include blocks without any labels, and if there is, we modify it in the file object to
 // inject the label as "".
+//
+// The marked values, such as sensitive values, are unmarked before they are decoded, use DecodeWithMarks to get their marks.
 func (file *File) Decode(out interface{}, evalContext *hcl.EvalContext) (err error) {
+	_, err = file.DecodeWithMarks(out, evalContext)
+
+	return err
+}
+
+// DecodeWithMarks decodes the file like Decode, and returns the marks of the values with their paths. The paths start
+// with the names of the attributes and blocks, followed by the labels of the blocks: the path of the `contents` attribute
+// of a `generate "provider"` block is `generate["provider"].contents`.
+func (file *File) DecodeWithMarks(out interface{}, evalContext *hcl.EvalContext) ([]cty.PathValueMarks, error) {
 	if file.fileUpdateHandlerFunc != nil {
 		if err := file.Parser.fileUpdateHandlerFunc(file); err != nil {
-			return err
+			return nil, err
 		}
 	}
 
-	diags := gohcl.DecodeBody(file.Body, evalContext, out)
+	marked := &markedValues{}
+
+	diags := gohcl.DecodeBody(&unmarkedBody{Body: file.Body, marked: marked}, evalContext, out)
 	if err := file.HandleDiagnostics(diags); err != nil {
-		return errors.New(err)
+		return nil, errors.New(err)
 	}
 
-	return nil
+	return marked.marks, nil
 }
 
 // Blocks takes a parsed HCL file and extracts a reference to the `name` block, if there are defined.
@@ -0,0 +1,106 @@
+package hclparse
+
+import (
+	"github.com/hashicorp/hcl/v2"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// markedValues collects the marks of the values evaluated while decoding a body, with their paths.
+type markedValues struct {
+	marks []cty.PathValueMarks
+}
+
+// unmarkedBody is a body whose expressions evaluate to unmarked values, since marked values, such as sensitive values,
+// cannot be decoded into Go values. The marks are collected with the paths of the values instead.
+type unmarkedBody struct {
+	hcl.Body
+	path   cty.Path
+	marked *markedValues
+}
+
+func (body *unmarkedBody) Content(schema *hcl.BodySchema) (*hcl.BodyContent, hcl.Diagnostics) {
+	content, diags := body.Body.Content(schema)
+
+	return body.wrapContent(content), diags
+}
+
+func (body *unmarkedBody) PartialContent(schema *hcl.BodySchema) (*hcl.BodyContent, hcl.Body, hcl.Diagnostics) {
+	content, remain, diags := body.Body.PartialContent(schema)
+
+	if remain != nil {
+		remain = &unmarkedBody{Body: remain, path: body.path, marked: body.marked}
+	}
+
+	return body.wrapContent(content), remain, diags
+}
+
+func (body *unmarkedBody) JustAttributes() (hcl.Attributes, hcl.Diagnostics) {
+	attrs, diags := body.Body.JustAttributes()
+
+	return body.wrapAttributes(attrs), diags
+}
+
+func (body *unmarkedBody) wrapContent(content *hcl.BodyContent) *hcl.BodyContent {
+	if content == nil {
+		return nil
+	}
+
+	wrapped := *content
+	wrapped.Attributes = body.wrapAttributes(content.Attributes)
+	wrapped.Blocks = make(hcl.Blocks, 0, len(content.Blocks))
+
+	for _, block := range content.Blocks {
+		path := body.path.Copy().GetAttr(block.Type)
+		for _, label := range block.Labels {
+			path = path.Index(cty.StringVal(label))
+		}
+
+		wrappedBlock := *block
+		wrappedBlock.Body = &unmarkedBody{Body: block.Body, path: path, marked: body.marked}
+		wrapped.Blocks = append(wrapped.Blocks, &wrappedBlock)
+	}
+
+	return &wrapped
+}
+
+func (body *unmarkedBody) wrapAttributes(attrs hcl.Attributes) hcl.Attributes {
+	if attrs == nil {
+		return nil
+	}
+
+	wrapped := make(hcl.Attributes, len(attrs))
+
+	for name, attr := range attrs {
+		wrappedAttr := *attr
+		wrappedAttr.Expr = &unmarkedExpression{Expression: attr.Expr, path: body.path.Copy().GetAttr(name), marked: body.marked}
+		wrapped[name] = &wrappedAttr
+	}
+
+	return wrapped
+}
+
+// unmarkedExpression is an expression evaluating to an unmarked value, whose marks are collected with their paths.
+type unmarkedExpression struct {
+	hcl.Expression
+	path   cty.Path
+	marked *markedValues
+}
+
+func (expr *unmarkedExpression) Value(evalCtx *hcl.EvalContext) (cty.Value, hcl.Diagnostics) {
+	val, diags := expr.Expression.Value(evalCtx)
+
+	val, pathMarks := val.UnmarkDeepWithPaths()
+	for _, pathMark := range pathMarks {
+		expr.marked.marks = append(expr.marked.marks, cty.PathValueMarks{
+			Path:  append(expr.path.Copy(), pathMark.Path...),
+			Marks: pathMark.Marks,
+		})
+	}
+
+	return val, diags
+}
+
+// UnwrapExpression allows the functions such as hcl.ExprList to reach the wrapped expression.
+func (expr *unmarkedExpression) UnwrapExpression() hcl.Expression {
+	return expr.Expression
+}
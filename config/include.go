@@ -1,6 +1,7 @@
 package config
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"path/filepath"
@@ -15,6 +16,7 @@ import (
 
 	"github.com/gruntwork-io/terragrunt/internal/errors"
 	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/telemetry"
 	"github.com/gruntwork-io/terragrunt/util"
 )
 
@@ -34,6 +36,28 @@ func parseIncludedConfig(ctx *ParsingContext, includedConfig *IncludeConfig) (*T
 		includePath = util.JoinPath(filepath.Dir(ctx.TerragruntOptions.TerragruntConfigPath), includePath)
 	}
 
+	var config *TerragruntConfig
+
+	err := telemetry.Telemetry(ctx, ctx.TerragruntOptions, "parse_include", map[string]interface{}{
+		"include":             includedConfig.Name,
+		"config_path":         includePath,
+		telemetry.SubjectAttr: includePath,
+	}, func(childCtx context.Context) error {
+		var err error
+
+		config, err = parseIncludedConfigFile(ctx.WithContext(childCtx), includePath, includedConfig)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+// parseIncludedConfigFile parses the config of the given include, at the include path.
+func parseIncludedConfigFile(ctx *ParsingContext, includePath string, includedConfig *IncludeConfig) (*TerragruntConfig, error) {
 	// These condition are here to specifically handle the `run-all` command. During any `run-all` call, terragrunt
 	// needs to first build up the dependency graph to know what order to process the modules in. We want to limit users
 	// from creating a dependency between the dependency path for graph generation, and a module output. This is because
@@ -115,6 +139,11 @@ func handleInclude(ctx *ParsingContext, config *TerragruntConfig, isPartial bool
 			return config, err
 		}
 
+		mergeRules, err := includeConfig.GetMergeRules()
+		if err != nil {
+			return config, err
+		}
+
 		var (
 			parsedIncludeConfig *TerragruntConfig
 			logPrefix           string
@@ -131,6 +160,15 @@ func handleInclude(ctx *ParsingContext, config *TerragruntConfig, isPartial bool
 			return nil, err
 		}
 
+		if err := includeConfig.removeIncludedBlocks(ctx.TerragruntOptions, parsedIncludeConfig); err != nil {
+			return nil, err
+		}
+
+		// The attributes with a merge rule are merged from the included config as it was before the merge.
+		includedConfig := parsedIncludeConfig.cloneForMergeRules()
+		childConfig := baseConfig
+		childProvenance := baseConfig.Provenance
+
 		// TODO: Remove lint suppression
 		switch mergeStrategy { //nolint:exhaustive
 		case NoMerge:
@@ -138,6 +176,8 @@ func handleInclude(ctx *ParsingContext, config *TerragruntConfig, isPartial bool
 		case ShallowMerge:
 			ctx.TerragruntOptions.Logger.Debugf("%sIncluded config %s has strategy shallow merge: merging config in (shallow).", logPrefix, includeConfig.Path)
 
+			childConfig = baseConfig.cloneForMergeRules()
+
 			if err := parsedIncludeConfig.Merge(baseConfig, ctx.TerragruntOptions); err != nil {
 				return nil, err
 			}
@@ -146,6 +186,8 @@ func handleInclude(ctx *ParsingContext, config *TerragruntConfig, isPartial bool
 		case DeepMerge:
 			ctx.TerragruntOptions.Logger.Debugf("%sIncluded config %s has strategy deep merge: merging config in (deep).", logPrefix, includeConfig.Path)
 
+			childConfig = baseConfig.cloneForMergeRules()
+
 			if err := parsedIncludeConfig.DeepMerge(baseConfig, ctx.TerragruntOptions); err != nil {
 				return nil, err
 			}
@@ -154,6 +196,14 @@ func handleInclude(ctx *ParsingContext, config *TerragruntConfig, isPartial bool
 		default:
 			return nil, fmt.Errorf("you reached an impossible condition. This is most likely a bug in terragrunt. Please open an issue at github.com/gruntwork-io/terragrunt with this error message. Code: UNKNOWN_MERGE_STRATEGY_%s", mergeStrategy)
 		}
+
+		if err := includeConfig.mergeWithRules(ctx.TerragruntOptions, baseConfig, includedConfig, childConfig, mergeRules); err != nil {
+			return nil, err
+		}
+
+		if ctx.TrackProvenance {
+			baseConfig.Provenance = mergeProvenance(childProvenance, includedConfig.Provenance, &includeConfig, mergeStrategy, mergeRules)
+		}
 	}
 
 	return baseConfig, nil
@@ -275,6 +325,10 @@ func (cfg *TerragruntConfig) Merge(sourceConfig *TerragruntConfig, terragruntOpt
 		cfg.Errors = sourceConfig.Errors.Clone()
 	}
 
+	if sourceConfig.StackHooks != nil {
+		cfg.StackHooks = sourceConfig.StackHooks.Clone()
+	}
+
 	if sourceConfig.RemoteState != nil {
 		cfg.RemoteState = sourceConfig.RemoteState
 	}
@@ -335,6 +389,7 @@ func (cfg *TerragruntConfig) Merge(sourceConfig *TerragruntConfig, terragruntOpt
 	}
 
 	CopyFieldsMetadata(sourceConfig, cfg)
+	mergeSensitivePaths(sourceConfig, cfg)
 
 	return nil
 }
@@ -415,6 +470,14 @@ func (cfg *TerragruntConfig) DeepMerge(sourceConfig *TerragruntConfig, terragrun
 		cfg.Errors.Merge(sourceConfig.Errors)
 	}
 
+	if sourceConfig.StackHooks != nil {
+		if cfg.StackHooks == nil {
+			cfg.StackHooks = &StackHooksConfig{}
+		}
+
+		cfg.StackHooks.Merge(sourceConfig.StackHooks.Clone())
+	}
+
 	if sourceConfig.Skip != nil {
 		cfg.Skip = sourceConfig.Skip
 	}
@@ -544,6 +607,7 @@ func (cfg *TerragruntConfig) DeepMerge(sourceConfig *TerragruntConfig, terragrun
 	}
 
 	CopyFieldsMetadata(sourceConfig, cfg)
+	mergeSensitivePaths(sourceConfig, cfg)
 
 	return nil
 }
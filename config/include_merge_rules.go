@@ -0,0 +1,343 @@
+package config
+
+import (
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/gruntwork-io/terragrunt/codegen"
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/options"
+)
+
+const (
+	mergeRuleTerraformExtraArgs       = MetadataTerraform + ".extra_arguments"
+	mergeRuleTerraformBeforeHooks     = MetadataTerraform + ".before_hook"
+	mergeRuleTerraformAfterHooks      = MetadataTerraform + ".after_hook"
+	mergeRuleTerraformErrorHooks      = MetadataTerraform + ".error_hook"
+	mergeRuleTerraformIncludeInCopy   = MetadataTerraform + ".include_in_copy"
+	mergeRuleTerraformExcludeFromCopy = MetadataTerraform + ".exclude_from_copy"
+)
+
+// includeMergeRule merges an attribute, or the blocks of a type, of the included config and of the child config with
+// the merge strategy set in the `merge_rules` of the include.
+type includeMergeRule struct {
+	// strategies are the merge strategies supported by the attribute.
+	strategies []MergeStrategyType
+
+	// merge sets the attribute of cfg to the attribute of the child merged into the one of the parent.
+	merge func(cfg, parent, child *TerragruntConfig, strategy MergeStrategyType) error
+}
+
+// includeMergeRules are the attributes and blocks whose merge strategy can be set with the `merge_rules` of the include.
+var includeMergeRules = map[string]includeMergeRule{
+	MetadataInputs: {
+		strategies: []MergeStrategyType{NoMerge, ShallowMerge, DeepMerge},
+		merge: func(cfg, parent, child *TerragruntConfig, strategy MergeStrategyType) error {
+			switch strategy { //nolint:exhaustive
+			case NoMerge:
+				cfg.Inputs = child.Inputs
+			case DeepMerge:
+				inputs, err := deepMergeInputs(child.Inputs, parent.Inputs)
+				if err != nil {
+					return err
+				}
+
+				cfg.Inputs = inputs
+			default:
+				cfg.Inputs = mergeInputs(child.Inputs, parent.Inputs)
+			}
+
+			return nil
+		},
+	},
+	mergeRuleTerraformExtraArgs: {
+		strategies: []MergeStrategyType{NoMerge, ShallowMerge, AppendMerge},
+		merge: func(cfg, parent, child *TerragruntConfig, strategy MergeStrategyType) error {
+			terraformConfig(cfg).ExtraArgs = mergeNamedBlocks(terraformConfig(parent).ExtraArgs, terraformConfig(child).ExtraArgs, strategy,
+				func(extraArgs TerraformExtraArguments) string { return extraArgs.Name })
+
+			return nil
+		},
+	},
+	mergeRuleTerraformBeforeHooks: {
+		strategies: []MergeStrategyType{NoMerge, ShallowMerge, AppendMerge},
+		merge: func(cfg, parent, child *TerragruntConfig, strategy MergeStrategyType) error {
+			terraformConfig(cfg).BeforeHooks = mergeNamedBlocks(terraformConfig(parent).BeforeHooks, terraformConfig(child).BeforeHooks, strategy,
+				func(hook Hook) string { return hook.Name })
+
+			return nil
+		},
+	},
+	mergeRuleTerraformAfterHooks: {
+		strategies: []MergeStrategyType{NoMerge, ShallowMerge, AppendMerge},
+		merge: func(cfg, parent, child *TerragruntConfig, strategy MergeStrategyType) error {
+			terraformConfig(cfg).AfterHooks = mergeNamedBlocks(terraformConfig(parent).AfterHooks, terraformConfig(child).AfterHooks, strategy,
+				func(hook Hook) string { return hook.Name })
+
+			return nil
+		},
+	},
+	mergeRuleTerraformErrorHooks: {
+		strategies: []MergeStrategyType{NoMerge, ShallowMerge, AppendMerge},
+		merge: func(cfg, parent, child *TerragruntConfig, strategy MergeStrategyType) error {
+			terraformConfig(cfg).ErrorHooks = mergeNamedBlocks(terraformConfig(parent).ErrorHooks, terraformConfig(child).ErrorHooks, strategy,
+				func(hook ErrorHook) string { return hook.Name })
+
+			return nil
+		},
+	},
+	mergeRuleTerraformIncludeInCopy: {
+		strategies: []MergeStrategyType{NoMerge, ShallowMerge, AppendMerge},
+		merge: func(cfg, parent, child *TerragruntConfig, strategy MergeStrategyType) error {
+			terraformConfig(cfg).IncludeInCopy = mergeListPtrs(terraformConfig(parent).IncludeInCopy, terraformConfig(child).IncludeInCopy, strategy)
+
+			return nil
+		},
+	},
+	mergeRuleTerraformExcludeFromCopy: {
+		strategies: []MergeStrategyType{NoMerge, ShallowMerge, AppendMerge},
+		merge: func(cfg, parent, child *TerragruntConfig, strategy MergeStrategyType) error {
+			terraformConfig(cfg).ExcludeFromCopy = mergeListPtrs(terraformConfig(parent).ExcludeFromCopy, terraformConfig(child).ExcludeFromCopy, strategy)
+
+			return nil
+		},
+	},
+	MetadataRetryableErrors: {
+		strategies: []MergeStrategyType{NoMerge, ShallowMerge, AppendMerge},
+		merge: func(cfg, parent, child *TerragruntConfig, strategy MergeStrategyType) error {
+			cfg.RetryableErrors = mergeLists(parent.RetryableErrors, child.RetryableErrors, strategy)
+
+			return nil
+		},
+	},
+	MetadataGenerateConfigs: {
+		strategies: []MergeStrategyType{NoMerge, ShallowMerge},
+		merge: func(cfg, parent, child *TerragruntConfig, strategy MergeStrategyType) error {
+			generateConfigs := maps.Clone(child.GenerateConfigs)
+
+			if strategy != NoMerge {
+				generateConfigs = maps.Clone(parent.GenerateConfigs)
+				maps.Copy(generateConfigs, child.GenerateConfigs)
+			}
+
+			if generateConfigs == nil {
+				generateConfigs = map[string]codegen.GenerateConfig{}
+			}
+
+			cfg.GenerateConfigs = generateConfigs
+
+			return nil
+		},
+	},
+}
+
+// includeRemovableBlocks are the types of blocks of the included config that can be removed by name with the
+// `remove_blocks` of the include. The functions remove the block with the given name, and return false if the config
+// has no such block.
+var includeRemovableBlocks = map[string]func(cfg *TerragruntConfig, name string) bool{
+	MetadataGenerateConfigs: func(cfg *TerragruntConfig, name string) bool {
+		if _, ok := cfg.GenerateConfigs[name]; !ok {
+			return false
+		}
+
+		generateConfigs := maps.Clone(cfg.GenerateConfigs)
+		delete(generateConfigs, name)
+		cfg.GenerateConfigs = generateConfigs
+
+		return true
+	},
+	mergeRuleTerraformExtraArgs: func(cfg *TerragruntConfig, name string) bool {
+		return cfg.Terraform != nil && removeNamedBlock(&cfg.Terraform.ExtraArgs, name,
+			func(extraArgs TerraformExtraArguments) string { return extraArgs.Name })
+	},
+	mergeRuleTerraformBeforeHooks: func(cfg *TerragruntConfig, name string) bool {
+		return cfg.Terraform != nil && removeNamedBlock(&cfg.Terraform.BeforeHooks, name,
+			func(hook Hook) string { return hook.Name })
+	},
+	mergeRuleTerraformAfterHooks: func(cfg *TerragruntConfig, name string) bool {
+		return cfg.Terraform != nil && removeNamedBlock(&cfg.Terraform.AfterHooks, name,
+			func(hook Hook) string { return hook.Name })
+	},
+	mergeRuleTerraformErrorHooks: func(cfg *TerragruntConfig, name string) bool {
+		return cfg.Terraform != nil && removeNamedBlock(&cfg.Terraform.ErrorHooks, name,
+			func(hook ErrorHook) string { return hook.Name })
+	},
+}
+
+// GetMergeRules returns the merge strategies set for the attributes and blocks with the `merge_rules` of the include.
+func (include *IncludeConfig) GetMergeRules() (map[string]MergeStrategyType, error) {
+	rules := make(map[string]MergeStrategyType, len(include.MergeRules))
+
+	for path, strategy := range include.MergeRules {
+		rule, ok := includeMergeRules[path]
+		if !ok {
+			return nil, errors.New(UnsupportedIncludeMergeRuleError{Path: path, Supported: sortedKeys(includeMergeRules)})
+		}
+
+		if !slices.Contains(rule.strategies, MergeStrategyType(strategy)) {
+			supported := make([]string, len(rule.strategies))
+			for i, strategy := range rule.strategies {
+				supported[i] = string(strategy)
+			}
+
+			return nil, errors.New(InvalidIncludeMergeRuleError{Path: path, Strategy: strategy, Supported: supported})
+		}
+
+		rules[path] = MergeStrategyType(strategy)
+	}
+
+	return rules, nil
+}
+
+// removeIncludedBlocks removes the blocks listed in the `remove_blocks` of the include from the included config.
+func (include *IncludeConfig) removeIncludedBlocks(opts *options.TerragruntOptions, includedConfig *TerragruntConfig) error {
+	for _, block := range include.RemoveBlocks {
+		blockType, name, removeBlock := findRemovableBlock(block)
+		if removeBlock == nil {
+			return errors.New(InvalidIncludeRemoveBlockError{Block: block, Supported: sortedKeys(includeRemovableBlocks)})
+		}
+
+		if removeBlock(includedConfig, name) {
+			opts.Logger.Debugf("Removed %s block %q of included config %s", blockType, name, include.Path)
+		} else {
+			opts.Logger.Warnf("Included config %s has no %s block %q to remove", include.Path, blockType, name)
+		}
+	}
+
+	return nil
+}
+
+// mergeWithRules merges the attributes with a merge rule of the child config into the ones of the included config,
+// and sets them in cfg, overriding the merge strategy of the include. The parent must be a copy of the included
+// config taken before the include is merged.
+func (include *IncludeConfig) mergeWithRules(opts *options.TerragruntOptions, cfg, parent, child *TerragruntConfig, rules map[string]MergeStrategyType) error {
+	for _, path := range sortedKeys(rules) {
+		opts.Logger.Debugf("Included config %s has merge rule %s for %s: merging it in.", include.Path, rules[path], path)
+
+		if err := includeMergeRules[path].merge(cfg, parent, child, rules[path]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// cloneForMergeRules returns a copy of the config whose attributes with a merge rule are not modified by a merge.
+func (cfg *TerragruntConfig) cloneForMergeRules() *TerragruntConfig {
+	clone := *cfg
+
+	if cfg.Terraform != nil {
+		terraform := *cfg.Terraform
+		terraform.ExtraArgs = slices.Clone(terraform.ExtraArgs)
+		terraform.BeforeHooks = slices.Clone(terraform.BeforeHooks)
+		terraform.AfterHooks = slices.Clone(terraform.AfterHooks)
+		terraform.ErrorHooks = slices.Clone(terraform.ErrorHooks)
+		clone.Terraform = &terraform
+	}
+
+	clone.RetryableErrors = slices.Clone(cfg.RetryableErrors)
+	clone.GenerateConfigs = maps.Clone(cfg.GenerateConfigs)
+
+	return &clone
+}
+
+// findRemovableBlock splits the block of `remove_blocks`, such as `terraform.after_hook.notify`, into its type and its
+// name, and returns the function removing it.
+func findRemovableBlock(block string) (string, string, func(cfg *TerragruntConfig, name string) bool) {
+	for blockType, removeBlock := range includeRemovableBlocks {
+		if name, ok := strings.CutPrefix(block, blockType+"."); ok && name != "" {
+			return blockType, name, removeBlock
+		}
+	}
+
+	return "", "", nil
+}
+
+// terraformConfig returns the terraform block of the config, which is added if missing.
+func terraformConfig(cfg *TerragruntConfig) *TerraformConfig {
+	if cfg.Terraform == nil {
+		cfg.Terraform = &TerraformConfig{}
+	}
+
+	return cfg.Terraform
+}
+
+// mergeNamedBlocks merges the blocks of the child into the blocks of the parent. With the shallow strategy, the child
+// blocks override the parent blocks with the same name, while with the append strategy they are all kept.
+func mergeNamedBlocks[T any](parentBlocks, childBlocks []T, strategy MergeStrategyType, blockName func(T) string) []T {
+	switch strategy { //nolint:exhaustive
+	case NoMerge:
+		return slices.Clone(childBlocks)
+	case AppendMerge:
+		return append(slices.Clone(parentBlocks), childBlocks...)
+	}
+
+	result := slices.Clone(parentBlocks)
+
+	for _, child := range childBlocks {
+		index := slices.IndexFunc(result, func(block T) bool { return blockName(block) == blockName(child) })
+		if index != -1 {
+			result[index] = child
+		} else {
+			result = append(result, child)
+		}
+	}
+
+	return result
+}
+
+// removeNamedBlock removes the blocks with the given name, and returns false if there is none.
+func removeNamedBlock[T any](blocks *[]T, name string, blockName func(T) string) bool {
+	result := slices.DeleteFunc(slices.Clone(*blocks), func(block T) bool { return blockName(block) == name })
+	if len(result) == len(*blocks) {
+		return false
+	}
+
+	*blocks = result
+
+	return true
+}
+
+// mergeLists merges the list of the child into the list of the parent. With the shallow strategy, the list of the
+// child overrides the one of the parent if it is set.
+func mergeLists(parentList, childList []string, strategy MergeStrategyType) []string {
+	switch strategy { //nolint:exhaustive
+	case NoMerge:
+		return childList
+	case AppendMerge:
+		return append(slices.Clone(parentList), childList...)
+	}
+
+	if childList != nil {
+		return childList
+	}
+
+	return parentList
+}
+
+// mergeListPtrs merges the optional list of the child into the optional list of the parent, like mergeLists.
+func mergeListPtrs(parentList, childList *[]string, strategy MergeStrategyType) *[]string {
+	switch {
+	case strategy == NoMerge, childList != nil && strategy == ShallowMerge, parentList == nil:
+		return childList
+	case childList == nil:
+		return parentList
+	}
+
+	result := mergeLists(*parentList, *childList, strategy)
+
+	return &result
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
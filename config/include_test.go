@@ -1,10 +1,14 @@
 package config_test
 
 import (
+	"context"
+	"os"
+	"path/filepath"
 	"sync"
 	"testing"
 
 	"github.com/gruntwork-io/terragrunt/config"
+	"github.com/gruntwork-io/terragrunt/internal/errors"
 	"github.com/gruntwork-io/terragrunt/remote"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -387,3 +391,117 @@ func TestConcurrentCopyFieldsMetadata(t *testing.T) {
 		t.Errorf("Expected %d fields, got %d", expectedFields, len(targetConfig.FieldsMetadata))
 	}
 }
+
+func TestIncludeMergeRulesAndRemoveBlocks(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "root.hcl"), []byte(`
+terraform {
+  extra_arguments "vars" {
+    commands  = ["plan"]
+    arguments = ["-var-file=root.tfvars"]
+  }
+
+  after_hook "notify" {
+    commands = ["apply"]
+    execute  = ["./notify.sh"]
+  }
+
+  after_hook "cleanup" {
+    commands = ["apply"]
+    execute  = ["./cleanup.sh"]
+  }
+}
+
+generate "provider" {
+  path      = "provider.tf"
+  if_exists = "overwrite"
+  contents  = "provider \"aws\" {}"
+}
+
+generate "versions" {
+  path      = "versions.tf"
+  if_exists = "overwrite"
+  contents  = "terraform {}"
+}
+
+inputs = {
+  tags = {
+    team = "platform"
+  }
+}
+`), 0644))
+
+	configPath := filepath.Join(dir, config.DefaultTerragruntConfigPath)
+	require.NoError(t, os.WriteFile(configPath, []byte(`
+include "root" {
+  path = "root.hcl"
+
+  merge_rules = {
+    inputs                      = "deep"
+    "terraform.extra_arguments" = "append"
+  }
+
+  remove_blocks = ["generate.provider", "terraform.after_hook.notify"]
+}
+
+terraform {
+  extra_arguments "vars" {
+    commands  = ["plan"]
+    arguments = ["-var-file=unit.tfvars"]
+  }
+}
+
+inputs = {
+  tags = {
+    unit = "vpc"
+  }
+}
+`), 0644))
+
+	ctx := config.NewParsingContext(context.Background(), mockOptionsForTestWithConfigPath(t, configPath))
+	terragruntConfig, err := config.ParseConfigFile(ctx, configPath, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]interface{}{"team": "platform", "unit": "vpc"}, terragruntConfig.Inputs["tags"])
+
+	require.Len(t, terragruntConfig.Terraform.ExtraArgs, 2)
+	assert.Equal(t, []string{"-var-file=root.tfvars"}, *terragruntConfig.Terraform.ExtraArgs[0].Arguments)
+	assert.Equal(t, []string{"-var-file=unit.tfvars"}, *terragruntConfig.Terraform.ExtraArgs[1].Arguments)
+
+	require.Len(t, terragruntConfig.Terraform.AfterHooks, 1)
+	assert.Equal(t, "cleanup", terragruntConfig.Terraform.AfterHooks[0].Name)
+
+	assert.NotContains(t, terragruntConfig.GenerateConfigs, "provider")
+	assert.Contains(t, terragruntConfig.GenerateConfigs, "versions")
+}
+
+func TestIncludeInvalidMergeRules(t *testing.T) {
+	t.Parallel()
+
+	tc := []struct {
+		include     config.IncludeConfig
+		expectedErr error
+	}{
+		{
+			config.IncludeConfig{MergeRules: map[string]string{"inputs": "append"}},
+			config.InvalidIncludeMergeRuleError{},
+		},
+		{
+			config.IncludeConfig{MergeRules: map[string]string{"locals": "deep"}},
+			config.UnsupportedIncludeMergeRuleError{},
+		},
+	}
+
+	for _, tt := range tc {
+		_, err := tt.include.GetMergeRules()
+		require.Error(t, err)
+		assert.IsType(t, tt.expectedErr, errors.Unwrap(err))
+	}
+
+	rules, err := (&config.IncludeConfig{MergeRules: map[string]string{"terraform.after_hook": "append"}}).GetMergeRules()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]config.MergeStrategyType{"terraform.after_hook": config.AppendMerge}, rules)
+}
@@ -43,9 +43,15 @@ type ParsingContext struct {
 	// These functions have the highest priority and will overwrite any others with the same name
 	PredefinedFunctions map[string]function.Function
 
+	// UserFunctions are the functions declared by the `function` blocks available to the current config.
+	UserFunctions map[string]function.Function
+
 	// `ParserOptions` is used to configure hcl Parser.
 	ParserOptions []hclparse.Option
 
+	// TrackProvenance records where the values of the parsed configurations were set, in their Provenance.
+	TrackProvenance bool
+
 	// Set a custom converter to TerragruntConfig.
 	// Used to read a "catalog" configuration where only certain blocks (`catalog`, `locals`) do not need to be converted, avoiding errors if any of the remaining blocks were not evaluated correctly.
 	ConvertToTerragruntConfigFunc func(ctx *ParsingContext, configPath string, terragruntConfigFromFile *terragruntConfigFile) (cfg *TerragruntConfig, err error)
@@ -60,6 +66,14 @@ func NewParsingContext(ctx context.Context, opts *options.TerragruntOptions) *Pa
 		ParserOptions:     DefaultParserOptions(opts),
 	}
 }
+
+// WithContext sets the context the configuration is parsed in, such as the one of the telemetry span of the parsing.
+func (ctx ParsingContext) WithContext(c context.Context) *ParsingContext {
+	ctx.Context = c
+
+	return &ctx
+}
+
 func (ctx ParsingContext) WithDecodeList(decodeList ...PartialDecodeSectionType) *ParsingContext {
 	ctx.PartialParseDecodeList = decodeList
 	return &ctx
@@ -87,6 +101,13 @@ func (ctx ParsingContext) WithFeatures(features *cty.Value) *ParsingContext {
 	return &ctx
 }
 
+// WithUserFunctions sets the user functions to be used in evaluation context.
+func (ctx ParsingContext) WithUserFunctions(functions map[string]function.Function) *ParsingContext {
+	ctx.UserFunctions = functions
+
+	return &ctx
+}
+
 func (ctx ParsingContext) WithTrackInclude(trackInclude *TrackInclude) *ParsingContext {
 	ctx.TrackInclude = trackInclude
 	return &ctx
@@ -96,3 +117,10 @@ func (ctx ParsingContext) WithParseOption(parserOptions []hclparse.Option) *Pars
 	ctx.ParserOptions = parserOptions
 	return &ctx
 }
+
+// WithProvenance enables the tracking of where the values of the parsed configurations were set.
+func (ctx ParsingContext) WithProvenance() *ParsingContext {
+	ctx.TrackProvenance = true
+
+	return &ctx
+}
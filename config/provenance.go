@@ -0,0 +1,420 @@
+package config
+
+import (
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/gruntwork-io/terragrunt/config/hclparse"
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+)
+
+// Provenance records where the values of a configuration were set, through the includes merged into it.
+type Provenance struct {
+	// Origins are the values set by the expressions of the configuration and of its includes, from the attributes
+	// down to the items of the object expressions, sorted by path.
+	Origins []*ValueOrigin
+}
+
+// ValueOrigin is a value of the configuration, and the expression that set it.
+type ValueOrigin struct {
+	// Path is the path of the value in the rendered configuration, such as `inputs.vpc_cidr`.
+	Path string
+
+	// ConfigPath is the file of the expression.
+	ConfigPath string
+
+	// Range is the range of the expression in the file.
+	Range hcl.Range
+
+	// Value is the value of the path in the configuration of the file, with the sensitive values redacted.
+	Value cty.Value
+
+	// IncludeChain are the includes that merged the value into the configuration, from the child configuration.
+	IncludeChain []*IncludeOrigin
+
+	// Locals are the locals referenced by the expression, with the locals they reference in turn.
+	Locals []*ValueOrigin
+
+	// Overridden are the values of the included configurations overridden by this value when they were merged.
+	Overridden []*ValueOrigin
+}
+
+// IncludeOrigin is an include block merging a value into the configuration.
+type IncludeOrigin struct {
+	Name          string
+	Path          string
+	MergeStrategy MergeStrategyType
+}
+
+// Explanation is the final value of a path of the configuration, and where it came from.
+type Explanation struct {
+	Path string
+
+	// Value is the final value of the path, with the sensitive values redacted. It is cty.NilVal if not set.
+	Value cty.Value
+
+	// Origins are the values that make up the final value. The value of a path set by an expression is the value of
+	// a single origin, while the value of an object merged from several configurations has an origin for each item.
+	// When the value is part of a value set as a whole, such as `inputs = local.inputs`, the origin is this value.
+	Origins []*ValueOrigin
+}
+
+// Explain returns the final value of the given path of the configuration, such as `inputs.vpc_cidr`, and where it came
+// from. The configuration must be read with ReadTerragruntConfigWithProvenance.
+func (cfg *TerragruntConfig) Explain(path string) (*Explanation, error) {
+	configCty, err := TerragruntConfigAsCty(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	explanation := &Explanation{
+		Path:  path,
+		Value: ctyValueAtPath(cfg.RedactSensitiveValues(configCty), strings.Split(path, ".")),
+	}
+
+	if cfg.Provenance != nil {
+		explanation.Origins = cfg.Provenance.originsOf(path)
+	}
+
+	if explanation.Value == cty.NilVal && len(explanation.Origins) == 0 {
+		return nil, errors.New(ConfigValueNotSetError{Path: path})
+	}
+
+	return explanation, nil
+}
+
+// originsOf returns the origins of the given path and of the paths below it, or the origin of the closest path above
+// it if there is none.
+func (provenance *Provenance) originsOf(path string) []*ValueOrigin {
+	var origins, parentOrigins []*ValueOrigin
+
+	for _, origin := range provenance.Origins {
+		switch {
+		case origin.Path == path || strings.HasPrefix(origin.Path, path+"."):
+			origins = append(origins, origin)
+		case strings.HasPrefix(path, origin.Path+"."):
+			if len(parentOrigins) > 0 && len(parentOrigins[0].Path) < len(origin.Path) {
+				parentOrigins = nil
+			}
+
+			if len(parentOrigins) == 0 || parentOrigins[0].Path == origin.Path {
+				parentOrigins = append(parentOrigins, origin)
+			}
+		}
+	}
+
+	if len(origins) > 0 {
+		return origins
+	}
+
+	return parentOrigins
+}
+
+// newProvenance records the values set by the expressions of the file, with the values of its configuration, before
+// any include is merged into it.
+func newProvenance(file *hclparse.File, cfg *TerragruntConfig) (*Provenance, error) {
+	// The ranges are only tracked for the native syntax.
+	body, ok := file.Body.(*hclsyntax.Body)
+	if !ok {
+		return &Provenance{}, nil
+	}
+
+	configCty, err := TerragruntConfigAsCty(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	recorder := &provenanceRecorder{
+		configPath: file.ConfigPath,
+		configCty:  cfg.RedactSensitiveValues(configCty),
+		locals:     map[string]*hclsyntax.Attribute{},
+	}
+
+	for _, block := range body.Blocks {
+		if block.Type == MetadataLocals {
+			maps.Copy(recorder.locals, block.Body.Attributes)
+		}
+	}
+
+	recorder.recordBody(nil, body)
+
+	provenance := &Provenance{Origins: recorder.origins}
+	provenance.sort()
+
+	return provenance, nil
+}
+
+// provenanceRecorder records the origins of the values set by the expressions of a file.
+type provenanceRecorder struct {
+	configPath string
+	configCty  cty.Value
+	locals     map[string]*hclsyntax.Attribute
+	origins    []*ValueOrigin
+}
+
+func (recorder *provenanceRecorder) recordBody(path []string, body *hclsyntax.Body) {
+	for _, attr := range body.Attributes {
+		recorder.recordExpr(slices.Concat(path, []string{attr.Name}), attr.Expr)
+	}
+
+	for _, block := range body.Blocks {
+		// The locals are recorded as the origins of the values referencing them, and the includes are merged instead.
+		if len(path) == 0 && (block.Type == MetadataLocals || block.Type == MetadataInclude || block.Type == MetadataFunction) {
+			continue
+		}
+
+		recorder.recordBody(slices.Concat(path, []string{block.Type}, block.Labels), block.Body)
+	}
+}
+
+// recordExpr records the value set by the expression, or by each item of an object expression with static keys.
+func (recorder *provenanceRecorder) recordExpr(path []string, expr hclsyntax.Expression) {
+	if items, ok := staticObjectItems(expr); ok {
+		for _, item := range items {
+			recorder.recordExpr(slices.Concat(path, []string{item.key}), item.expr)
+		}
+
+		return
+	}
+
+	recorder.origins = append(recorder.origins, &ValueOrigin{
+		Path:       strings.Join(path, "."),
+		ConfigPath: recorder.configPath,
+		Range:      expr.Range(),
+		Value:      ctyValueAtPath(recorder.configCty, path),
+		Locals:     recorder.localOrigins(expr, map[string]bool{}),
+	})
+}
+
+// localOrigins returns the origins of the locals referenced by the expression.
+func (recorder *provenanceRecorder) localOrigins(expr hclsyntax.Expression, visited map[string]bool) []*ValueOrigin {
+	var origins []*ValueOrigin
+
+	for _, traversal := range expr.Variables() {
+		if traversal.RootName() != MetadataLocal || len(traversal) < 2 { //nolint:mnd
+			continue
+		}
+
+		attr, ok := traversal[1].(hcl.TraverseAttr)
+		if !ok || visited[attr.Name] {
+			continue
+		}
+
+		local, ok := recorder.locals[attr.Name]
+		if !ok {
+			continue
+		}
+
+		visited[attr.Name] = true
+
+		origins = append(origins, &ValueOrigin{
+			Path:       MetadataLocal + "." + attr.Name,
+			ConfigPath: recorder.configPath,
+			Range:      local.Expr.Range(),
+			Value:      ctyValueAtPath(recorder.configCty, []string{MetadataLocals, attr.Name}),
+			Locals:     recorder.localOrigins(local.Expr, visited),
+		})
+	}
+
+	return origins
+}
+
+type objectItem struct {
+	key  string
+	expr hclsyntax.Expression
+}
+
+// staticObjectItems returns the items of an object expression, if all its keys are static strings.
+func staticObjectItems(expr hclsyntax.Expression) ([]objectItem, bool) {
+	objectExpr, ok := expr.(*hclsyntax.ObjectConsExpr)
+	if !ok || len(objectExpr.Items) == 0 {
+		return nil, false
+	}
+
+	items := make([]objectItem, 0, len(objectExpr.Items))
+
+	for _, item := range objectExpr.Items {
+		key, diags := item.KeyExpr.Value(nil)
+		if diags.HasErrors() || !key.IsKnown() || key.IsNull() || key.Type() != cty.String {
+			return nil, false
+		}
+
+		items = append(items, objectItem{key: key.AsString(), expr: item.ValueExpr})
+	}
+
+	return items, true
+}
+
+// mergeProvenance returns the provenance of the child configuration with the included configuration merged into it,
+// following the merge strategy and the merge rules of the include. The values of the included configuration overridden
+// by the child are recorded in the origins of the child overriding them.
+func mergeProvenance(child, included *Provenance, include *IncludeConfig, strategy MergeStrategyType, rules map[string]MergeStrategyType) *Provenance {
+	if child == nil || included == nil {
+		return child
+	}
+
+	merged := &Provenance{Origins: slices.Clone(child.Origins)}
+
+	for _, origin := range included.Origins {
+		if slices.ContainsFunc(include.RemoveBlocks, func(block string) bool { return isPathWithin(origin.Path, block) }) {
+			continue
+		}
+
+		originStrategy := mergeStrategyForPath(origin.Path, strategy, rules)
+		if originStrategy == NoMerge {
+			continue
+		}
+
+		includedOrigin := *origin
+		includedOrigin.IncludeChain = slices.Concat([]*IncludeOrigin{{
+			Name:          include.Name,
+			Path:          include.Path,
+			MergeStrategy: originStrategy,
+		}}, origin.IncludeChain)
+
+		if overriding := findOverridingOrigin(child.Origins, &includedOrigin, originStrategy); overriding != nil {
+			overriding.Overridden = append(overriding.Overridden, &includedOrigin)
+			continue
+		}
+
+		merged.Origins = append(merged.Origins, &includedOrigin)
+	}
+
+	merged.sort()
+
+	return merged
+}
+
+func (provenance *Provenance) sort() {
+	sort.SliceStable(provenance.Origins, func(i, j int) bool {
+		return provenance.Origins[i].Path < provenance.Origins[j].Path
+	})
+}
+
+// mergeStrategyForPath returns the merge strategy of the path, set by the closest merge rule above it, or by the
+// include.
+func mergeStrategyForPath(path string, strategy MergeStrategyType, rules map[string]MergeStrategyType) MergeStrategyType {
+	rulePath := ""
+
+	for candidate := range rules {
+		if isPathWithin(path, candidate) && len(candidate) > len(rulePath) {
+			rulePath = candidate
+		}
+	}
+
+	if rulePath == "" {
+		// The merge rules are applied with the `no_merge` strategy too, but the other values are not merged.
+		return strategy
+	}
+
+	return rules[rulePath]
+}
+
+// findOverridingOrigin returns the origin of the child overriding the value of the included origin when they are
+// merged with the given strategy, or nil if the included value is kept.
+func findOverridingOrigin(childOrigins []*ValueOrigin, included *ValueOrigin, strategy MergeStrategyType) *ValueOrigin {
+	if strategy == AppendMerge {
+		return nil
+	}
+
+	// The collections are combined together by the deep merge.
+	if strategy == DeepMerge && isCollection(included.Value) {
+		return nil
+	}
+
+	unit := mergeUnit(strings.Split(included.Path, "."), strategy)
+
+	for _, child := range childOrigins {
+		childPath := strings.Split(child.Path, ".")
+
+		switch {
+		case hasPathPrefix(childPath, unit):
+			return child
+		case hasPathPrefix(unit, childPath):
+			// The child sets a value above the unit, such as `inputs = local.inputs`, which overrides the unit if the
+			// value contains it.
+			if value := ctyValueAtPath(child.Value, unit[len(childPath):]); value != cty.NilVal && !value.IsNull() {
+				return child
+			}
+		}
+	}
+
+	return nil
+}
+
+// mergeUnit returns the path of the value containing the given path, that is replaced as a whole by the value of the
+// child when merged with the given strategy. For example, the shallow merge replaces the inputs one by one, and the
+// deep merge replaces the values inside the inputs.
+func mergeUnit(path []string, strategy MergeStrategyType) []string {
+	depth := 1
+
+	switch path[0] {
+	case MetadataInputs:
+		depth = 2 //nolint:mnd
+
+		if strategy == DeepMerge {
+			depth = len(path)
+		}
+	case MetadataTerraform:
+		depth = 2 //nolint:mnd
+
+		if len(path) > 1 && slices.Contains([]string{"extra_arguments", "before_hook", "after_hook", "error_hook"}, path[1]) {
+			depth = 3 //nolint:mnd
+		}
+	case MetadataGenerateConfigs, MetadataDependency, MetadataFeatureFlag:
+		depth = 2 //nolint:mnd
+
+		if strategy == DeepMerge && path[0] != MetadataGenerateConfigs {
+			depth = len(path)
+		}
+	}
+
+	return path[:min(depth, len(path))]
+}
+
+// ctyValueAtPath returns the value of the given path of attributes and map keys, or cty.NilVal if it is not set.
+func ctyValueAtPath(value cty.Value, path []string) cty.Value {
+	for _, name := range path {
+		if value == cty.NilVal || value.IsNull() || !value.IsKnown() {
+			return cty.NilVal
+		}
+
+		value, _ = value.Unmark()
+
+		switch {
+		case value.Type().IsObjectType() && value.Type().HasAttribute(name):
+			value = value.GetAttr(name)
+		case value.Type().IsMapType() && value.HasIndex(cty.StringVal(name)).True():
+			value = value.Index(cty.StringVal(name))
+		default:
+			return cty.NilVal
+		}
+	}
+
+	return value
+}
+
+func isCollection(value cty.Value) bool {
+	if value == cty.NilVal {
+		return false
+	}
+
+	ty := value.Type()
+
+	return ty.IsObjectType() || ty.IsMapType() || ty.IsListType() || ty.IsTupleType() || ty.IsSetType()
+}
+
+// isPathWithin returns true if the path is the given parent path, or a path below it.
+func isPathWithin(path, parent string) bool {
+	return path == parent || strings.HasPrefix(path, parent+".")
+}
+
+func hasPathPrefix(path, prefix []string) bool {
+	return len(path) >= len(prefix) && slices.Equal(path[:len(prefix)], prefix)
+}
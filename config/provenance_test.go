@@ -0,0 +1,139 @@
+package config_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/gruntwork-io/terragrunt/config"
+)
+
+func TestExplainConfigValues(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	rootPath := filepath.Join(dir, "root.hcl")
+
+	require.NoError(t, os.WriteFile(rootPath, []byte(`
+locals {
+  region = "us-east-1"
+}
+
+inputs = {
+  vpc_cidr = "10.1.0.0/16"
+  region   = local.region
+  tags = {
+    team = "platform"
+  }
+}
+`), 0644))
+
+	configPath := filepath.Join(dir, "unit", config.DefaultTerragruntConfigPath)
+	require.NoError(t, os.MkdirAll(filepath.Dir(configPath), os.ModePerm))
+	require.NoError(t, os.WriteFile(configPath, []byte(`
+include "root" {
+  path           = find_in_parent_folders("root.hcl")
+  merge_strategy = "deep"
+}
+
+locals {
+  cidr = "10.0.0.0/16"
+}
+
+inputs = {
+  vpc_cidr = local.cidr
+  tags = {
+    unit = "vpc"
+  }
+}
+`), 0644))
+
+	opts := mockOptionsForTestWithConfigPath(t, configPath)
+
+	cfg, err := config.ReadTerragruntConfigWithProvenance(context.Background(), opts, config.DefaultParserOptions(opts))
+	require.NoError(t, err)
+
+	explanation, err := cfg.Explain("inputs.vpc_cidr")
+	require.NoError(t, err)
+	assert.Equal(t, cty.StringVal("10.0.0.0/16"), explanation.Value)
+	require.Len(t, explanation.Origins, 1)
+
+	origin := explanation.Origins[0]
+	assert.Equal(t, configPath, origin.ConfigPath)
+	assert.Equal(t, 12, origin.Range.Start.Line)
+	assert.Empty(t, origin.IncludeChain)
+	require.Len(t, origin.Locals, 1)
+	assert.Equal(t, "local.cidr", origin.Locals[0].Path)
+	assert.Equal(t, 8, origin.Locals[0].Range.Start.Line)
+
+	require.Len(t, origin.Overridden, 1)
+	assert.Equal(t, rootPath, origin.Overridden[0].ConfigPath)
+	assert.Equal(t, cty.StringVal("10.1.0.0/16"), origin.Overridden[0].Value)
+	assert.Equal(t, []*config.IncludeOrigin{{Name: "root", Path: rootPath, MergeStrategy: config.DeepMerge}}, origin.Overridden[0].IncludeChain)
+
+	// The tags are deep merged from both configurations.
+	explanation, err = cfg.Explain("inputs.tags")
+	require.NoError(t, err)
+	require.Len(t, explanation.Origins, 2)
+	assert.Equal(t, "inputs.tags.team", explanation.Origins[0].Path)
+	assert.Equal(t, rootPath, explanation.Origins[0].ConfigPath)
+	assert.Equal(t, "inputs.tags.unit", explanation.Origins[1].Path)
+	assert.Equal(t, configPath, explanation.Origins[1].ConfigPath)
+
+	explanation, err = cfg.Explain("inputs.region")
+	require.NoError(t, err)
+	require.Len(t, explanation.Origins, 1)
+	require.Len(t, explanation.Origins[0].Locals, 1)
+	assert.Equal(t, cty.StringVal("us-east-1"), explanation.Origins[0].Locals[0].Value)
+
+	_, err = cfg.Explain("inputs.missing")
+
+	var notSetErr config.ConfigValueNotSetError
+	require.ErrorAs(t, err, &notSetErr)
+}
+
+func TestExplainShallowMergeOverridesWholeInputs(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "root.hcl"), []byte(`
+inputs = {
+  tags = {
+    team = "platform"
+  }
+}
+`), 0644))
+
+	configPath := filepath.Join(dir, "unit", config.DefaultTerragruntConfigPath)
+	require.NoError(t, os.MkdirAll(filepath.Dir(configPath), os.ModePerm))
+	require.NoError(t, os.WriteFile(configPath, []byte(`
+include "root" {
+  path = find_in_parent_folders("root.hcl")
+}
+
+inputs = {
+  tags = {
+    unit = "vpc"
+  }
+}
+`), 0644))
+
+	opts := mockOptionsForTestWithConfigPath(t, configPath)
+
+	cfg, err := config.ReadTerragruntConfigWithProvenance(context.Background(), opts, config.DefaultParserOptions(opts))
+	require.NoError(t, err)
+
+	explanation, err := cfg.Explain("inputs.tags")
+	require.NoError(t, err)
+	require.Len(t, explanation.Origins, 1)
+	assert.Equal(t, "inputs.tags.unit", explanation.Origins[0].Path)
+	require.Len(t, explanation.Origins[0].Overridden, 1)
+	assert.Equal(t, "inputs.tags.team", explanation.Origins[0].Overridden[0].Path)
+	assert.Equal(t, config.ShallowMerge, explanation.Origins[0].Overridden[0].IncludeChain[0].MergeStrategy)
+}
@@ -0,0 +1,117 @@
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"time"
+
+	"github.com/gruntwork-io/terragrunt/internal/cache"
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/util"
+)
+
+const (
+	// runCmdCacheTTLOption enables the cache on disk of the result of `run_cmd`, for the given duration.
+	runCmdCacheTTLOption = "--terragrunt-cache-ttl"
+
+	// runCmdCacheEnvOption adds the comma separated environment variables to the key of the result cached on disk.
+	runCmdCacheEnvOption = "--terragrunt-cache-env"
+
+	runCmdDiskCacheName = "runCmdDiskCache"
+	runCmdCacheDirName  = "run-cmd"
+)
+
+// runCmdDiskCache caches the result of a `run_cmd` call on disk, where it is shared by the units and the runs of
+// Terragrunt until its TTL elapses.
+type runCmdDiskCache struct {
+	cache *cache.DiskCache[string]
+	key   string
+	ttl   time.Duration
+}
+
+// runCmdCacheKey identifies the result of a `run_cmd` call cached on disk.
+type runCmdCacheKey struct {
+	Env     map[string]string `json:"env"`
+	Dir     string            `json:"dir"`
+	Command string            `json:"command"`
+	Args    []string          `json:"args"`
+}
+
+// runCmdCacheDir returns the directory of the results of `run_cmd` cached on disk.
+func runCmdCacheDir(opts *options.TerragruntOptions) (string, error) {
+	if opts.RunCmdCacheDir != "" {
+		return opts.RunCmdCacheDir, nil
+	}
+
+	userCacheDir, err := util.GetCacheDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(userCacheDir, runCmdCacheDirName), nil
+}
+
+// ClearRunCmdCache removes all the results of `run_cmd` cached on disk.
+func ClearRunCmdCache(ctx context.Context, opts *options.TerragruntOptions) error {
+	cacheDir, err := runCmdCacheDir(opts)
+	if err != nil {
+		return err
+	}
+
+	opts.Logger.Debugf("Clearing the run_cmd results cached in %s", cacheDir)
+
+	return cache.NewDiskCache[string](runCmdDiskCacheName, cacheDir).Clear(ctx)
+}
+
+func newRunCmdDiskCache(ctx *ParsingContext, cachePath string, args, envVars []string, ttl time.Duration) (*runCmdDiskCache, error) {
+	cacheDir, err := runCmdCacheDir(ctx.TerragruntOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	cacheKey := runCmdCacheKey{
+		Env:     make(map[string]string, len(envVars)),
+		Dir:     cachePath,
+		Command: args[0],
+		Args:    args[1:],
+	}
+
+	for _, name := range envVars {
+		cacheKey.Env[name] = ctx.TerragruntOptions.Env[name]
+	}
+
+	key, err := json.Marshal(cacheKey)
+	if err != nil {
+		return nil, errors.New(err)
+	}
+
+	return &runCmdDiskCache{
+		cache: cache.NewDiskCache[string](runCmdDiskCacheName, cacheDir),
+		key:   string(key),
+		ttl:   ttl,
+	}, nil
+}
+
+// get returns the cached result, unless the cache is refreshed with `--run-cmd-cache-refresh`.
+func (c *runCmdDiskCache) get(ctx *ParsingContext) (string, bool) {
+	if ctx.TerragruntOptions.RunCmdCacheRefresh {
+		return "", false
+	}
+
+	return c.cache.Get(ctx, c.key)
+}
+
+// put caches the result and removes the expired results of the other commands. Failing to cache it is not an error,
+// since the command is run again by the next runs.
+func (c *runCmdDiskCache) put(ctx *ParsingContext, value string) {
+	if err := c.cache.Put(ctx, c.key, value, time.Now().Add(c.ttl)); err != nil {
+		ctx.TerragruntOptions.Logger.Warnf("Failed to cache the run_cmd result in %s: %v", c.cache.Dir, err)
+		return
+	}
+
+	if err := c.cache.DeleteExpired(ctx); err != nil {
+		ctx.TerragruntOptions.Logger.Warnf("Failed to remove the expired run_cmd results from %s: %v", c.cache.Dir, err)
+	}
+}
@@ -0,0 +1,83 @@
+package config
+
+import (
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/options"
+)
+
+// runCmdDefaultPlaceholder is the key of the placeholder value returned with `--no-run-cmd` for the commands without
+// their own placeholder value.
+const runCmdDefaultPlaceholder = "*"
+
+// runCmdBaseEnvVars are the environment variables kept in the stripped environment of the commands of `run_cmd`, which
+// most commands need to run.
+var runCmdBaseEnvVars = []string{"PATH", "HOME", "USER", "LANG", "TMPDIR", "TMP", "TEMP", "SYSTEMROOT"}
+
+// runCmdPlaceholder returns the value returned by `run_cmd` for the command with `--no-run-cmd`.
+func runCmdPlaceholder(opts *options.TerragruntOptions, command string) string {
+	if value, ok := opts.RunCmdPlaceholders[command]; ok {
+		return value
+	}
+
+	return opts.RunCmdPlaceholders[runCmdDefaultPlaceholder]
+}
+
+// checkRunCmdAllowed returns an error if the command is not in the executables allowed with `--run-cmd-allow`. The
+// allowed executables without path separator match the commands run by their name, found in PATH. The others match
+// the commands run by their path, relative to the directory of the configuration, and are relative to the working
+// directory of Terragrunt.
+func checkRunCmdAllowed(opts *options.TerragruntOptions, configDir, command string) error {
+	if len(opts.RunCmdAllow) == 0 {
+		return nil
+	}
+
+	isPath := strings.ContainsAny(command, `/\`)
+
+	commandPath := command
+	if isPath && !filepath.IsAbs(commandPath) {
+		commandPath = filepath.Join(configDir, commandPath)
+	}
+
+	for _, allowed := range opts.RunCmdAllow {
+		if !strings.ContainsAny(allowed, `/\`) {
+			if matched, _ := filepath.Match(allowed, command); matched && !isPath {
+				return nil
+			}
+
+			continue
+		}
+
+		if !filepath.IsAbs(allowed) {
+			allowed = filepath.Join(opts.WorkingDir, allowed)
+		}
+
+		if matched, _ := filepath.Match(filepath.Clean(allowed), filepath.Clean(commandPath)); matched && isPath {
+			return nil
+		}
+	}
+
+	return errors.New(RunCmdNotAllowedError{Command: command, Allowed: opts.RunCmdAllow})
+}
+
+// runCmdOptions returns the options to run the commands of `run_cmd` with, with the environment stripped by
+// `--run-cmd-strip-env`.
+func runCmdOptions(opts *options.TerragruntOptions) *options.TerragruntOptions {
+	if !opts.RunCmdStripEnv {
+		return opts
+	}
+
+	strippedOpts := opts.Clone()
+	strippedOpts.Env = make(map[string]string)
+
+	for name, value := range opts.Env {
+		if slices.Contains(runCmdBaseEnvVars, strings.ToUpper(name)) || slices.Contains(opts.RunCmdKeepEnv, name) {
+			strippedOpts.Env[name] = value
+		}
+	}
+
+	return strippedOpts
+}
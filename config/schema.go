@@ -0,0 +1,59 @@
+package config
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/gohcl"
+)
+
+// BlockSchemas returns the schemas of the bodies of the Terragrunt configuration file and of its nested blocks. The
+// schemas are keyed by the dotted path of the block types, such as `terraform.before_hook`, the schema of the
+// top-level body is keyed by an empty string.
+func BlockSchemas() map[string]*hcl.BodySchema {
+	schemas := map[string]*hcl.BodySchema{}
+
+	addBlockSchemas(schemas, "", reflect.TypeOf(terragruntConfigFile{}))
+
+	// The `include` blocks are decoded in a separate cycle, so they are ignored by the configuration file struct.
+	addBlockSchemas(schemas, MetadataInclude, reflect.TypeOf(IncludeConfig{}))
+
+	return schemas
+}
+
+func addBlockSchemas(schemas map[string]*hcl.BodySchema, path string, ty reflect.Type) {
+	schema, _ := gohcl.ImpliedBodySchema(reflect.New(ty).Interface())
+	schemas[path] = schema
+
+	for i := 0; i < ty.NumField(); i++ {
+		field := ty.Field(i)
+
+		name, kind, _ := strings.Cut(field.Tag.Get("hcl"), ",")
+		if kind != "block" {
+			continue
+		}
+
+		blockType := field.Type
+		for blockType.Kind() == reflect.Ptr || blockType.Kind() == reflect.Slice {
+			blockType = blockType.Elem()
+		}
+
+		if blockType.Kind() != reflect.Struct {
+			continue
+		}
+
+		blockPath := name
+		if path != "" {
+			blockPath = path + "." + name
+		}
+
+		addBlockSchemas(schemas, blockPath, blockType)
+	}
+}
+
+// NewEvalContext returns the evaluation context of the given configuration, with the Terragrunt functions and the
+// variables of the parsing context, such as `local` and `dependency`.
+func NewEvalContext(ctx *ParsingContext, configPath string) (*hcl.EvalContext, error) {
+	return createTerragruntEvalContext(ctx, configPath)
+}
@@ -0,0 +1,91 @@
+package config
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/function"
+	"github.com/zclconf/go-cty/cty/gocty"
+
+	"github.com/gruntwork-io/terragrunt/internal/cache"
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/internal/secrets"
+	"github.com/gruntwork-io/terragrunt/options"
+)
+
+// maxGetSecretParams is the number of parameters of get_secret with the optional parameters of the provider.
+const maxGetSecretParams = 3
+
+func init() {
+	secrets.Register(secrets.ProviderSops, &sopsSecretProvider{})
+}
+
+// sopsSecretProvider decrypts the files encrypted with sops, referenced by their path relative to the configuration.
+// The files are decrypted as by `sops_decrypt_file`, sharing its cache and its error messages.
+type sopsSecretProvider struct{}
+
+func (provider *sopsSecretProvider) Params() []string {
+	return nil
+}
+
+func (provider *sopsSecretProvider) Secret(ctx context.Context, opts *options.TerragruntOptions, ref string, _ map[string]string) (string, error) {
+	return sopsDecryptFile(NewParsingContext(ctx, opts), []string{ref})
+}
+
+// Create a cty Function that can be used for calling get_secret.
+func getSecretAsFuncImpl(ctx *ParsingContext) function.Function {
+	return function.New(&function.Spec{
+		// Takes the name of the provider and the reference to the secret
+		Params: []function.Parameter{{Name: "provider", Type: cty.String}, {Name: "ref", Type: cty.String}},
+		// And an optional map of the parameters of the provider
+		VarParam: &function.Parameter{Name: "params", Type: cty.Map(cty.String)},
+		Type:     function.StaticReturnType(cty.String),
+		Impl: func(args []cty.Value, retType cty.Type) (cty.Value, error) {
+			if numParams := len(args); numParams > maxGetSecretParams {
+				return cty.NilVal, errors.New(WrongNumberOfParamsError{Func: FuncNameGetSecret, Expected: "2 or 3", Actual: numParams})
+			}
+
+			params := map[string]string{}
+
+			if len(args) == maxGetSecretParams && !args[2].IsNull() {
+				if err := gocty.FromCtyValue(args[2], &params); err != nil {
+					return cty.NilVal, errors.New(err)
+				}
+			}
+
+			secret, err := getSecret(ctx, args[0].AsString(), args[1].AsString(), params)
+			if err != nil {
+				return cty.NilVal, err
+			}
+
+			return cty.StringVal(secret).Mark(MarkSensitive), nil
+		},
+	})
+}
+
+// getSecret returns the secret from the provider, which is fetched once per run.
+func getSecret(ctx *ParsingContext, provider, ref string, params map[string]string) (string, error) {
+	secretCache := cache.ContextCache[string](ctx, SecretCacheContextKey)
+
+	cacheKey := provider + "|" + ref
+	for _, name := range slices.Sorted(maps.Keys(params)) {
+		cacheKey += "|" + name + "=" + params[name]
+	}
+
+	if secret, found := secretCache.Get(ctx, cacheKey); found {
+		return secret, nil
+	}
+
+	ctx.TerragruntOptions.Logger.Debugf("Fetching secret %s from the %s secret provider", ref, provider)
+
+	secret, err := secrets.Resolve(ctx, ctx.TerragruntOptions, provider, ref, params)
+	if err != nil {
+		return "", err
+	}
+
+	secretCache.Put(ctx, cacheKey, secret)
+
+	return secret, nil
+}
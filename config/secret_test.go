@@ -0,0 +1,106 @@
+package config_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/gruntwork-io/terragrunt/config"
+)
+
+func TestParseTerragruntConfigGetSecret(t *testing.T) {
+	t.Parallel()
+
+	cfg := `
+locals {
+  password = get_secret("env", "DB_PASSWORD")
+  user     = get_secret("env", "DB_CONFIG", { key = "user" })
+}
+
+inputs = {
+  database = {
+    user     = local.user
+    password = local.password
+    port     = 5432
+  }
+  region = "us-east-1"
+}
+`
+
+	opts := mockOptionsForTest(t)
+	opts.Env = map[string]string{
+		"DB_PASSWORD": "hunter2",
+		"DB_CONFIG":   `{"user": "admin"}`,
+	}
+
+	ctx := config.NewParsingContext(context.Background(), opts)
+	terragruntConfig, err := config.ParseConfigString(ctx, config.DefaultTerragruntConfigPath, cfg, nil)
+	require.NoError(t, err)
+
+	// The configuration holds the actual values, to be passed to OpenTofu/Terraform.
+	assert.Equal(t, map[string]interface{}{"user": "admin", "password": "hunter2", "port": float64(5432)}, terragruntConfig.Inputs["database"])
+	assert.Equal(t, "hunter2", terragruntConfig.Locals["password"])
+
+	assert.ElementsMatch(t, []cty.Path{
+		cty.GetAttrPath("inputs").GetAttr("database").GetAttr("user"),
+		cty.GetAttrPath("inputs").GetAttr("database").GetAttr("password"),
+		cty.GetAttrPath("locals").GetAttr("password"),
+		cty.GetAttrPath("locals").GetAttr("user"),
+	}, terragruntConfig.SensitivePaths)
+
+	inputs, err := terragruntConfig.RedactedInputs()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"database": map[string]interface{}{"user": config.RedactedValue, "password": config.RedactedValue, "port": float64(5432)},
+		"region":   "us-east-1",
+	}, inputs)
+
+	configCty, err := config.TerragruntConfigAsCty(terragruntConfig)
+	require.NoError(t, err)
+
+	redacted := terragruntConfig.RedactSensitiveValues(configCty)
+	assert.Equal(t, cty.StringVal(config.RedactedValue), redacted.GetAttr("inputs").GetAttr("database").GetAttr("password"))
+	assert.Equal(t, cty.StringVal(config.RedactedValue), redacted.GetAttr("locals").GetAttr("user"))
+	assert.Equal(t, cty.StringVal("us-east-1"), redacted.GetAttr("inputs").GetAttr("region"))
+}
+
+func TestParseTerragruntConfigGetSecretNotFound(t *testing.T) {
+	t.Parallel()
+
+	cfg := `
+inputs = {
+  password = get_secret("env", "MISSING_PASSWORD")
+}
+`
+
+	ctx := config.NewParsingContext(context.Background(), mockOptionsForTest(t))
+	_, err := config.ParseConfigString(ctx, config.DefaultTerragruntConfigPath, cfg, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `Secret "MISSING_PASSWORD" not found by the env secret provider`)
+}
+
+func TestParseTerragruntConfigGetSecretSops(t *testing.T) {
+	t.Parallel()
+
+	cfg := `
+inputs = {
+  password = get_secret("sops", "plain.json", { key = "password" })
+}
+`
+	configPath := filepath.Join(t.TempDir(), config.DefaultTerragruntConfigPath)
+	require.NoError(t, os.WriteFile(filepath.Join(filepath.Dir(configPath), "plain.json"), []byte(`{"password": "hunter2"}`), 0644))
+
+	opts := mockOptionsForTest(t)
+	opts.TerragruntConfigPath = configPath
+
+	// The file is decrypted by `sops_decrypt_file`, which refuses files that are not encrypted.
+	ctx := config.NewParsingContext(context.Background(), opts)
+	_, err := config.ParseConfigString(ctx, configPath, cfg, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "sops metadata not found")
+}
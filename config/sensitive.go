@@ -0,0 +1,323 @@
+package config
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/function"
+)
+
+const (
+	// MarkSensitive is the mark of the sensitive values, such as the values returned by `get_secret`. This is the mark
+	// set by the `sensitive` function of OpenTofu/Terraform, and removed by its `nonsensitive` function.
+	MarkSensitive = "sensitive"
+
+	// RedactedValue replaces the sensitive values in the outputs of Terragrunt.
+	RedactedValue = "(sensitive value)"
+
+	// metadataValue is the attribute of the value of a field rendered with its metadata.
+	metadataValue = "value"
+)
+
+// sensitiveEnvVarNameParts are the parts of the names of the environment variables holding secrets, whose values are
+// sensitive when read with `get_env`.
+var sensitiveEnvVarNameParts = []string{"PASSWORD", "PASSWD", "SECRET", "TOKEN", "PRIVATE_KEY", "ACCESS_KEY", "API_KEY", "CREDENTIAL"}
+
+// isSensitiveEnvVar returns true if the environment variable holds a secret, judging by its name.
+func isSensitiveEnvVar(name string) bool {
+	name = strings.ToUpper(name)
+
+	return slices.ContainsFunc(sensitiveEnvVarNameParts, func(part string) bool {
+		return strings.Contains(name, part)
+	})
+}
+
+// isSensitiveGetEnv returns true if `get_env` reads an environment variable holding a secret.
+func isSensitiveGetEnv(args []cty.Value) bool {
+	return len(args) > 0 && args[0].IsKnown() && !args[0].IsNull() && isSensitiveEnvVar(args[0].AsString())
+}
+
+// isSensitiveSopsDecryptFile returns true, since the files decrypted by `sops_decrypt_file` always hold secrets.
+func isSensitiveSopsDecryptFile([]cty.Value) bool {
+	return true
+}
+
+// wrapSensitiveResult wraps the function to mark its result as sensitive when isSensitive returns true for its
+// arguments.
+func wrapSensitiveResult(fn function.Function, isSensitive func(args []cty.Value) bool) function.Function {
+	return function.New(&function.Spec{
+		Params:   fn.Params(),
+		VarParam: fn.VarParam(),
+		Type:     fn.ReturnTypeForValues,
+		Impl: func(args []cty.Value, retType cty.Type) (cty.Value, error) {
+			result, err := fn.Call(args)
+			if err != nil || !isSensitive(args) {
+				return result, err
+			}
+
+			return result.Mark(MarkSensitive), nil
+		},
+	})
+}
+
+// Create a cty Function that can be used for calling nonsensitive. Unlike the `nonsensitive` function of
+// OpenTofu/Terraform, it removes the sensitive marks nested in the value as well, and accepts the values that are not
+// sensitive, since which values are sensitive depends on the dependencies and the environment.
+func nonsensitiveAsFuncImpl() function.Function {
+	return function.New(&function.Spec{
+		Params: []function.Parameter{{
+			Name:             "value",
+			Type:             cty.DynamicPseudoType,
+			AllowUnknown:     true,
+			AllowNull:        true,
+			AllowMarked:      true,
+			AllowDynamicType: true,
+		}},
+		Type: func(args []cty.Value) (cty.Type, error) {
+			return args[0].Type(), nil
+		},
+		Impl: func(args []cty.Value, retType cty.Type) (cty.Value, error) {
+			val, pathMarks := args[0].UnmarkDeepWithPaths()
+
+			otherPathMarks := make([]cty.PathValueMarks, 0, len(pathMarks))
+
+			for _, pathMark := range pathMarks {
+				marks := cty.ValueMarks{}
+
+				for mark := range pathMark.Marks {
+					if mark != MarkSensitive {
+						marks[mark] = struct{}{}
+					}
+				}
+
+				if len(marks) > 0 {
+					otherPathMarks = append(otherPathMarks, cty.PathValueMarks{Path: pathMark.Path, Marks: marks})
+				}
+			}
+
+			return val.MarkWithPaths(otherPathMarks), nil
+		},
+	})
+}
+
+// sensitivePaths returns the paths of the sensitive values among the marked values, prefixed with the given path.
+func sensitivePaths(prefix cty.Path, pathMarks []cty.PathValueMarks) []cty.Path {
+	var paths []cty.Path
+
+	for _, pathMark := range pathMarks {
+		if _, ok := pathMark.Marks[MarkSensitive]; ok {
+			paths = append(paths, append(prefix.Copy(), pathMark.Path...))
+		}
+	}
+
+	return paths
+}
+
+// mergeSensitivePaths adds the paths of the sensitive values of the included configuration to the target configuration.
+// The locals are not merged, so neither are their paths.
+func mergeSensitivePaths(sourceConfig *TerragruntConfig, targetConfig *TerragruntConfig) {
+	for _, path := range sourceConfig.SensitivePaths {
+		if root, ok := path[0].(cty.GetAttrStep); ok && root.Name == MetadataLocals {
+			continue
+		}
+
+		if !slices.ContainsFunc(targetConfig.SensitivePaths, path.Equals) {
+			targetConfig.SensitivePaths = append(targetConfig.SensitivePaths, path)
+		}
+	}
+}
+
+// copyLocalsSensitivePaths replaces the paths of the sensitive locals of the target configuration with the ones of the
+// source configuration, whose locals are in scope.
+func copyLocalsSensitivePaths(sourceConfig *TerragruntConfig, targetConfig *TerragruntConfig) {
+	isLocalsPath := func(path cty.Path) bool {
+		root, ok := path[0].(cty.GetAttrStep)
+		return ok && root.Name == MetadataLocals
+	}
+
+	// A new slice is built, since the paths of the target configuration may share their array with the source one.
+	paths := make([]cty.Path, 0, len(targetConfig.SensitivePaths))
+
+	for _, path := range targetConfig.SensitivePaths {
+		if !isLocalsPath(path) {
+			paths = append(paths, path)
+		}
+	}
+
+	for _, path := range sourceConfig.SensitivePaths {
+		if isLocalsPath(path) {
+			paths = append(paths, path)
+		}
+	}
+
+	targetConfig.SensitivePaths = paths
+}
+
+// IsSensitive returns true if the value at the given path of the configuration, such as `iam_role`, is sensitive or is
+// part of a sensitive value.
+func (cfg *TerragruntConfig) IsSensitive(path cty.Path) bool {
+	for _, sensitivePath := range cfg.SensitivePaths {
+		if len(sensitivePath) <= len(path) && sensitivePath.Equals(path[:len(sensitivePath)]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RedactSensitiveValues replaces the sensitive values of the configuration, rendered as cty by TerragruntConfigAsCty, with
+// RedactedValue.
+func (cfg *TerragruntConfig) RedactSensitiveValues(configCty cty.Value) cty.Value {
+	configCty, pathMarks := configCty.UnmarkDeepWithPaths()
+
+	// The values still marked in the rendered configuration, such as the sensitive outputs of the dependencies, are
+	// redacted as well.
+	paths := append(slices.Clone(cfg.SensitivePaths), sensitivePaths(nil, pathMarks)...)
+
+	return transformPaths(configCty, paths, redact)
+}
+
+// RedactSensitiveValuesWithMetadata replaces the sensitive values of the configuration, rendered as cty by
+// TerragruntConfigAsCtyWithMetadata, with RedactedValue.
+func (cfg *TerragruntConfig) RedactSensitiveValuesWithMetadata(configCty cty.Value) cty.Value {
+	configCty, pathMarks := configCty.UnmarkDeepWithPaths()
+
+	paths := make([]cty.Path, 0, len(cfg.SensitivePaths))
+
+	// The value of each input and local is wrapped with its metadata, while the other fields are wrapped as a whole.
+	for _, path := range cfg.SensitivePaths {
+		depth := 1
+		if root, ok := path[0].(cty.GetAttrStep); ok && (root.Name == MetadataInputs || root.Name == MetadataLocals) {
+			depth = 2
+		}
+
+		if len(path) < depth {
+			paths = append(paths, path)
+			continue
+		}
+
+		wrapped := append(path[:depth:depth], cty.GetAttrStep{Name: metadataValue})
+		paths = append(paths, append(wrapped, path[depth:]...))
+	}
+
+	paths = append(paths, sensitivePaths(nil, pathMarks)...)
+
+	return transformPaths(configCty, paths, redact)
+}
+
+// RedactMarkedValues replaces the values marked as sensitive, such as the locals read with `get_secret`, with
+// RedactedValue, and removes the other marks.
+func RedactMarkedValues(val cty.Value) cty.Value {
+	val, pathMarks := val.UnmarkDeepWithPaths()
+
+	return transformPaths(val, sensitivePaths(nil, pathMarks), redact)
+}
+
+// MarkSensitiveValues marks the sensitive values of the configuration, rendered as cty by TerragruntConfigAsCty, so
+// that they stay sensitive in the configurations reading it with `read_terragrunt_config` or an exposed include.
+func (cfg *TerragruntConfig) MarkSensitiveValues(configCty cty.Value) cty.Value {
+	return transformPaths(configCty, cfg.SensitivePaths, func(val cty.Value) cty.Value {
+		return val.Mark(MarkSensitive)
+	})
+}
+
+// RedactedInputs returns the inputs with their sensitive values replaced with RedactedValue.
+func (cfg *TerragruntConfig) RedactedInputs() (map[string]interface{}, error) {
+	var paths []cty.Path
+
+	for _, path := range cfg.SensitivePaths {
+		if root, ok := path[0].(cty.GetAttrStep); ok && root.Name == MetadataInputs {
+			paths = append(paths, path[1:])
+		}
+	}
+
+	// The whole inputs are sensitive.
+	if slices.ContainsFunc(paths, func(path cty.Path) bool { return len(path) == 0 }) {
+		redacted := make(map[string]interface{}, len(cfg.Inputs))
+		for name := range cfg.Inputs {
+			redacted[name] = RedactedValue
+		}
+
+		return redacted, nil
+	}
+
+	if len(paths) == 0 {
+		return cfg.Inputs, nil
+	}
+
+	inputs, err := convertToCtyWithJSON(cfg.Inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseCtyValueToMap(transformPaths(inputs, paths, redact))
+}
+
+// redact returns the value replacing a sensitive value.
+func redact(cty.Value) cty.Value {
+	return cty.StringVal(RedactedValue)
+}
+
+// transformPaths replaces the values at the given paths with the result of the transform function. Since the replaced
+// values can have any type, the collections containing them are converted to objects and tuples.
+func transformPaths(val cty.Value, paths []cty.Path, transform func(cty.Value) cty.Value) cty.Value {
+	if len(paths) == 0 || val.IsNull() || !val.IsKnown() {
+		return val
+	}
+
+	for _, path := range paths {
+		if len(path) == 0 {
+			return transform(val)
+		}
+	}
+
+	// The collections cannot be iterated while marked, so their marks are set back on the transformed collections.
+	val, marks := val.Unmark()
+	ty := val.Type()
+
+	switch {
+	case ty.IsObjectType() || ty.IsMapType():
+		attrs := map[string]cty.Value{}
+
+		for it := val.ElementIterator(); it.Next(); {
+			key, elem := it.Element()
+			attrs[key.AsString()] = transformPaths(elem, childPaths(paths, key), transform)
+		}
+
+		return cty.ObjectVal(attrs).WithMarks(marks)
+	case ty.IsListType() || ty.IsTupleType() || ty.IsSetType():
+		elems := []cty.Value{}
+
+		for it := val.ElementIterator(); it.Next(); {
+			key, elem := it.Element()
+			elems = append(elems, transformPaths(elem, childPaths(paths, key), transform))
+		}
+
+		return cty.TupleVal(elems).WithMarks(marks)
+	}
+
+	return val.WithMarks(marks)
+}
+
+// childPaths returns the rest of the paths going through the element with the given key.
+func childPaths(paths []cty.Path, key cty.Value) []cty.Path {
+	var children []cty.Path
+
+	for _, path := range paths {
+		var matches bool
+
+		switch step := path[0].(type) {
+		case cty.GetAttrStep:
+			matches = key.Type().Equals(cty.String) && key.AsString() == step.Name
+		case cty.IndexStep:
+			matches = step.Key.Type().Equals(key.Type()) && step.Key.Equals(key).True()
+		}
+
+		if matches {
+			children = append(children, path[1:])
+		}
+	}
+
+	return children
+}
@@ -0,0 +1,129 @@
+package config_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/gruntwork-io/terragrunt/config"
+)
+
+func TestParseTerragruntConfigSensitiveEnvVars(t *testing.T) {
+	t.Parallel()
+
+	cfg := `
+locals {
+  token = get_env("GITHUB_TOKEN")
+}
+
+inputs = {
+  token       = local.token
+  region      = get_env("AWS_REGION")
+  db_password = nonsensitive(get_env("DB_PASSWORD"))
+  endpoint    = "https://${get_env("API_TOKEN")}@example.com"
+  plain       = nonsensitive("us-east-1")
+}
+`
+
+	opts := mockOptionsForTest(t)
+	opts.Env = map[string]string{
+		"GITHUB_TOKEN": "ghp_secret",
+		"AWS_REGION":   "us-east-1",
+		"DB_PASSWORD":  "hunter2",
+		"API_TOKEN":    "s3cr3t",
+	}
+
+	ctx := config.NewParsingContext(context.Background(), opts)
+	terragruntConfig, err := config.ParseConfigString(ctx, config.DefaultTerragruntConfigPath, cfg, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, "ghp_secret", terragruntConfig.Inputs["token"])
+	assert.Equal(t, "hunter2", terragruntConfig.Inputs["db_password"])
+
+	assert.ElementsMatch(t, []cty.Path{
+		cty.GetAttrPath("inputs").GetAttr("token"),
+		cty.GetAttrPath("inputs").GetAttr("endpoint"),
+		cty.GetAttrPath("locals").GetAttr("token"),
+	}, terragruntConfig.SensitivePaths)
+
+	inputs, err := terragruntConfig.RedactedInputs()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"token":       config.RedactedValue,
+		"region":      "us-east-1",
+		"db_password": "hunter2",
+		"endpoint":    config.RedactedValue,
+		"plain":       "us-east-1",
+	}, inputs)
+}
+
+func TestReadTerragruntConfigKeepsSensitiveValues(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "common.hcl"), []byte(`
+inputs = {
+  token  = get_env("GITHUB_TOKEN")
+  region = "us-east-1"
+}
+`), 0644))
+
+	configPath := filepath.Join(dir, config.DefaultTerragruntConfigPath)
+	require.NoError(t, os.WriteFile(configPath, []byte(`
+locals {
+  common = read_terragrunt_config("common.hcl")
+}
+
+inputs = {
+  token  = local.common.inputs.token
+  region = local.common.inputs.region
+}
+`), 0644))
+
+	opts := mockOptionsForTestWithConfigPath(t, configPath)
+	opts.Env = map[string]string{"GITHUB_TOKEN": "ghp_secret"}
+
+	ctx := config.NewParsingContext(context.Background(), opts)
+	terragruntConfig, err := config.ParseConfigFile(ctx, configPath, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, "ghp_secret", terragruntConfig.Inputs["token"])
+	assert.True(t, terragruntConfig.IsSensitive(cty.GetAttrPath("inputs").GetAttr("token")))
+	assert.False(t, terragruntConfig.IsSensitive(cty.GetAttrPath("inputs").GetAttr("region")))
+}
+
+func TestTerraformOutputJSONToCtyValueMapSensitiveOutputs(t *testing.T) {
+	t.Parallel()
+
+	outputs, err := config.TerraformOutputJSONToCtyValueMap("dep/terragrunt.hcl", []byte(`{
+  "password": {"sensitive": true, "type": "string", "value": "hunter2"},
+  "vpc_id": {"sensitive": false, "type": "string", "value": "vpc-123"}
+}`))
+	require.NoError(t, err)
+
+	assert.True(t, outputs["password"].HasMark(config.MarkSensitive))
+	assert.False(t, outputs["vpc_id"].IsMarked())
+
+	// The sensitive outputs stay marked in the rendered configuration, and are redacted with the sensitive paths.
+	terragruntConfig := &config.TerragruntConfig{}
+	rendered := terragruntConfig.RedactSensitiveValues(cty.ObjectVal(map[string]cty.Value{
+		"dependency": cty.ObjectVal(map[string]cty.Value{
+			"outputs": cty.ObjectVal(outputs),
+		}),
+	}))
+
+	assert.Equal(t, cty.ObjectVal(map[string]cty.Value{
+		"dependency": cty.ObjectVal(map[string]cty.Value{
+			"outputs": cty.ObjectVal(map[string]cty.Value{
+				"password": cty.StringVal(config.RedactedValue),
+				"vpc_id":   cty.StringVal("vpc-123"),
+			}),
+		}),
+	}), rendered)
+}
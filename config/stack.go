@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/hclsyntax"
 
 	"github.com/gruntwork-io/terragrunt/util"
@@ -20,10 +21,11 @@ import (
 )
 
 const (
-	stackDir       = ".terragrunt-stack"
-	unitValuesFile = "terragrunt.values.hcl"
-	unitDirPerm    = 0755
-	valueFilePerm  = 0644
+	stackDir        = ".terragrunt-stack"
+	stackConfigFile = "terragrunt.stack.hcl"
+	unitValuesFile  = "terragrunt.values.hcl"
+	unitDirPerm     = 0755
+	valueFilePerm   = 0644
 )
 
 // StackConfigFile represents the structure of terragrunt.stack.hcl stack file.
@@ -38,6 +40,9 @@ type Unit struct {
 	Source string     `hcl:"source,attr"`
 	Path   string     `hcl:"path,attr"`
 	Values *cty.Value `hcl:"values,attr"`
+
+	// declRange is the range of the `unit` block in the stack file, where the errors about the unit point at.
+	declRange hcl.Range
 }
 
 // ReadOutputs reads the outputs from the unit.
@@ -61,6 +66,11 @@ func (u *Unit) ReadOutputs(ctx context.Context, opts *options.TerragruntOptions)
 		return nil, errors.New(err)
 	}
 
+	// As `output -json` does, the stack outputs show the values of the sensitive outputs.
+	for name, val := range outputMap {
+		outputMap[name], _ = val.UnmarkDeep()
+	}
+
 	return outputMap, nil
 }
 
@@ -93,6 +103,15 @@ func ReadStackConfigFile(ctx context.Context, opts *options.TerragruntOptions) (
 		return nil, errors.New(err)
 	}
 
+	declRanges, err := unitDeclRanges(file)
+	if err != nil {
+		return nil, errors.New(err)
+	}
+
+	for _, unit := range config.Units {
+		unit.declRange = declRanges[unit.Name]
+	}
+
 	return config, nil
 }
 
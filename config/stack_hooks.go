@@ -0,0 +1,158 @@
+package config
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+)
+
+// StackHooksConfig represents the `stack_hooks` block, with the hooks that run once around a whole `run-all`, rather than for each unit.
+type StackHooksConfig struct {
+	BeforeAll  []*StackHook `cty:"before_all" hcl:"before_all,block"`
+	AfterAll   []*StackHook `cty:"after_all" hcl:"after_all,block"`
+	OnErrorAll []*StackHook `cty:"on_error_all" hcl:"on_error_all,block"`
+}
+
+// StackHook represents a labeled stack hook.
+type StackHook struct {
+	Label      string   `cty:"name" hcl:"name,label"`
+	Commands   []string `cty:"commands" hcl:"commands,optional"`
+	Execute    []string `cty:"execute" hcl:"execute"`
+	WorkingDir *string  `cty:"working_dir" hcl:"working_dir,optional"`
+}
+
+// GetBeforeAll returns the hooks to run before any unit of the `run-all`.
+func (c *StackHooksConfig) GetBeforeAll() []*StackHook {
+	if c == nil {
+		return nil
+	}
+
+	return c.BeforeAll
+}
+
+// GetAfterAll returns the hooks to run once all the units of the `run-all` have finished.
+func (c *StackHooksConfig) GetAfterAll() []*StackHook {
+	if c == nil {
+		return nil
+	}
+
+	return c.AfterAll
+}
+
+// GetOnErrorAll returns the hooks to run once all the units of the `run-all` have finished, if any of them failed.
+func (c *StackHooksConfig) GetOnErrorAll() []*StackHook {
+	if c == nil {
+		return nil
+	}
+
+	return c.OnErrorAll
+}
+
+// Validate checks that all hooks have a command to execute.
+func (c *StackHooksConfig) Validate() error {
+	for _, hooks := range [][]*StackHook{c.GetBeforeAll(), c.GetAfterAll(), c.GetOnErrorAll()} {
+		for _, hook := range hooks {
+			if len(hook.Execute) < 1 || hook.Execute[0] == "" {
+				return InvalidArgError(fmt.Sprintf("Error with stack hook %s. Need at least one non-empty argument in 'execute'.", hook.Label))
+			}
+		}
+	}
+
+	return nil
+}
+
+// resolveWorkingDirs resolves the relative working dirs of the hooks against the directory of the configuration that declares them,
+// since the hooks run from the working dir of the `run-all`, which may be any directory above the units.
+func (c *StackHooksConfig) resolveWorkingDirs(configDir string) {
+	for _, hooks := range [][]*StackHook{c.GetBeforeAll(), c.GetAfterAll(), c.GetOnErrorAll()} {
+		for _, hook := range hooks {
+			if hook.WorkingDir == nil || *hook.WorkingDir == "" || filepath.IsAbs(*hook.WorkingDir) {
+				continue
+			}
+
+			workingDir := filepath.Join(configDir, *hook.WorkingDir)
+			hook.WorkingDir = &workingDir
+		}
+	}
+}
+
+// Clone creates a deep copy of StackHooksConfig
+func (c *StackHooksConfig) Clone() *StackHooksConfig {
+	if c == nil {
+		return nil
+	}
+
+	return &StackHooksConfig{
+		BeforeAll:  cloneStackHooks(c.BeforeAll),
+		AfterAll:   cloneStackHooks(c.AfterAll),
+		OnErrorAll: cloneStackHooks(c.OnErrorAll),
+	}
+}
+
+// Merge combines the current StackHooksConfig with another one, the hooks of the other config replace the hooks with the same name.
+func (c *StackHooksConfig) Merge(other *StackHooksConfig) {
+	if other == nil {
+		return
+	}
+
+	c.BeforeAll = mergeStackHooks(c.BeforeAll, other.BeforeAll)
+	c.AfterAll = mergeStackHooks(c.AfterAll, other.AfterAll)
+	c.OnErrorAll = mergeStackHooks(c.OnErrorAll, other.OnErrorAll)
+}
+
+// RunsFor returns true if the hook runs for the given `run-all` command. A hook without commands runs for all of them.
+func (h *StackHook) RunsFor(command string) bool {
+	return len(h.Commands) == 0 || slices.Contains(h.Commands, command)
+}
+
+// Clone creates a deep copy of StackHook
+func (h *StackHook) Clone() *StackHook {
+	if h == nil {
+		return nil
+	}
+
+	clone := &StackHook{
+		Label:      h.Label,
+		WorkingDir: h.WorkingDir,
+	}
+
+	if h.Commands != nil {
+		clone.Commands = make([]string, len(h.Commands))
+		copy(clone.Commands, h.Commands)
+	}
+
+	if h.Execute != nil {
+		clone.Execute = make([]string, len(h.Execute))
+		copy(clone.Execute, h.Execute)
+	}
+
+	return clone
+}
+
+func cloneStackHooks(hooks []*StackHook) []*StackHook {
+	if hooks == nil {
+		return nil
+	}
+
+	clone := make([]*StackHook, len(hooks))
+	for i, hook := range hooks {
+		clone[i] = hook.Clone()
+	}
+
+	return clone
+}
+
+// mergeStackHooks keeps the order of the hooks, the hooks of `other` with the same name as existing ones replace them, the others are appended.
+func mergeStackHooks(hooks, other []*StackHook) []*StackHook {
+	for _, otherHook := range other {
+		idx := slices.IndexFunc(hooks, func(hook *StackHook) bool { return hook.Label == otherHook.Label })
+		if idx != -1 {
+			hooks[idx] = otherHook
+			continue
+		}
+
+		hooks = append(hooks, otherHook)
+	}
+
+	return hooks
+}
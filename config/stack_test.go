@@ -1,11 +1,16 @@
 package config_test
 
 import (
+	"context"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/gruntwork-io/terragrunt/config"
+	"github.com/gruntwork-io/terragrunt/internal/experiment"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestValidateStackConfig(t *testing.T) {
@@ -168,3 +173,117 @@ func TestValidateStackConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestUnitValuesSchema(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	stackConfigPath := filepath.Join(dir, "terragrunt.stack.hcl")
+	require.NoError(t, os.WriteFile(stackConfigPath, []byte(`unit "app" {
+  source = "units/app"
+  path   = "app"
+  values = {
+    env = "dev"
+  }
+}
+
+unit "invalid" {
+  source = "units/app"
+  path   = "invalid"
+  values = {
+    env   = "staging"
+    count = "many"
+    extra = true
+  }
+}
+`), 0644))
+
+	unitConfig := []byte(`
+values {
+  value "env" {
+    type        = string
+    description = "The environment of the unit."
+
+    validation {
+      condition     = contains(["dev", "prod"], values.env)
+      error_message = "The environment must be either dev or prod."
+    }
+  }
+
+  value "count" {
+    type    = number
+    default = 1
+  }
+}
+
+inputs = {
+  env   = values.env
+  count = values.count
+}
+`)
+
+	opts := mockOptionsForTestWithConfigPath(t, filepath.Join(dir, config.DefaultTerragruntConfigPath))
+	opts.TerragruntStackConfigPath = stackConfigPath
+	require.NoError(t, opts.Experiments.EnableExperiment(experiment.Stacks))
+
+	stackFile, err := config.ReadStackConfigFile(context.Background(), opts)
+	require.NoError(t, err)
+	require.Len(t, stackFile.Units, 2)
+
+	unitDirs := make([]string, 0, len(stackFile.Units))
+
+	for _, unit := range stackFile.Units {
+		unitDir := filepath.Join(dir, ".terragrunt-stack", unit.Path)
+		require.NoError(t, os.MkdirAll(unitDir, 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(unitDir, config.DefaultTerragruntConfigPath), unitConfig, 0644))
+
+		unitDirs = append(unitDirs, unitDir)
+	}
+
+	// The values are checked when the stack is generated, with the errors pointing at the unit block.
+	require.NoError(t, config.CheckUnitValues(opts, stackFile.Units[0], unitDirs[0]))
+
+	err = config.CheckUnitValues(opts, stackFile.Units[1], unitDirs[1])
+	require.Error(t, err)
+
+	var valuesErr config.UnitValuesError
+	require.ErrorAs(t, err, &valuesErr)
+	assert.Equal(t, "invalid", valuesErr.Unit)
+	assert.Equal(t, stackConfigPath, valuesErr.Range.Filename)
+	assert.Equal(t, 9, valuesErr.Range.Start.Line)
+	assert.Len(t, valuesErr.Errs, 2)
+	assert.Contains(t, err.Error(), "values.count: a number is required")
+	assert.Contains(t, err.Error(), "values.extra: the value is not declared")
+
+	// The values are checked again when the unit is parsed, with the defaults of the values that are not set.
+	require.NoError(t, config.WriteUnitValues(opts, stackFile.Units[0], unitDirs[0]))
+
+	configPath := filepath.Join(unitDirs[0], config.DefaultTerragruntConfigPath)
+	ctx := config.NewParsingContext(context.Background(), opts)
+
+	terragruntConfig, err := config.ParseConfigFile(ctx, configPath, nil)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"env": "dev", "count": float64(1)}, terragruntConfig.Inputs)
+
+	require.NoError(t, os.WriteFile(filepath.Join(unitDirs[0], "terragrunt.values.hcl"), []byte(`env = "qa"`), 0644))
+
+	_, err = config.ParseConfigFile(ctx, configPath, nil)
+	require.Error(t, err)
+
+	valuesErr = config.UnitValuesError{}
+	require.ErrorAs(t, err, &valuesErr)
+	assert.Equal(t, "app", valuesErr.Unit)
+	assert.Equal(t, 1, valuesErr.Range.Start.Line)
+	assert.Equal(t, []string{"values.env: The environment must be either dev or prod."}, errorMessages(valuesErr.Errs))
+}
+
+func errorMessages(errs []error) []string {
+	msgs := make([]string, 0, len(errs))
+
+	for _, err := range errs {
+		msgs = append(msgs, err.Error())
+	}
+
+	return msgs
+}
@@ -0,0 +1,472 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/ext/typeexpr"
+	"github.com/hashicorp/hcl/v2/gohcl"
+	tflang "github.com/hashicorp/terraform/lang"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+
+	"github.com/gruntwork-io/terragrunt/config/hclparse"
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/util"
+)
+
+const (
+	unitValueBlock           = "value"
+	unitValueValidationBlock = "validation"
+)
+
+var (
+	unitValuesBlockSchema = &hcl.BodySchema{
+		Blocks: []hcl.BlockHeaderSchema{
+			{Type: unitValueBlock, LabelNames: []string{"name"}},
+		},
+	}
+
+	unitValueBlockSchema = &hcl.BodySchema{
+		Attributes: []hcl.AttributeSchema{
+			{Name: "type"},
+			{Name: "description"},
+			{Name: "default"},
+		},
+		Blocks: []hcl.BlockHeaderSchema{
+			{Type: unitValueValidationBlock},
+		},
+	}
+
+	unitValueValidationBlockSchema = &hcl.BodySchema{
+		Attributes: []hcl.AttributeSchema{
+			{Name: "condition", Required: true},
+			{Name: "error_message"},
+		},
+	}
+)
+
+// terragruntValuesIgnore is used to accept the `values` block when decoding the whole configuration, since it is
+// decoded on its own, before the values are added to the evaluation context.
+type terragruntValuesIgnore struct {
+	Remain hcl.Body `hcl:",remain"`
+}
+
+// UnitValuesSchema is the `values` block of a unit, declaring the values the unit expects from the stack file:
+//
+//	values {
+//	  value "env" {
+//	    type        = string
+//	    description = "The environment the unit is deployed to."
+//	    default     = "dev"
+//
+//	    validation {
+//	      condition     = contains(["dev", "prod"], values.env)
+//	      error_message = "The environment must be either dev or prod."
+//	    }
+//	  }
+//	}
+//
+// The values are checked against the schema both when the stack is generated and when the unit is parsed.
+type UnitValuesSchema struct {
+	Values    []*UnitValue
+	DeclRange hcl.Range
+
+	// unitDir is the directory of the unit, from which the functions of the validations resolve relative paths.
+	unitDir string
+}
+
+// UnitValue is a `value` block of the `values` schema of a unit.
+type UnitValue struct {
+	Name        string
+	Description string
+	// Default is the value used when the stack file does not set one, nil if the value is required.
+	Default *cty.Value
+	// TypeConstraint is the type of the value, `cty.DynamicPseudoType` if it does not define one.
+	TypeConstraint cty.Type
+	// TypeDefaults are the default values of the optional attributes of the type, if any.
+	TypeDefaults *typeexpr.Defaults
+	// Validations are the `validation` blocks of the value.
+	Validations []*VariableValidation
+}
+
+// ReadUnitValuesSchema reads the `values` schema from the Terragrunt configuration of the unit in the given directory.
+// It returns nil if the unit does not declare one.
+func ReadUnitValuesSchema(opts *options.TerragruntOptions, unitDirectory string) (*UnitValuesSchema, error) {
+	configPath := filepath.Join(unitDirectory, DefaultTerragruntConfigPath)
+	if util.FileNotExists(configPath) {
+		return nil, nil
+	}
+
+	file, err := hclparse.NewParser(DefaultParserOptions(opts)...).ParseFromFile(configPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeUnitValuesSchema(file)
+}
+
+// decodeUnitValuesSchema decodes the `values` block of the given file, if any.
+func decodeUnitValuesSchema(file *hclparse.File) (*UnitValuesSchema, error) {
+	blocks, err := file.Blocks(MetadataValues, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(blocks) == 0 {
+		return nil, nil
+	}
+
+	content, diags := blocks[0].Body.Content(unitValuesBlockSchema)
+	if err := file.HandleDiagnostics(diags); err != nil {
+		return nil, errors.New(err)
+	}
+
+	schema := &UnitValuesSchema{
+		DeclRange: blocks[0].DefRange,
+		unitDir:   filepath.Dir(file.ConfigPath),
+	}
+
+	for _, block := range content.Blocks {
+		value, err := decodeUnitValue(file, block)
+		if err != nil {
+			return nil, err
+		}
+
+		schema.Values = append(schema.Values, value)
+	}
+
+	return schema, nil
+}
+
+func decodeUnitValue(file *hclparse.File, block *hcl.Block) (*UnitValue, error) {
+	content, diags := block.Body.Content(unitValueBlockSchema)
+	if err := file.HandleDiagnostics(diags); err != nil {
+		return nil, errors.New(err)
+	}
+
+	value := &UnitValue{
+		Name:           block.Labels[0],
+		TypeConstraint: cty.DynamicPseudoType,
+	}
+
+	if attr, ok := content.Attributes["type"]; ok {
+		value.TypeConstraint, value.TypeDefaults, diags = typeexpr.TypeConstraintWithDefaults(attr.Expr)
+		if err := file.HandleDiagnostics(diags); err != nil {
+			return nil, errors.New(err)
+		}
+	}
+
+	if attr, ok := content.Attributes["description"]; ok {
+		if err := file.HandleDiagnostics(gohcl.DecodeExpression(attr.Expr, nil, &value.Description)); err != nil {
+			return nil, errors.New(err)
+		}
+	}
+
+	if attr, ok := content.Attributes["default"]; ok {
+		defaultValue, diags := attr.Expr.Value(nil)
+		if err := file.HandleDiagnostics(diags); err != nil {
+			return nil, errors.New(err)
+		}
+
+		if !defaultValue.IsNull() {
+			if mismatches := value.typeMismatches(defaultValue); len(mismatches) > 0 {
+				return nil, errors.New(UnitValueTypeError{Name: value.Name, Path: mismatches[0].Path, Message: "invalid default: " + mismatches[0].Message})
+			}
+		}
+
+		value.Default = &defaultValue
+	}
+
+	for _, nested := range content.Blocks {
+		validationContent, diags := nested.Body.Content(unitValueValidationBlockSchema)
+		if err := file.HandleDiagnostics(diags); err != nil {
+			return nil, errors.New(err)
+		}
+
+		validation := &VariableValidation{Condition: validationContent.Attributes["condition"].Expr}
+
+		if attr, ok := validationContent.Attributes["error_message"]; ok {
+			validation.ErrorMessage = attr.Expr
+		}
+
+		value.Validations = append(value.Validations, validation)
+	}
+
+	return value, nil
+}
+
+// Check checks the values set by the stack file against the schema: each value must be declared, match its type and
+// pass its validations, and the values without a default must be set. It returns the values converted to their
+// types, with the defaults of the values that are not set, along with an error for each value that does not match.
+func (schema *UnitValuesSchema) Check(values *cty.Value) (*cty.Value, []error, error) {
+	setValues := map[string]cty.Value{}
+	if values != nil && !values.IsNull() {
+		setValues = values.AsValueMap()
+	}
+
+	var (
+		valueErrs []error
+		checked   = make(map[string]cty.Value, len(schema.Values))
+		declared  = make(map[string]bool, len(schema.Values))
+	)
+
+	for _, value := range schema.Values {
+		declared[value.Name] = true
+
+		val, ok := setValues[value.Name]
+		if !ok {
+			if value.Default == nil {
+				valueErrs = append(valueErrs, errors.New(UnitValueRequiredError{Name: value.Name}))
+				continue
+			}
+
+			val = *value.Default
+		}
+
+		if value.TypeDefaults != nil {
+			val = value.TypeDefaults.Apply(val)
+		}
+
+		if mismatches := value.typeMismatches(val); len(mismatches) > 0 {
+			for _, mismatch := range mismatches {
+				valueErrs = append(valueErrs, errors.New(UnitValueTypeError{Name: value.Name, Path: mismatch.Path, Message: mismatch.Message}))
+			}
+
+			continue
+		}
+
+		val, err := convert.Convert(val, value.TypeConstraint)
+		if err != nil {
+			valueErrs = append(valueErrs, errors.New(UnitValueTypeError{Name: value.Name, Message: err.Error()}))
+			continue
+		}
+
+		checked[value.Name] = val
+	}
+
+	for _, name := range sortedKeys(setValues) {
+		if !declared[name] {
+			valueErrs = append(valueErrs, errors.New(UnitValueNotDeclaredError{Name: name}))
+		}
+	}
+
+	if len(valueErrs) > 0 {
+		return nil, valueErrs, nil
+	}
+
+	result := cty.ObjectVal(checked)
+
+	scope := &tflang.Scope{BaseDir: schema.unitDir}
+
+	evalCtx := &hcl.EvalContext{
+		Variables: map[string]cty.Value{MetadataValues: result},
+		Functions: scope.Functions(),
+	}
+
+	for _, value := range schema.Values {
+		for _, validation := range value.Validations {
+			ok, message, err := validation.checkUnitValue(evalCtx, value.Name)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			if !ok {
+				valueErrs = append(valueErrs, errors.New(UnitValueValidationError{Name: value.Name, Message: message}))
+			}
+		}
+	}
+
+	if len(valueErrs) > 0 {
+		return nil, valueErrs, nil
+	}
+
+	return &result, nil, nil
+}
+
+func (value *UnitValue) typeMismatches(val cty.Value) []typeMismatch {
+	return typeMismatches(cty.Path{}, val, value.TypeConstraint)
+}
+
+// checkUnitValue evaluates the condition of the validation of a unit value. If the condition does not hold, it returns
+// false with the error message of the validation.
+func (validation *VariableValidation) checkUnitValue(evalCtx *hcl.EvalContext, name string) (bool, string, error) {
+	result, diags := validation.Condition.Value(evalCtx)
+	if diags.HasErrors() {
+		return false, "", errors.New(diags)
+	}
+
+	if !result.IsWhollyKnown() || result.IsNull() || !result.Type().Equals(cty.Bool) {
+		return false, "", errors.New(UnitValueInvalidConditionError{Name: name, Range: validation.Condition.Range()})
+	}
+
+	if result.True() {
+		return true, "", nil
+	}
+
+	message := "the value does not match the validation of the unit"
+
+	if validation.ErrorMessage != nil {
+		val, diags := validation.ErrorMessage.Value(evalCtx)
+		if diags.HasErrors() {
+			return false, "", errors.New(diags)
+		}
+
+		if val.IsWhollyKnown() && !val.IsNull() && val.Type().Equals(cty.String) {
+			message = val.AsString()
+		}
+	}
+
+	return false, message, nil
+}
+
+// CheckUnitValues checks the values the unit of the stack file sets against the `values` schema of the unit generated
+// in the given directory, if it declares one. The errors point at the `unit` block of the stack file.
+func CheckUnitValues(opts *options.TerragruntOptions, unit *Unit, unitDirectory string) error {
+	schema, err := ReadUnitValuesSchema(opts, unitDirectory)
+	if err != nil {
+		return err
+	}
+
+	if schema == nil {
+		return nil
+	}
+
+	opts.Logger.Debugf("Checking values of unit %s against its values schema", unit.Name)
+
+	_, valueErrs, err := schema.Check(unit.Values)
+	if err != nil {
+		return err
+	}
+
+	if len(valueErrs) > 0 {
+		return errors.New(UnitValuesError{Unit: unit.Name, Range: unit.declRange, Errs: valueErrs})
+	}
+
+	return nil
+}
+
+// readCheckedUnitValues reads the values of the unit the file belongs to, then checks them against the `values`
+// schema of the file, if it declares one. When the unit was generated by a stack file, the errors point at its
+// `unit` block, otherwise at the `values` block of the file.
+func readCheckedUnitValues(ctx *ParsingContext, file *hclparse.File) (*cty.Value, error) {
+	unitDirectory := filepath.Dir(file.ConfigPath)
+
+	values, err := ReadUnitValues(ctx.Context, ctx.TerragruntOptions, unitDirectory)
+	if err != nil {
+		return nil, err
+	}
+
+	schema, err := decodeUnitValuesSchema(file)
+	if err != nil {
+		return nil, err
+	}
+
+	if schema == nil {
+		return values, nil
+	}
+
+	checked, valueErrs, err := schema.Check(values)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(valueErrs) > 0 {
+		valuesErr := UnitValuesError{Range: schema.DeclRange, Errs: valueErrs}
+
+		if unit, rng := findStackUnit(ctx.TerragruntOptions, unitDirectory); unit != "" {
+			valuesErr.Unit, valuesErr.Range = unit, rng
+		}
+
+		return nil, errors.New(valuesErr)
+	}
+
+	return checked, nil
+}
+
+// findStackUnit returns the name of the unit of the stack file that generated the given unit directory, and the range
+// of its `unit` block. It returns an empty name if the directory was not generated by a stack file, or if the unit
+// cannot be found.
+func findStackUnit(opts *options.TerragruntOptions, unitDirectory string) (string, hcl.Range) {
+	for dir := unitDirectory; filepath.Dir(dir) != dir; dir = filepath.Dir(dir) {
+		generatedDir := filepath.Dir(dir)
+		if filepath.Base(generatedDir) != stackDir {
+			continue
+		}
+
+		stackConfigPath := filepath.Join(filepath.Dir(generatedDir), stackConfigFile)
+		if _, err := os.Stat(stackConfigPath); err != nil {
+			return "", hcl.Range{}
+		}
+
+		unitPath, err := filepath.Rel(generatedDir, unitDirectory)
+		if err != nil {
+			return "", hcl.Range{}
+		}
+
+		file, err := hclparse.NewParser(DefaultParserOptions(opts)...).ParseFromFile(stackConfigPath)
+		if err != nil {
+			return "", hcl.Range{}
+		}
+
+		blocks, err := stackUnitBlocks(file)
+		if err != nil {
+			return "", hcl.Range{}
+		}
+
+		for _, block := range blocks {
+			attrs, diags := block.Body.JustAttributes()
+			if diags.HasErrors() || attrs["path"] == nil {
+				continue
+			}
+
+			// The path of the unit is usually a literal, the units whose path cannot be evaluated on its own are skipped.
+			path, diags := attrs["path"].Expr.Value(nil)
+			if diags.HasErrors() || path.IsNull() || !path.Type().Equals(cty.String) {
+				continue
+			}
+
+			if filepath.Clean(path.AsString()) == unitPath {
+				return block.Labels[0], block.DefRange
+			}
+		}
+
+		return "", hcl.Range{}
+	}
+
+	return "", hcl.Range{}
+}
+
+// unitDeclRanges returns the ranges of the `unit` blocks of the stack file, by unit name.
+func unitDeclRanges(file *hclparse.File) (map[string]hcl.Range, error) {
+	blocks, err := stackUnitBlocks(file)
+	if err != nil {
+		return nil, err
+	}
+
+	ranges := make(map[string]hcl.Range, len(blocks))
+
+	for _, block := range blocks {
+		if _, ok := ranges[block.Labels[0]]; !ok {
+			ranges[block.Labels[0]] = block.DefRange
+		}
+	}
+
+	return ranges, nil
+}
+
+// stackUnitBlocks returns the `unit` blocks of the stack file.
+func stackUnitBlocks(file *hclparse.File) ([]*hcl.Block, error) {
+	content, _, diags := file.Body.PartialContent(&hcl.BodySchema{
+		Blocks: []hcl.BlockHeaderSchema{
+			{Type: MetadataUnit, LabelNames: []string{"name"}},
+		},
+	})
+	if err := file.HandleDiagnostics(diags); err != nil {
+		return nil, errors.New(err)
+	}
+
+	return content.Blocks, nil
+}
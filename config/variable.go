@@ -3,6 +3,8 @@ package config
 import (
 	"encoding/json"
 	"fmt"
+	"maps"
+	"slices"
 
 	"github.com/gruntwork-io/terragrunt/config/hclparse"
 	"github.com/gruntwork-io/terragrunt/internal/errors"
@@ -10,8 +12,11 @@ import (
 	"github.com/gruntwork-io/terragrunt/options"
 	"github.com/gruntwork-io/terragrunt/util"
 	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/ext/typeexpr"
 	"github.com/hashicorp/hcl/v2/hclsyntax"
+	tflang "github.com/hashicorp/terraform/lang"
 	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
 	ctyjson "github.com/zclconf/go-cty/cty/json"
 )
 
@@ -22,6 +27,23 @@ type ParsedVariable struct {
 	Type                    string
 	DefaultValue            string
 	DefaultValuePlaceholder string
+
+	// TypeConstraint is the type of the variable, `cty.DynamicPseudoType` if it does not define one.
+	TypeConstraint cty.Type
+	// TypeDefaults are the default values of the optional attributes of the type, if any.
+	TypeDefaults *typeexpr.Defaults
+	// Validations are the `validation` blocks of the variable.
+	Validations []*VariableValidation
+
+	// moduleDir is the directory of the module declaring the variable, from which the functions of the validations
+	// resolve relative paths.
+	moduleDir string
+}
+
+// VariableValidation is a `validation` block of a variable.
+type VariableValidation struct {
+	Condition    hcl.Expression
+	ErrorMessage hcl.Expression
 }
 
 // ParseVariables - parse variables from tf files.
@@ -117,6 +139,19 @@ func ParseVariables(opts *options.TerragruntOptions, directoryPath string) ([]*P
 							Description:             descriptionAttrText,
 							DefaultValue:            defaultValueText,
 							DefaultValuePlaceholder: generateDefaultValue(typeAttrText),
+							TypeConstraint:          cty.DynamicPseudoType,
+							Validations:             readVariableValidations(block),
+							moduleDir:               directoryPath,
+						}
+
+						if attr, ok := block.Body.Attributes["type"]; ok {
+							typeConstraint, typeDefaults, diags := typeexpr.TypeConstraintWithDefaults(attr.Expr)
+							if diags.HasErrors() {
+								opts.Logger.Warnf("Failed to read type constraint for %s %v", name, diags)
+							} else {
+								input.TypeConstraint = typeConstraint
+								input.TypeDefaults = typeDefaults
+							}
 						}
 
 						parsedInputs = append(parsedInputs, input)
@@ -129,6 +164,194 @@ func ParseVariables(opts *options.TerragruntOptions, directoryPath string) ([]*P
 	return parsedInputs, nil
 }
 
+// readVariableValidations - read the validation blocks of a variable.
+func readVariableValidations(block *hclsyntax.Block) []*VariableValidation {
+	var validations []*VariableValidation
+
+	for _, nested := range block.Body.Blocks {
+		if nested.Type != "validation" {
+			continue
+		}
+
+		condition, ok := nested.Body.Attributes["condition"]
+		if !ok {
+			continue
+		}
+
+		validation := &VariableValidation{Condition: condition.Expr}
+
+		if errorMessage, ok := nested.Body.Attributes["error_message"]; ok {
+			validation.ErrorMessage = errorMessage.Expr
+		}
+
+		validations = append(validations, validation)
+	}
+
+	return validations
+}
+
+// CheckInputs checks the inputs against the type constraints and the validations of the variables they are passed to,
+// the way OpenTofu/Terraform does. It returns an error for each input that does not match, in the order of the variables.
+// Inputs that are not passed to any variable are not checked.
+func CheckInputs(inputs map[string]interface{}, variables []*ParsedVariable) ([]error, error) {
+	var inputErrs []error
+
+	for _, variable := range variables {
+		input, ok := inputs[variable.Name]
+		if !ok {
+			continue
+		}
+
+		value, err := convertToCtyWithJSON(input)
+		if err != nil {
+			return nil, err
+		}
+
+		inputErrs = append(inputErrs, variable.checkValue(value)...)
+	}
+
+	return inputErrs, nil
+}
+
+// checkValue checks the value against the type constraint of the variable, then against its validations. Validations
+// that cannot be evaluated, such as those referencing other variables, are skipped.
+func (variable *ParsedVariable) checkValue(value cty.Value) []error {
+	if value.IsNull() {
+		return nil
+	}
+
+	if variable.TypeDefaults != nil {
+		value = variable.TypeDefaults.Apply(value)
+	}
+
+	if mismatches := typeMismatches(cty.Path{}, value, variable.TypeConstraint); len(mismatches) > 0 {
+		typeErrs := make([]error, 0, len(mismatches))
+
+		for _, mismatch := range mismatches {
+			typeErrs = append(typeErrs, errors.New(InputTypeError{Name: variable.Name, Path: mismatch.Path, Message: mismatch.Message}))
+		}
+
+		return typeErrs
+	}
+
+	value, err := convert.Convert(value, variable.TypeConstraint)
+	if err != nil {
+		return []error{errors.New(InputTypeError{Name: variable.Name, Message: err.Error()})}
+	}
+
+	scope := &tflang.Scope{BaseDir: variable.moduleDir}
+
+	evalCtx := &hcl.EvalContext{
+		Variables: map[string]cty.Value{
+			"var": cty.ObjectVal(map[string]cty.Value{variable.Name: value}),
+		},
+		Functions: scope.Functions(),
+	}
+
+	var validationErrs []error
+
+	for _, validation := range variable.Validations {
+		result, diags := validation.Condition.Value(evalCtx)
+		if diags.HasErrors() || !result.IsWhollyKnown() || result.IsNull() || !result.Type().Equals(cty.Bool) {
+			continue
+		}
+
+		if result.True() {
+			continue
+		}
+
+		message := "the value does not match the validation of the variable"
+
+		if validation.ErrorMessage != nil {
+			if val, diags := validation.ErrorMessage.Value(evalCtx); !diags.HasErrors() && val.IsWhollyKnown() && !val.IsNull() && val.Type().Equals(cty.String) {
+				message = val.AsString()
+			}
+		}
+
+		validationErrs = append(validationErrs, errors.New(InputValidationError{Name: variable.Name, Message: message}))
+	}
+
+	return validationErrs
+}
+
+// typeMismatch is a part of a value that does not match a type constraint.
+type typeMismatch struct {
+	Path    cty.Path
+	Message string
+}
+
+// typeMismatches returns each part of the value that does not match the type, with its path. Unlike
+// `convert.Convert`, which only describes the first mismatch, all of them are reported.
+func typeMismatches(path cty.Path, value cty.Value, ty cty.Type) []typeMismatch {
+	if value.IsNull() || !value.IsKnown() {
+		return nil
+	}
+
+	var (
+		errs  []typeMismatch
+		valTy = value.Type()
+	)
+
+	switch {
+	case ty.IsObjectType() && (valTy.IsObjectType() || valTy.IsMapType()):
+		for _, name := range slices.Sorted(maps.Keys(ty.AttributeTypes())) {
+			attr, ok := attributeValue(value, name)
+			if !ok {
+				if !ty.AttributeOptional(name) {
+					errs = append(errs, typeMismatch{Path: path, Message: fmt.Sprintf("attribute %q is required", name)})
+				}
+
+				continue
+			}
+
+			errs = append(errs, typeMismatches(path.GetAttr(name), attr, ty.AttributeType(name))...)
+		}
+
+		return errs
+	case ty.IsMapType() && (valTy.IsObjectType() || valTy.IsMapType()):
+		for key, elem := range value.AsValueMap() {
+			errs = append(errs, typeMismatches(path.IndexString(key), elem, ty.ElementType())...)
+		}
+
+		return errs
+	case (ty.IsListType() || ty.IsSetType()) && (valTy.IsTupleType() || valTy.IsListType() || valTy.IsSetType()):
+		for i, elem := range value.AsValueSlice() {
+			errs = append(errs, typeMismatches(path.IndexInt(i), elem, ty.ElementType())...)
+		}
+
+		return errs
+	case ty.IsTupleType() && (valTy.IsTupleType() || valTy.IsListType()) && value.LengthInt() == len(ty.TupleElementTypes()):
+		for i, elem := range value.AsValueSlice() {
+			errs = append(errs, typeMismatches(path.IndexInt(i), elem, ty.TupleElementType(i))...)
+		}
+
+		return errs
+	}
+
+	if _, err := convert.Convert(value, ty); err != nil {
+		return []typeMismatch{{Path: path, Message: err.Error()}}
+	}
+
+	return nil
+}
+
+// attributeValue returns the attribute of an object, or the element of a map, with the given name.
+func attributeValue(value cty.Value, name string) (cty.Value, bool) {
+	if value.Type().IsObjectType() {
+		if !value.Type().HasAttribute(name) {
+			return cty.NilVal, false
+		}
+
+		return value.GetAttr(name), true
+	}
+
+	if !value.HasIndex(cty.StringVal(name)).True() {
+		return cty.NilVal, false
+	}
+
+	return value.Index(cty.StringVal(name)), true
+}
+
 // generateDefaultValue - generate hcl default value
 // HCL type of variable https://developer.hashicorp.com/packer/docs/templates/hcl_templates/variables#type-constraints
 func generateDefaultValue(variableType string) string {
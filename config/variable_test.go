@@ -81,3 +81,77 @@ func TestScanDefaultVariables(t *testing.T) {
 	assert.Equal(t, "\"default-vpc\"", varByName["vpc"].DefaultValue)
 	assert.Equal(t, "VPC to be used", varByName["vpc"].Description)
 }
+
+func TestCheckInputs(t *testing.T) {
+	t.Parallel()
+
+	opts := terragruntOptionsForTest(t, "")
+
+	variables, err := config.ParseVariables(opts, "../test/fixtures/validate-inputs/fail-input-types")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		inputs   map[string]interface{}
+		expected []string
+	}{
+		{
+			name: "valid inputs",
+			inputs: map[string]interface{}{
+				"name": "app",
+				"node_groups": []interface{}{
+					map[string]interface{}{"name": "a", "instance_type": "t3.small"},
+					map[string]interface{}{"name": "b", "instance_type": "t3.small", "min_size": 2},
+				},
+				"tags":    map[string]interface{}{"env": "dev", "count": 1},
+				"unknown": true,
+			},
+		},
+		{
+			name: "invalid types",
+			inputs: map[string]interface{}{
+				"node_groups": []interface{}{
+					map[string]interface{}{"name": "a", "instance_type": "t3.small"},
+					map[string]interface{}{"name": "b", "instance_type": "t3.small", "min_size": "two"},
+					map[string]interface{}{"name": "c", "instance_type": []interface{}{"t3.small"}},
+				},
+				"tags": map[string]interface{}{"team": map[string]interface{}{"name": "platform"}},
+			},
+			expected: []string{
+				`inputs.node_groups[1].min_size: a number is required`,
+				`inputs.node_groups[2].instance_type: string required`,
+				`inputs.tags["team"]: string required`,
+			},
+		},
+		{
+			name: "missing attribute",
+			inputs: map[string]interface{}{
+				"node_groups": []interface{}{
+					map[string]interface{}{"name": "a"},
+				},
+			},
+			expected: []string{`inputs.node_groups[0]: attribute "instance_type" is required`},
+		},
+		{
+			name:     "failed validation",
+			inputs:   map[string]interface{}{"name": "ab"},
+			expected: []string{"inputs.name: The name must be longer than 2 characters."},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			inputErrs, err := config.CheckInputs(tc.inputs, variables)
+			require.NoError(t, err)
+
+			messages := make([]string, 0, len(inputErrs))
+			for _, inputErr := range inputErrs {
+				messages = append(messages, inputErr.Error())
+			}
+
+			assert.ElementsMatch(t, tc.expected, messages)
+		})
+	}
+}
@@ -8,8 +8,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"maps"
 	"os"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -209,14 +211,61 @@ func (stack *Stack) Run(ctx context.Context, terragruntOptions *options.Terragru
 		defer stack.summarizePlanAllErrors(terragruntOptions, errorStreams)
 	}
 
+	defer stack.summarizeIgnoredErrors(terragruntOptions)
+	defer stack.summarizeHookOutputs(terragruntOptions)
+
+	stackHooks, err := stack.stackHooks()
+	if err != nil {
+		return err
+	}
+
+	if err := runStackHooks(ctx, terragruntOptions, config.MetadataBeforeAll, stackHooks.GetBeforeAll(), nil); err != nil {
+		// No unit is run, the `on_error_all` hooks receive all of them as skipped. The `after_all` hooks are not run, since
+		// they usually undo the work of the `before_all` hooks, such as releasing a lock.
+		onErrorErr := runStackHooks(ctx, terragruntOptions, config.MetadataOnErrorAll, stackHooks.GetOnErrorAll(), stack.Modules.skippedUnitResults())
+		if onErrorErr == nil {
+			return err
+		}
+
+		return (&errors.MultiError{}).Append(onErrorErr, err).ErrorOrNil()
+	}
+
+	dependencyOrder := NormalOrder
+
 	switch {
 	case terragruntOptions.IgnoreDependencyOrder:
-		return stack.Modules.RunModulesIgnoreOrder(ctx, terragruntOptions, terragruntOptions.Parallelism)
+		dependencyOrder = IgnoreOrder
 	case stackCmd == tf.CommandNameDestroy:
-		return stack.Modules.RunModulesReverseOrder(ctx, terragruntOptions, terragruntOptions.Parallelism)
-	default:
-		return stack.Modules.RunModules(ctx, terragruntOptions, terragruntOptions.Parallelism)
+		dependencyOrder = ReverseOrder
+	}
+
+	runningModules, err := stack.Modules.ToRunningModules(dependencyOrder)
+	if err != nil {
+		return err
+	}
+
+	runErr := runningModules.runModules(ctx, terragruntOptions, terragruntOptions.Parallelism)
+
+	if len(stackHooks.GetAfterAll()) == 0 && len(stackHooks.GetOnErrorAll()) == 0 {
+		return runErr
+	}
+
+	var (
+		results  = runningModules.unitResults()
+		hookErrs *errors.MultiError
+	)
+
+	if runErr != nil {
+		hookErrs = hookErrs.Append(runStackHooks(ctx, terragruntOptions, config.MetadataOnErrorAll, stackHooks.GetOnErrorAll(), results))
+	}
+
+	hookErrs = hookErrs.Append(runStackHooks(ctx, terragruntOptions, config.MetadataAfterAll, stackHooks.GetAfterAll(), results))
+
+	if hookErrs.ErrorOrNil() == nil {
+		return runErr
 	}
+
+	return hookErrs.Append(runErr).ErrorOrNil()
 }
 
 // We inspect the error streams to give an explicit message if the plan failed because there were references to
@@ -246,6 +295,75 @@ func (stack *Stack) summarizePlanAllErrors(terragruntOptions *options.Terragrunt
 	}
 }
 
+// summarizeIgnoredErrors logs the signals of the errors ignored by the units during the run, so that they are not lost in the output of
+// the individual units.
+func (stack *Stack) summarizeIgnoredErrors(terragruntOptions *options.TerragruntOptions) {
+	var summary []string
+
+	for _, module := range stack.Modules {
+		if module.FlagExcluded {
+			continue
+		}
+
+		signals, err := options.ReadErrorSignals(module.Path)
+		if err != nil {
+			terragruntOptions.Logger.Warnf("Unable to read error signals of %s: %v", module.Path, err)
+			continue
+		}
+
+		if len(signals) == 0 {
+			continue
+		}
+
+		signalsJSON, err := json.Marshal(signals)
+		if err != nil {
+			continue
+		}
+
+		unitPath := module.Path
+		if relPath, err := util.GetPathRelativeTo(module.Path, terragruntOptions.WorkingDir); err == nil {
+			unitPath = relPath
+		}
+
+		summary = append(summary, fmt.Sprintf("%s: %s", unitPath, signalsJSON))
+	}
+
+	if len(summary) == 0 {
+		return
+	}
+
+	terragruntOptions.Logger.Warnf("Errors were ignored in %d unit(s), with the following signals:\n  %s", len(summary), strings.Join(summary, "\n  "))
+}
+
+// summarizeHookOutputs logs the JSON values the hooks of the units wrote to their `output_file`.
+func (stack *Stack) summarizeHookOutputs(terragruntOptions *options.TerragruntOptions) {
+	var summary []string
+
+	for _, module := range stack.Modules {
+		outputs := terragruntOptions.UnitHookOutputs(module.Path)
+
+		for _, hookName := range slices.Sorted(maps.Keys(outputs)) {
+			outputJSON, err := json.Marshal(outputs[hookName])
+			if err != nil {
+				continue
+			}
+
+			unitPath := module.Path
+			if relPath, err := util.GetPathRelativeTo(module.Path, terragruntOptions.WorkingDir); err == nil {
+				unitPath = relPath
+			}
+
+			summary = append(summary, fmt.Sprintf("%s (%s): %s", unitPath, hookName, outputJSON))
+		}
+	}
+
+	if len(summary) == 0 {
+		return
+	}
+
+	terragruntOptions.Logger.Infof("Hook outputs:\n  %s", strings.Join(summary, "\n  "))
+}
+
 // Sync the TerraformCliArgs for each module in the stack to match the provided terragruntOptions struct.
 func (stack *Stack) syncTerraformCliArgs(terragruntOptions *options.TerragruntOptions) {
 	for _, module := range stack.Modules {
@@ -594,6 +712,7 @@ func (stack *Stack) resolveTerraformModule(ctx context.Context, terragruntConfig
 			config.DependencyBlock,
 			config.FeatureFlagsBlock,
 			config.ErrorsBlock,
+			config.StackHooksBlock,
 		)
 
 	// Credentials have to be acquired before the config is parsed, as the config may contain interpolation functions
@@ -0,0 +1,149 @@
+package configstack
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sort"
+
+	"github.com/gruntwork-io/terragrunt/config"
+	"github.com/gruntwork-io/terragrunt/internal/cloner"
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/shell"
+)
+
+const (
+	StackHookCtxCommandEnvName     = "TG_CTX_COMMAND"
+	StackHookCtxHookNameEnvName    = "TG_CTX_HOOK_NAME"
+	StackHookCtxResultsFileEnvName = "TG_CTX_RESULTS_FILE"
+
+	UnitStatusSucceeded = "succeeded"
+	UnitStatusFailed    = "failed"
+	UnitStatusSkipped   = "skipped"
+)
+
+// UnitResult is the result of running a unit, passed to the `after_all` and `on_error_all` stack hooks.
+type UnitResult struct {
+	Path   string `json:"path"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// stackHooks returns the stack hooks of the units, usually declared in a root configuration included by all of them.
+// Hooks with the same name are only run once.
+func (stack *Stack) stackHooks() (*config.StackHooksConfig, error) {
+	var hooks *config.StackHooksConfig
+
+	for _, module := range stack.Modules {
+		if module.FlagExcluded || module.Config.StackHooks == nil {
+			continue
+		}
+
+		if hooks == nil {
+			hooks = &config.StackHooksConfig{}
+		}
+
+		hooks.Merge(module.Config.StackHooks.Clone())
+	}
+
+	if err := hooks.Validate(); err != nil {
+		return nil, err
+	}
+
+	return hooks, nil
+}
+
+// runStackHooks runs the given hooks in order, stopping at the first failure. The results of the units, if any, are written to a JSON file
+// whose path is passed to the hooks in the `TG_CTX_RESULTS_FILE` env var.
+func runStackHooks(ctx context.Context, terragruntOptions *options.TerragruntOptions, hookType string, hooks []*config.StackHook, results []UnitResult) error {
+	var resultsFile string
+
+	for _, hook := range hooks {
+		if !hook.RunsFor(terragruntOptions.TerraformCommand) {
+			continue
+		}
+
+		if results != nil && resultsFile == "" {
+			var err error
+			if resultsFile, err = writeUnitResults(results); err != nil {
+				return err
+			}
+
+			defer os.Remove(resultsFile) //nolint:errcheck
+		}
+
+		terragruntOptions.Logger.Infof("Executing %s hook: %s", hookType, hook.Label)
+
+		hookOptions := *terragruntOptions
+		hookOptions.Env = cloner.Clone(terragruntOptions.Env)
+		hookOptions.Env[StackHookCtxCommandEnvName] = terragruntOptions.TerraformCommand
+		hookOptions.Env[StackHookCtxHookNameEnvName] = hook.Label
+
+		if resultsFile != "" {
+			hookOptions.Env[StackHookCtxResultsFileEnvName] = resultsFile
+		}
+
+		workingDir := ""
+		if hook.WorkingDir != nil {
+			workingDir = *hook.WorkingDir
+		}
+
+		if _, err := shell.RunCommandWithOutput(ctx, &hookOptions, workingDir, false, false, hook.Execute[0], hook.Execute[1:]...); err != nil {
+			terragruntOptions.Logger.Errorf("Error running %s hook %s with message: %s", hookType, hook.Label, err.Error())
+			return err
+		}
+	}
+
+	return nil
+}
+
+func writeUnitResults(results []UnitResult) (string, error) {
+	file, err := os.CreateTemp("", "terragrunt-results-*.json")
+	if err != nil {
+		return "", errors.New(err)
+	}
+	defer file.Close() //nolint:errcheck
+
+	if err := json.NewEncoder(file).Encode(results); err != nil {
+		return "", errors.New(err)
+	}
+
+	return file.Name(), nil
+}
+
+// unitResults returns the results of the units that were run, sorted by path.
+func (modules RunningModules) unitResults() []UnitResult {
+	results := make([]UnitResult, 0, len(modules))
+
+	for _, module := range modules {
+		result := UnitResult{Path: module.Module.Path, Status: UnitStatusSucceeded}
+
+		switch {
+		case module.Err != nil:
+			result.Status = UnitStatusFailed
+			result.Error = module.Err.Error()
+		case module.Module.AssumeAlreadyApplied:
+			result.Status = UnitStatusSkipped
+		}
+
+		results = append(results, result)
+	}
+
+	sort.Slice(results, func(i, j int) bool { return results[i].Path < results[j].Path })
+
+	return results
+}
+
+// skippedUnitResults returns the results of the units when none of them was run, sorted by path.
+func (modules TerraformModules) skippedUnitResults() []UnitResult {
+	results := make([]UnitResult, 0, len(modules))
+
+	for _, module := range modules {
+		results = append(results, UnitResult{Path: module.Path, Status: UnitStatusSkipped})
+	}
+
+	sort.Slice(results, func(i, j int) bool { return results[i].Path < results[j].Path })
+
+	return results
+}
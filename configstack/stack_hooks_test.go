@@ -0,0 +1,61 @@
+//go:build linux || darwin
+// +build linux darwin
+
+package configstack_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gruntwork-io/terragrunt/config"
+	"github.com/gruntwork-io/terragrunt/configstack"
+)
+
+func TestStackHooksOnErrorAllWhenBeforeAllFails(t *testing.T) {
+	t.Parallel()
+
+	tmpDir := t.TempDir()
+	resultsFile := filepath.Join(tmpDir, "results.json")
+	afterAllFile := filepath.Join(tmpDir, "after-all")
+
+	stackHooks := &config.StackHooksConfig{
+		BeforeAll:  []*config.StackHook{{Label: "lock", Execute: []string{"false"}}},
+		OnErrorAll: []*config.StackHook{{Label: "page", Execute: []string{"sh", "-c", `cp "$TG_CTX_RESULTS_FILE" ` + resultsFile}}},
+		AfterAll:   []*config.StackHook{{Label: "unlock", Execute: []string{"touch", afterAllFile}}},
+	}
+
+	aRan := false
+	unitA := &configstack.TerraformModule{
+		Path:              filepath.Join(tmpDir, "a"),
+		Config:            config.TerragruntConfig{StackHooks: stackHooks},
+		TerragruntOptions: optionsWithMockTerragruntCommand(t, filepath.Join(tmpDir, "a", config.DefaultTerragruntConfigPath), nil, &aRan),
+	}
+
+	opts := optionsWithMockTerragruntCommand(t, filepath.Join(tmpDir, config.DefaultTerragruntConfigPath), nil, new(bool))
+	opts.WorkingDir = tmpDir
+	opts.TerraformCommand = "output"
+	opts.TerraformCliArgs = []string{"output"}
+
+	stack := configstack.NewStack(opts)
+	stack.Modules = configstack.TerraformModules{unitA}
+
+	err := stack.Run(context.Background(), opts)
+	require.Error(t, err)
+	assert.False(t, aRan)
+
+	resultsJSON, err := os.ReadFile(resultsFile)
+	require.NoError(t, err)
+
+	var results []configstack.UnitResult
+
+	require.NoError(t, json.Unmarshal(resultsJSON, &results))
+	assert.Equal(t, []configstack.UnitResult{{Path: unitA.Path, Status: configstack.UnitStatusSkipped}}, results)
+
+	assert.NoFileExists(t, afterAllFile)
+}
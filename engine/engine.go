@@ -24,7 +24,6 @@ import (
 
 	"google.golang.org/grpc/credentials/insecure"
 
-	"github.com/gruntwork-io/terragrunt-engine-go/engine"
 	"github.com/gruntwork-io/terragrunt-engine-go/proto"
 	"github.com/gruntwork-io/terragrunt/internal/errors"
 	"github.com/gruntwork-io/terragrunt/options"
@@ -141,11 +140,42 @@ func DownloadEngine(ctx context.Context, opts *options.TerragruntOptions) error
 
 	e := opts.Engine
 
+	if _, err := LookupType(e.Type); err != nil {
+		return err
+	}
+
 	if util.FileExists(e.Source) {
-		// if source is a file, no need to download, exit
+		// if source is a file, no need to download, only verify the pinned checksum
+		if e.Checksum != "" {
+			return verifyChecksum(e.Source, e.Checksum)
+		}
+
 		return nil
 	}
 
+	var ociRef *ociReference
+
+	if isOCISource(e.Source) {
+		var err error
+
+		if ociRef, err = parseOCIReference(e.Source); err != nil {
+			return err
+		}
+
+		// A tag can be moved to another artifact at any time, so the engine, which is executed, must be pinned by digest or checksum.
+		if !ociRef.IsDigest() && e.Checksum == "" {
+			if !opts.EngineSkipChecksumCheck {
+				return errors.New(UnpinnedOCIReferenceError(e.Source))
+			}
+
+			opts.Logger.Warnf("The engine %s is neither referenced by digest nor pinned by checksum, it is NOT verified and may be altered by the registry", e.Source)
+		}
+		// the tag or digest identifies the engine version in the cache
+		if len(e.Version) == 0 {
+			e.Version = strings.ReplaceAll(ociRef.Reference, ":", "-")
+		}
+	}
+
 	// identify engine version if not specified
 	if len(e.Version) == 0 {
 		if !strings.Contains(e.Source, "://") {
@@ -189,10 +219,17 @@ func DownloadEngine(ctx context.Context, opts *options.TerragruntOptions) error
 	checksumFile := ""
 	checksumSigFile := ""
 
-	if strings.Contains(e.Source, "://") {
+	switch {
+	case ociRef != nil:
+		opts.Logger.Infof("Downloading %s to %s", e.Source, downloadFile)
+
+		if err := fetchOCIArtifact(ctx, ociRef, downloadFile); err != nil {
+			return err
+		}
+	case strings.Contains(e.Source, "://"):
 		// if source starts with absolute path, download as is
 		downloads[e.Source] = downloadFile
-	} else {
+	default:
 		baseURL := fmt.Sprintf("https://%s/releases/download/%s", e.Source, e.Version)
 
 		// URLs and their corresponding local paths
@@ -218,13 +255,23 @@ func DownloadEngine(ctx context.Context, opts *options.TerragruntOptions) error
 		}
 	}
 
-	if !opts.EngineSkipChecksumCheck && checksumFile != "" && checksumSigFile != "" {
+	switch {
+	case e.Checksum != "":
+		opts.Logger.Infof("Verifying pinned checksum for %s", downloadFile)
+
+		if err := verifyChecksum(downloadFile, e.Checksum); err != nil {
+			return err
+		}
+	case ociRef != nil && ociRef.IsDigest():
+		// OCI manifests and blobs are verified against the referenced digest while downloading
+		opts.Logger.Infof("Verified %s against the OCI digest %s", downloadFile, ociRef.Reference)
+	case !opts.EngineSkipChecksumCheck && checksumFile != "" && checksumSigFile != "":
 		opts.Logger.Infof("Verifying checksum for %s", downloadFile)
 
 		if err := verifyFile(downloadFile, checksumFile, checksumSigFile); err != nil {
 			return errors.New(err)
 		}
-	} else {
+	default:
 		opts.Logger.Warnf("Skipping verification for %s", downloadFile)
 	}
 
@@ -365,9 +412,20 @@ func engineDir(terragruntOptions *options.TerragruntOptions) (string, error) {
 	return filepath.Join(cacheDir, defaultEngineCachePath, engine.Type, engine.Version, platform, arch), nil
 }
 
+// engineSourceName returns the engine name derived from the last element of its source.
+func engineSourceName(source string) string {
+	if isOCISource(source) {
+		if ref, err := parseOCIReference(source); err == nil {
+			return ref.Name()
+		}
+	}
+
+	return filepath.Base(source)
+}
+
 // engineFileName returns the file name for the engine.
 func engineFileName(e *options.EngineOptions) string {
-	engineName := filepath.Base(e.Source)
+	engineName := engineSourceName(e.Source)
 	if util.FileExists(e.Source) {
 		// return file name if source is absolute path
 		return engineName
@@ -382,7 +440,7 @@ func engineFileName(e *options.EngineOptions) string {
 
 // engineChecksumName returns the file name of engine checksum file
 func engineChecksumName(e *options.EngineOptions) string {
-	engineName := filepath.Base(e.Source)
+	engineName := engineSourceName(e.Source)
 
 	engineName = strings.TrimPrefix(engineName, prefixTrim)
 
@@ -480,6 +538,11 @@ func Shutdown(ctx context.Context, opts *options.TerragruntOptions) error {
 
 // createEngine create engine for working directory
 func createEngine(terragruntOptions *options.TerragruntOptions) (*proto.EngineClient, *plugin.Client, error) {
+	engineType, err := LookupType(terragruntOptions.Engine.Type)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	path, err := engineDir(terragruntOptions)
 	if err != nil {
 		return nil, nil, errors.New(err)
@@ -491,7 +554,11 @@ func createEngine(terragruntOptions *options.TerragruntOptions) (*proto.EngineCl
 
 	// validate engine before loading if verification is not disabled
 	skipCheck := terragruntOptions.EngineSkipChecksumCheck
-	if !skipCheck && util.FileExists(localEnginePath) && util.FileExists(localChecksumFile) &&
+	if checksum := terragruntOptions.Engine.Checksum; checksum != "" && util.FileExists(terragruntOptions.Engine.Source) {
+		if err := verifyChecksum(localEnginePath, checksum); err != nil {
+			return nil, nil, err
+		}
+	} else if !skipCheck && util.FileExists(localEnginePath) && util.FileExists(localChecksumFile) &&
 		util.FileExists(localChecksumSigFile) {
 		if err := verifyFile(localEnginePath, localChecksumFile, localChecksumSigFile); err != nil {
 			return nil, nil, errors.New(err)
@@ -526,10 +593,9 @@ func createEngine(terragruntOptions *options.TerragruntOptions) (*proto.EngineCl
 			MagicCookieKey:   engineCookieKey,
 			MagicCookieValue: engineCookieValue,
 		},
-		Plugins: map[string]plugin.Plugin{
-			"plugin": &engine.TerragruntGRPCEngine{},
-		},
-		Cmd: cmd,
+		// the protocol version is negotiated among the versions supported by the engine type
+		VersionedPlugins: engineType.Plugins,
+		Cmd:              cmd,
 		GRPCDialOptions: []grpc.DialOption{
 			grpc.WithTransportCredentials(insecure.NewCredentials()),
 		},
@@ -541,7 +607,9 @@ func createEngine(terragruntOptions *options.TerragruntOptions) (*proto.EngineCl
 		return nil, nil, errors.New(err)
 	}
 
-	rawClient, err := rpcClient.Dispense("plugin")
+	terragruntOptions.Logger.Debugf("Engine %s negotiated plugin protocol version %d", localEnginePath, client.NegotiatedVersion())
+
+	rawClient, err := rpcClient.Dispense(pluginName)
 	if err != nil {
 		return nil, nil, errors.New(err)
 	}
@@ -1,10 +1,22 @@
 package engine_test
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
 	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
 	"testing"
 
 	"github.com/gruntwork-io/terragrunt/engine"
+	"github.com/gruntwork-io/terragrunt/options"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -44,3 +56,211 @@ func TestReadEngineOutput(t *testing.T) {
 	err := engine.ReadEngineOutput(runOptions, false, outputFn)
 	assert.NoError(t, err)
 }
+
+func newEngineTestOptions(t *testing.T, engineOpts *options.EngineOptions) *options.TerragruntOptions {
+	t.Helper()
+
+	opts := options.NewTerragruntOptions()
+	opts.EngineEnabled = true
+	opts.EngineCachePath = t.TempDir()
+	opts.Engine = engineOpts
+
+	return opts
+}
+
+func findEngineFile(t *testing.T, cacheDir string) []byte {
+	t.Helper()
+
+	var content []byte
+
+	err := filepath.WalkDir(cacheDir, func(path string, entry os.DirEntry, err error) error {
+		if err == nil && !entry.IsDir() && strings.HasPrefix(entry.Name(), "terragrunt-iac-") {
+			content, err = os.ReadFile(path)
+		}
+
+		return err
+	})
+	require.NoError(t, err)
+
+	return content
+}
+
+func TestDownloadEngineUnsupportedType(t *testing.T) {
+	t.Parallel()
+
+	opts := newEngineTestOptions(t, &options.EngineOptions{Source: "/path/to/engine", Type: "grpc-v9"})
+
+	err := engine.DownloadEngine(engine.WithEngineValues(context.Background()), opts)
+
+	var typeErr engine.UnsupportedTypeError
+	require.ErrorAs(t, err, &typeErr)
+	assert.Equal(t, []string{engine.DefaultType}, typeErr.Supported)
+}
+
+func TestDownloadEngineFromOCIRegistry(t *testing.T) {
+	t.Parallel()
+
+	binary := []byte("#!/bin/sh\necho engine\n")
+	blobDigest := fmt.Sprintf("sha256:%x", sha256.Sum256(binary))
+	platform := runtime.GOOS + "_" + runtime.GOARCH
+
+	manifest, err := json.Marshal(map[string]any{
+		"schemaVersion": 2,
+		"mediaType":     "application/vnd.oci.image.manifest.v1+json",
+		"layers": []map[string]any{
+			{
+				"mediaType":   "application/octet-stream",
+				"digest":      "sha256:0000000000000000000000000000000000000000000000000000000000000000",
+				"annotations": map[string]string{"org.opencontainers.image.title": "engine_plan9_mips"},
+			},
+			{
+				"mediaType":   "application/octet-stream",
+				"digest":      blobDigest,
+				"size":        len(binary),
+				"annotations": map[string]string{"org.opencontainers.image.title": "engine_" + platform},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	manifestDigest := fmt.Sprintf("sha256:%x", sha256.Sum256(manifest))
+	tamperedDigest := fmt.Sprintf("sha256:%064x", 1)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "repository:engines/tofu:pull", r.URL.Query().Get("scope"))
+		fmt.Fprint(w, `{"token":"secret"}`)
+	})
+	mux.HandleFunc("/v2/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.Header().Set("Www-Authenticate", fmt.Sprintf(`Bearer realm="http://%s/token",service="test"`, r.Host))
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		switch r.URL.Path {
+		case "/v2/engines/tofu/manifests/v1.0.0", "/v2/engines/tofu/manifests/" + manifestDigest:
+			w.Header().Set("Docker-Content-Digest", manifestDigest)
+			w.Write(manifest) //nolint:errcheck
+		case "/v2/engines/tofu/manifests/" + tamperedDigest:
+			// The registry serves another manifest than the referenced one.
+			w.Write(manifest) //nolint:errcheck
+		case "/v2/engines/tofu/blobs/" + blobDigest:
+			w.Write(binary) //nolint:errcheck
+		default:
+			http.NotFound(w, r)
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	repository := "oci://" + strings.TrimPrefix(server.URL, "http://") + "/engines/tofu"
+
+	testCases := []struct {
+		name            string
+		source          string
+		skipCheck       bool
+		expectedVersion string
+		expectedErr     error
+	}{
+		{
+			name:            "digest",
+			source:          repository + "@" + manifestDigest,
+			expectedVersion: strings.ReplaceAll(manifestDigest, ":", "-"),
+		},
+		{
+			name:        "tampered digest",
+			source:      repository + "@" + tamperedDigest,
+			expectedErr: engine.ChecksumMismatchError{},
+		},
+		{
+			name:        "unpinned tag",
+			source:      repository + ":v1.0.0",
+			expectedErr: engine.UnpinnedOCIReferenceError(""),
+		},
+		{
+			name:            "unpinned tag with skipped check",
+			source:          repository + ":v1.0.0",
+			skipCheck:       true,
+			expectedVersion: "v1.0.0",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			opts := newEngineTestOptions(t, &options.EngineOptions{Source: tc.source, Type: engine.DefaultType})
+			opts.EngineSkipChecksumCheck = tc.skipCheck
+
+			err := engine.DownloadEngine(engine.WithEngineValues(context.Background()), opts)
+			if tc.expectedErr == nil {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedVersion, opts.Engine.Version)
+				assert.Equal(t, binary, findEngineFile(t, opts.EngineCachePath))
+
+				return
+			}
+
+			require.ErrorAs(t, err, &tc.expectedErr)
+		})
+	}
+}
+
+func TestDownloadEnginePinnedChecksum(t *testing.T) {
+	t.Parallel()
+
+	binary := []byte("#!/bin/sh\necho engine\n")
+	checksum := sha256.Sum256(binary)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(binary) //nolint:errcheck
+	}))
+	t.Cleanup(server.Close)
+
+	testCases := []struct {
+		name        string
+		checksum    string
+		expectedErr error
+	}{
+		{
+			name:     "matching",
+			checksum: "sha256:" + hex.EncodeToString(checksum[:]),
+		},
+		{
+			name:        "mismatching",
+			checksum:    strings.Repeat("0", 64),
+			expectedErr: engine.ChecksumMismatchError{},
+		},
+		{
+			name:        "invalid",
+			checksum:    "md5:abc",
+			expectedErr: engine.InvalidChecksumError(""),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			opts := newEngineTestOptions(t, &options.EngineOptions{
+				Source:   server.URL + "/terragrunt-iac-engine-test",
+				Version:  "v0.0.1",
+				Type:     engine.DefaultType,
+				Checksum: tc.checksum,
+			})
+
+			err := engine.DownloadEngine(engine.WithEngineValues(context.Background()), opts)
+			if tc.expectedErr == nil {
+				require.NoError(t, err)
+				assert.Equal(t, binary, findEngineFile(t, opts.EngineCachePath))
+
+				return
+			}
+
+			require.ErrorAs(t, err, &tc.expectedErr)
+		})
+	}
+}
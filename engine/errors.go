@@ -0,0 +1,58 @@
+package engine
+
+import (
+	"fmt"
+	"strings"
+)
+
+// UnsupportedTypeError is returned when the engine type is not in the types registry.
+type UnsupportedTypeError struct {
+	Type      string
+	Supported []string
+}
+
+func (err UnsupportedTypeError) Error() string {
+	return fmt.Sprintf("unsupported engine type %q, supported types: %s", err.Type, strings.Join(err.Supported, ", "))
+}
+
+// ChecksumMismatchError is returned when the engine package does not match the pinned checksum.
+type ChecksumMismatchError struct {
+	File     string
+	Expected string
+	Actual   string
+}
+
+func (err ChecksumMismatchError) Error() string {
+	return fmt.Sprintf("engine %s has SHA-256 checksum %s, expected %s", err.File, err.Actual, err.Expected)
+}
+
+// InvalidChecksumError is returned when the engine checksum is not a hex-encoded SHA-256 hash.
+type InvalidChecksumError string
+
+func (checksum InvalidChecksumError) Error() string {
+	return fmt.Sprintf("invalid engine checksum %q, expected a hex-encoded SHA-256 hash, e.g. sha256:<hash>", string(checksum))
+}
+
+// InvalidOCIReferenceError is returned when the `oci://` source cannot be parsed.
+type InvalidOCIReferenceError string
+
+func (source InvalidOCIReferenceError) Error() string {
+	return fmt.Sprintf("invalid OCI reference %q, expected oci://<registry>/<repository>[:<tag>|@<digest>]", string(source))
+}
+
+// OCIArtifactNotFoundError is returned when the OCI artifact has no layer for the current platform.
+type OCIArtifactNotFoundError struct {
+	Reference string
+	Platform  string
+}
+
+func (err OCIArtifactNotFoundError) Error() string {
+	return fmt.Sprintf("OCI artifact %s has no engine for platform %s", err.Reference, err.Platform)
+}
+
+// UnpinnedOCIReferenceError is returned when the `oci://` source is referenced by tag, without a pinned checksum.
+type UnpinnedOCIReferenceError string
+
+func (source UnpinnedOCIReferenceError) Error() string {
+	return fmt.Sprintf("engine source %q is referenced by tag, reference it by digest, e.g. oci://<registry>/<repository>@sha256:<hash>, or pin its checksum with the checksum attribute", string(source))
+}
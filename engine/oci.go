@@ -0,0 +1,377 @@
+package engine
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+)
+
+const (
+	ociScheme = "oci://"
+
+	ociDefaultTag = "latest"
+
+	ociMediaTypeManifest      = "application/vnd.oci.image.manifest.v1+json"
+	ociMediaTypeIndex         = "application/vnd.oci.image.index.v1+json"
+	dockerMediaTypeManifest   = "application/vnd.docker.distribution.manifest.v2+json"
+	dockerMediaTypeList       = "application/vnd.docker.distribution.manifest.list.v2+json"
+	ociAnnotationTitle        = "org.opencontainers.image.title"
+	ociDigestAlgorithmSHA256  = "sha256:"
+	ociAuthenticateHeader     = "Www-Authenticate"
+	ociContentDigestHeader    = "Docker-Content-Digest"
+	ociBearerChallengePrefix  = "Bearer "
+	ociRepositoryPullScopeFmt = "repository:%s:pull"
+
+	// ociClientTimeout bounds the whole request, including reading the engine package.
+	ociClientTimeout = 10 * time.Minute
+	// ociResponseHeaderTimeout bounds waiting for the registry to respond.
+	ociResponseHeaderTimeout = 30 * time.Second
+)
+
+// ociReference is a parsed `oci://<registry>/<repository>[:<tag>|@<digest>]` engine source.
+type ociReference struct {
+	Registry   string
+	Repository string
+	// Reference is either a tag or a digest.
+	Reference string
+}
+
+// isOCISource returns true if the engine source is an OCI artifact reference.
+func isOCISource(source string) bool {
+	return strings.HasPrefix(source, ociScheme)
+}
+
+// parseOCIReference parses the `oci://` engine source.
+func parseOCIReference(source string) (*ociReference, error) {
+	registry, repository, ok := strings.Cut(strings.TrimPrefix(source, ociScheme), "/")
+	if !ok || registry == "" || repository == "" {
+		return nil, errors.New(InvalidOCIReferenceError(source))
+	}
+
+	ref := &ociReference{Registry: registry, Repository: repository, Reference: ociDefaultTag}
+
+	if name, digest, ok := strings.Cut(repository, "@"); ok {
+		ref.Repository, ref.Reference = name, digest
+	} else if idx := strings.LastIndex(repository, ":"); idx > strings.LastIndex(repository, "/") {
+		ref.Repository, ref.Reference = repository[:idx], repository[idx+1:]
+	}
+
+	if ref.Repository == "" || ref.Reference == "" {
+		return nil, errors.New(InvalidOCIReferenceError(source))
+	}
+
+	return ref, nil
+}
+
+// Name returns the last element of the repository, used as the engine name.
+func (ref *ociReference) Name() string {
+	return path.Base(ref.Repository)
+}
+
+// IsDigest returns true if the artifact is referenced by its digest rather than a tag.
+func (ref *ociReference) IsDigest() bool {
+	return strings.HasPrefix(ref.Reference, ociDigestAlgorithmSHA256)
+}
+
+func (ref *ociReference) String() string {
+	if ref.IsDigest() {
+		return ociScheme + ref.Registry + "/" + ref.Repository + "@" + ref.Reference
+	}
+
+	return ociScheme + ref.Registry + "/" + ref.Repository + ":" + ref.Reference
+}
+
+// baseURL returns the registry API URL. Registries on the loopback interface, such as a local test registry, are accessed over plain HTTP.
+func (ref *ociReference) baseURL() string {
+	scheme := "https"
+
+	host := ref.Registry
+	if hostname, _, err := net.SplitHostPort(host); err == nil {
+		host = hostname
+	}
+
+	if ip := net.ParseIP(host); host == "localhost" || (ip != nil && ip.IsLoopback()) {
+		scheme = "http"
+	}
+
+	return fmt.Sprintf("%s://%s/v2/%s", scheme, ref.Registry, ref.Repository)
+}
+
+type ociDescriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Size        int64             `json:"size"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+	Platform    *struct {
+		OS           string `json:"os"`
+		Architecture string `json:"architecture"`
+	} `json:"platform,omitempty"`
+}
+
+// ociManifest covers both the image manifest and the image index, they are told apart by `Manifests` and `Layers`.
+type ociManifest struct {
+	MediaType string           `json:"mediaType"`
+	Manifests []*ociDescriptor `json:"manifests,omitempty"`
+	Layers    []*ociDescriptor `json:"layers,omitempty"`
+}
+
+// ociClient pulls artifacts using the OCI distribution API, authenticating anonymously with bearer tokens when the registry asks for it.
+type ociClient struct {
+	*http.Client
+
+	ref   *ociReference
+	token string
+}
+
+// newOCIClient returns a client for the registry of the reference, with timeouts so that an unresponsive registry does not hang the run.
+func newOCIClient(ref *ociReference) *ociClient {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.ResponseHeaderTimeout = ociResponseHeaderTimeout
+
+	return &ociClient{
+		Client: &http.Client{Transport: transport, Timeout: ociClientTimeout},
+		ref:    ref,
+	}
+}
+
+// fetchOCIArtifact downloads the engine for the current platform from the OCI artifact into the `dst` file, verifying its digest.
+// The platform is selected from the image index, if any, and then by the layer title containing `<os>_<arch>`. An artifact with a single layer is used as is.
+// The manifests are verified against the digest they are referenced by, so that an artifact referenced by digest cannot be altered by the registry.
+func fetchOCIArtifact(ctx context.Context, ref *ociReference, dst string) error {
+	client := newOCIClient(ref)
+
+	manifest, err := client.manifest(ctx, ref.Reference)
+	if err != nil {
+		return err
+	}
+
+	platform := runtime.GOOS + "_" + runtime.GOARCH
+
+	if len(manifest.Manifests) > 0 {
+		var digest string
+
+		for _, desc := range manifest.Manifests {
+			if desc.Platform != nil && desc.Platform.OS == runtime.GOOS && desc.Platform.Architecture == runtime.GOARCH {
+				digest = desc.Digest
+				break
+			}
+		}
+
+		if digest == "" {
+			return errors.New(OCIArtifactNotFoundError{Reference: ref.String(), Platform: platform})
+		}
+
+		if manifest, err = client.manifest(ctx, digest); err != nil {
+			return err
+		}
+	}
+
+	layer := selectOCILayer(manifest.Layers, platform)
+	if layer == nil {
+		return errors.New(OCIArtifactNotFoundError{Reference: ref.String(), Platform: platform})
+	}
+
+	return client.blob(ctx, layer, dst)
+}
+
+// selectOCILayer returns the layer whose title contains the platform, or the only layer of the artifact.
+func selectOCILayer(layers []*ociDescriptor, platform string) *ociDescriptor {
+	for _, layer := range layers {
+		if strings.Contains(layer.Annotations[ociAnnotationTitle], platform) {
+			return layer
+		}
+	}
+
+	if len(layers) == 1 {
+		return layers[0]
+	}
+
+	return nil
+}
+
+// manifest fetches the manifest or the image index with the given reference. If the reference is a digest, the manifest is verified against it,
+// otherwise against the digest returned by the registry, if any.
+func (client *ociClient) manifest(ctx context.Context, reference string) (*ociManifest, error) {
+	resp, err := client.get(ctx, client.ref.baseURL()+"/manifests/"+reference,
+		ociMediaTypeManifest, ociMediaTypeIndex, dockerMediaTypeManifest, dockerMediaTypeList)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close() //nolint:errcheck
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.New(err)
+	}
+
+	expected := resp.Header.Get(ociContentDigestHeader)
+	if strings.HasPrefix(reference, ociDigestAlgorithmSHA256) {
+		expected = reference
+	}
+
+	if actual := ociDigest(body); expected != "" && actual != expected {
+		return nil, errors.New(ChecksumMismatchError{File: client.ref.Repository + "@" + reference, Expected: expected, Actual: actual})
+	}
+
+	var manifest ociManifest
+
+	if err := json.Unmarshal(body, &manifest); err != nil {
+		return nil, errors.Errorf("failed to decode OCI manifest %s: %w", reference, err)
+	}
+
+	return &manifest, nil
+}
+
+// blob downloads the layer into the `dst` file, verifying it against the digest and the size from the manifest.
+func (client *ociClient) blob(ctx context.Context, layer *ociDescriptor, dst string) error {
+	if !strings.HasPrefix(layer.Digest, ociDigestAlgorithmSHA256) {
+		return errors.Errorf("unsupported OCI digest algorithm %q", layer.Digest)
+	}
+
+	resp, err := client.get(ctx, client.ref.baseURL()+"/blobs/"+layer.Digest)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close() //nolint:errcheck
+
+	file, err := os.Create(dst)
+	if err != nil {
+		return errors.New(err)
+	}
+	defer file.Close() //nolint:errcheck
+
+	hash := sha256.New()
+
+	size, err := io.Copy(io.MultiWriter(file, hash), resp.Body)
+	if err != nil {
+		return errors.New(err)
+	}
+
+	if actual := ociDigestAlgorithmSHA256 + hex.EncodeToString(hash.Sum(nil)); actual != layer.Digest {
+		return errors.New(ChecksumMismatchError{File: client.ref.String(), Expected: layer.Digest, Actual: actual})
+	}
+
+	if layer.Size > 0 && size != layer.Size {
+		return errors.Errorf("OCI layer %s has %d bytes, expected %d", layer.Digest, size, layer.Size)
+	}
+
+	return nil
+}
+
+func ociDigest(content []byte) string {
+	hash := sha256.Sum256(content)
+
+	return ociDigestAlgorithmSHA256 + hex.EncodeToString(hash[:])
+}
+
+// get sends the GET request, retrying it once with a bearer token if the registry responds with an authentication challenge.
+func (client *ociClient) get(ctx context.Context, reqURL string, accept ...string) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+		if err != nil {
+			return nil, errors.New(err)
+		}
+
+		if len(accept) > 0 {
+			req.Header.Set("Accept", strings.Join(accept, ", "))
+		}
+
+		if client.token != "" {
+			req.Header.Set("Authorization", "Bearer "+client.token)
+		}
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, errors.New(err)
+		}
+
+		if resp.StatusCode == http.StatusUnauthorized && attempt == 0 {
+			challenge := resp.Header.Get(ociAuthenticateHeader)
+			resp.Body.Close() //nolint:errcheck
+
+			if client.token, err = client.authenticate(ctx, challenge); err != nil {
+				return nil, err
+			}
+
+			continue
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close() //nolint:errcheck
+			return nil, errors.Errorf("%s returned from %s", resp.Status, reqURL)
+		}
+
+		return resp, nil
+	}
+}
+
+// authenticate requests an anonymous pull token from the realm of the `Bearer` challenge.
+func (client *ociClient) authenticate(ctx context.Context, challenge string) (string, error) {
+	if !strings.HasPrefix(challenge, ociBearerChallengePrefix) {
+		return "", errors.Errorf("unsupported OCI registry authentication challenge %q", challenge)
+	}
+
+	params := make(map[string]string)
+
+	for _, param := range strings.Split(strings.TrimPrefix(challenge, ociBearerChallengePrefix), ",") {
+		if key, val, ok := strings.Cut(strings.TrimSpace(param), "="); ok {
+			params[key] = strings.Trim(val, `"`)
+		}
+	}
+
+	tokenURL, err := url.Parse(params["realm"])
+	if err != nil || params["realm"] == "" {
+		return "", errors.Errorf("invalid OCI registry authentication realm %q", params["realm"])
+	}
+
+	query := tokenURL.Query()
+	if service := params["service"]; service != "" {
+		query.Set("service", service)
+	}
+
+	query.Set("scope", fmt.Sprintf(ociRepositoryPullScopeFmt, client.ref.Repository))
+	tokenURL.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, tokenURL.String(), nil)
+	if err != nil {
+		return "", errors.New(err)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", errors.New(err)
+	}
+	defer resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.Errorf("%s returned from %s", resp.Status, tokenURL.Host)
+	}
+
+	var token struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return "", errors.New(err)
+	}
+
+	if token.Token != "" {
+		return token.Token, nil
+	}
+
+	return token.AccessToken, nil
+}
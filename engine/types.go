@@ -0,0 +1,62 @@
+package engine
+
+import (
+	"maps"
+	"slices"
+	"sync"
+
+	"github.com/gruntwork-io/terragrunt-engine-go/engine"
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/hashicorp/go-plugin"
+)
+
+const (
+	// DefaultType is the engine type used when the `type` attribute is not set, also exposed as `config.DefaultEngineType`.
+	DefaultType = "rpc"
+
+	pluginName = "plugin"
+)
+
+// Type describes an engine plugin type by the plugin protocol versions Terragrunt can speak with it.
+// The protocol version is negotiated with the engine binary during the plugin handshake.
+type Type struct {
+	// Plugins maps the supported protocol versions to the plugin sets served by the engine.
+	// Each set must contain the `plugin` entry dispensing a `proto.EngineClient`.
+	Plugins map[int]plugin.PluginSet
+}
+
+// ProtocolVersions returns the supported protocol versions in ascending order.
+func (engineType *Type) ProtocolVersions() []int {
+	return slices.Sorted(maps.Keys(engineType.Plugins))
+}
+
+var (
+	typesMu sync.RWMutex
+	types   = map[string]*Type{
+		DefaultType: {
+			Plugins: map[int]plugin.PluginSet{
+				engineVersion: {pluginName: &engine.TerragruntGRPCEngine{}},
+			},
+		},
+	}
+)
+
+// RegisterType adds the engine type to the registry of supported types, replacing the existing one with the same name.
+func RegisterType(name string, engineType *Type) {
+	typesMu.Lock()
+	defer typesMu.Unlock()
+
+	types[name] = engineType
+}
+
+// LookupType returns the registered engine type with the given name.
+func LookupType(name string) (*Type, error) {
+	typesMu.RLock()
+	defer typesMu.RUnlock()
+
+	if engineType, ok := types[name]; ok {
+		return engineType, nil
+	}
+
+	return nil, errors.New(UnsupportedTypeError{Type: name, Supported: slices.Sorted(maps.Keys(types))})
+}
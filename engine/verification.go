@@ -60,3 +60,23 @@ func verifyFile(checkedFile, checksumsFile, signatureFile string) error {
 
 	return nil
 }
+
+// verifyChecksum verifies the file against the pinned SHA-256 checksum, given as `sha256:<hex>` or just `<hex>`.
+func verifyChecksum(checkedFile, checksum string) error {
+	expectedChecksum := strings.ToLower(strings.TrimPrefix(checksum, ociDigestAlgorithmSHA256))
+
+	if decoded, err := hex.DecodeString(expectedChecksum); err != nil || len(decoded) != sha256.Size {
+		return errors.New(InvalidChecksumError(checksum))
+	}
+
+	packageChecksum, err := util.FileSHA256(checkedFile)
+	if err != nil {
+		return errors.New(err)
+	}
+
+	if actualChecksum := hex.EncodeToString(packageChecksum); actualChecksum != expectedChecksum {
+		return errors.New(ChecksumMismatchError{File: checkedFile, Expected: expectedChecksum, Actual: actualChecksum})
+	}
+
+	return nil
+}
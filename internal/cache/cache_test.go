@@ -2,11 +2,13 @@ package cache_test
 
 import (
 	"context"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/gruntwork-io/terragrunt/internal/cache"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestCacheCreation(t *testing.T) {
@@ -82,3 +84,68 @@ func TestExpiringCacheExpiration(t *testing.T) {
 	assert.NotEmpty(t, value)
 	assert.Equal(t, "carrot", value)
 }
+
+func TestDiskCacheOperation(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dir := t.TempDir()
+	diskCache := cache.NewDiskCache[string]("test", dir)
+
+	value, found := diskCache.Get(ctx, "potato")
+	assert.False(t, found)
+	assert.Empty(t, value)
+
+	require.NoError(t, diskCache.Put(ctx, "potato", "carrot", time.Now().Add(time.Hour)))
+
+	// The items are shared by the caches using the same directory, as in different processes.
+	value, found = cache.NewDiskCache[string]("test", dir).Get(ctx, "potato")
+	assert.True(t, found)
+	assert.Equal(t, "carrot", value)
+
+	require.NoError(t, diskCache.Delete(ctx, "potato"))
+
+	_, found = diskCache.Get(ctx, "potato")
+	assert.False(t, found)
+
+	require.NoError(t, diskCache.Put(ctx, "potato", "carrot", time.Now().Add(time.Hour)))
+	require.NoError(t, diskCache.Put(ctx, "tomato", "cucumber", time.Now().Add(time.Hour)))
+	require.NoError(t, diskCache.Clear(ctx))
+
+	_, found = diskCache.Get(ctx, "tomato")
+	assert.False(t, found)
+}
+
+func TestDiskCacheExpiration(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	diskCache := cache.NewDiskCache[string]("test", t.TempDir())
+
+	require.NoError(t, diskCache.Put(ctx, "potato", "carrot", time.Now().Add(-1*time.Second)))
+
+	value, found := diskCache.Get(ctx, "potato")
+	assert.False(t, found)
+	assert.Empty(t, value)
+}
+
+func TestDiskCacheDeleteExpired(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dir := t.TempDir()
+	diskCache := cache.NewDiskCache[string]("test", dir)
+
+	require.NoError(t, diskCache.Put(ctx, "potato", "carrot", time.Now().Add(-1*time.Second)))
+	require.NoError(t, diskCache.Put(ctx, "tomato", "cucumber", time.Now().Add(time.Minute)))
+
+	require.NoError(t, diskCache.DeleteExpired(ctx))
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	require.NoError(t, err)
+	assert.Len(t, files, 1)
+
+	value, found := diskCache.Get(ctx, "tomato")
+	assert.True(t, found)
+	assert.Equal(t, "cucumber", value)
+}
@@ -0,0 +1,174 @@
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/telemetry"
+)
+
+const (
+	diskCacheFileExt = ".json"
+
+	// diskCacheDirPerms only gives access to the user, since the cached items may be secrets. The items are written with
+	// the 0600 permissions of the temporary files.
+	diskCacheDirPerms = 0700
+)
+
+// diskItem - item stored in a file of the disk cache
+type diskItem[V any] struct {
+	Key        string    `json:"key"`
+	Value      V         `json:"value"`
+	Expiration time.Time `json:"expiration"`
+}
+
+// DiskCache - cache with items with expiration time, persisted in a directory so that they are shared between processes
+type DiskCache[V any] struct {
+	Name string
+	Dir  string
+}
+
+// NewDiskCache - create new disk cache with generic type V, storing the items in the given directory
+func NewDiskCache[V any](name, dir string) *DiskCache[V] {
+	return &DiskCache[V]{
+		Name: name,
+		Dir:  dir,
+	}
+}
+
+// Get - fetch value from cache by key. Items that cannot be read are considered missing.
+func (c *DiskCache[V]) Get(ctx context.Context, key string) (V, bool) {
+	var item diskItem[V]
+
+	telemetry.Count(ctx, c.Name+"_cache_get", 1)
+
+	content, err := os.ReadFile(c.path(key))
+	if err != nil || json.Unmarshal(content, &item) != nil || item.Key != key {
+		telemetry.Count(ctx, c.Name+"_cache_miss", 1)
+
+		var empty V
+
+		return empty, false
+	}
+
+	if time.Now().After(item.Expiration) {
+		telemetry.Count(ctx, c.Name+"_cache_expiry", 1)
+		os.Remove(c.path(key)) //nolint:errcheck
+
+		var empty V
+
+		return empty, false
+	}
+
+	telemetry.Count(ctx, c.Name+"_cache_hit", 1)
+
+	return item.Value, true
+}
+
+// Put - put value into cache by key. The item is written to a temporary file first, so that concurrent processes
+// never read a partially written item, and is only readable by the user.
+func (c *DiskCache[V]) Put(ctx context.Context, key string, value V, expiration time.Time) error {
+	telemetry.Count(ctx, c.Name+"_cache_put", 1)
+
+	content, err := json.Marshal(diskItem[V]{Key: key, Value: value, Expiration: expiration})
+	if err != nil {
+		return errors.New(err)
+	}
+
+	if err := os.MkdirAll(c.Dir, diskCacheDirPerms); err != nil {
+		return errors.New(err)
+	}
+
+	file, err := os.CreateTemp(c.Dir, "*.tmp")
+	if err != nil {
+		return errors.New(err)
+	}
+
+	defer os.Remove(file.Name()) //nolint:errcheck
+
+	if _, err := file.Write(content); err != nil {
+		file.Close() //nolint:errcheck
+		return errors.New(err)
+	}
+
+	if err := file.Close(); err != nil {
+		return errors.New(err)
+	}
+
+	if err := os.Rename(file.Name(), c.path(key)); err != nil {
+		return errors.New(err)
+	}
+
+	return nil
+}
+
+// Delete - remove the item with the given key from cache
+func (c *DiskCache[V]) Delete(ctx context.Context, key string) error {
+	telemetry.Count(ctx, c.Name+"_cache_delete", 1)
+
+	if err := os.Remove(c.path(key)); err != nil && !os.IsNotExist(err) {
+		return errors.New(err)
+	}
+
+	return nil
+}
+
+// Clear - remove all the items from cache
+func (c *DiskCache[V]) Clear(ctx context.Context) error {
+	telemetry.Count(ctx, c.Name+"_cache_clear", 1)
+
+	files, err := filepath.Glob(filepath.Join(c.Dir, "*"+diskCacheFileExt))
+	if err != nil {
+		return errors.New(err)
+	}
+
+	for _, file := range files {
+		if err := os.Remove(file); err != nil && !os.IsNotExist(err) {
+			return errors.New(err)
+		}
+	}
+
+	return nil
+}
+
+// DeleteExpired - remove the items whose expiration time has passed, so that the results that are never read again
+// do not pile up in the directory. Items that cannot be read are left in place.
+func (c *DiskCache[V]) DeleteExpired(ctx context.Context) error {
+	telemetry.Count(ctx, c.Name+"_cache_delete_expired", 1)
+
+	files, err := filepath.Glob(filepath.Join(c.Dir, "*"+diskCacheFileExt))
+	if err != nil {
+		return errors.New(err)
+	}
+
+	now := time.Now()
+
+	for _, file := range files {
+		var item struct {
+			Expiration time.Time `json:"expiration"`
+		}
+
+		content, err := os.ReadFile(file)
+		if err != nil || json.Unmarshal(content, &item) != nil || !now.After(item.Expiration) {
+			continue
+		}
+
+		if err := os.Remove(file); err != nil && !os.IsNotExist(err) {
+			return errors.New(err)
+		}
+	}
+
+	return nil
+}
+
+func (c *DiskCache[V]) path(key string) string {
+	keyHash := sha256.Sum256([]byte(key))
+
+	return filepath.Join(c.Dir, hex.EncodeToString(keyHash[:])+diskCacheFileExt)
+}
@@ -0,0 +1,104 @@
+package lsp
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/sourcegraph/go-lsp"
+	"github.com/zclconf/go-cty/cty/function"
+
+	"github.com/gruntwork-io/terragrunt/config"
+)
+
+// completion returns the built-in functions, and the attributes and blocks of the block enclosing the position.
+func (server *Server) completion(ctx context.Context, params lsp.CompletionParams) (*lsp.CompletionList, error) {
+	doc, err := server.document(params.TextDocument.URI)
+	if err != nil {
+		return nil, err
+	}
+
+	opts, err := server.unitOptions(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	// The variables are not needed to list the functions, so the configuration is not evaluated.
+	evalCtx, err := config.NewEvalContext(config.NewParsingContext(ctx, opts), doc.path)
+	if err != nil {
+		return nil, err
+	}
+
+	items := []lsp.CompletionItem{}
+
+	if schema, ok := config.BlockSchemas()[doc.blockPath(doc.offset(params.Position))]; ok {
+		// Some settings, such as `generate`, can be configured either as a block or as an attribute, they are
+		// completed as blocks.
+		blockTypes := map[string]bool{}
+
+		for _, block := range schema.Blocks {
+			blockTypes[block.Type] = true
+
+			items = append(items, lsp.CompletionItem{Label: block.Type, Kind: lsp.CIKStruct, Detail: "block"})
+		}
+
+		for _, attr := range schema.Attributes {
+			if !blockTypes[attr.Name] {
+				items = append(items, lsp.CompletionItem{Label: attr.Name, Kind: lsp.CIKProperty, Detail: "attribute"})
+			}
+		}
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Label < items[j].Label
+	})
+
+	// The functions are listed after the attributes and blocks.
+	names := slices.Sorted(maps.Keys(evalCtx.Functions))
+
+	for _, name := range names {
+		items = append(items, lsp.CompletionItem{Label: name, Kind: lsp.CIKFunction, Detail: functionSignature(name, evalCtx.Functions[name])})
+	}
+
+	return &lsp.CompletionList{Items: items}, nil
+}
+
+// blockPath returns the dotted path of the types of the blocks enclosing the offset, such as `terraform.before_hook`.
+func (doc *document) blockPath(offset int) string {
+	var types []string
+
+	for body := doc.body; body != nil; {
+		var next *hclsyntax.Body
+
+		for _, block := range body.Blocks {
+			if block.Body.SrcRange.ContainsOffset(offset) {
+				types = append(types, block.Type)
+				next = block.Body
+
+				break
+			}
+		}
+
+		body = next
+	}
+
+	return strings.Join(types, ".")
+}
+
+func functionSignature(name string, fn function.Function) string {
+	params := make([]string, 0, len(fn.Params())+1)
+
+	for _, param := range fn.Params() {
+		params = append(params, fmt.Sprintf("%s %s", param.Name, param.Type.FriendlyName()))
+	}
+
+	if param := fn.VarParam(); param != nil {
+		params = append(params, fmt.Sprintf("%s... %s", param.Name, param.Type.FriendlyName()))
+	}
+
+	return fmt.Sprintf("%s(%s)", name, strings.Join(params, ", "))
+}
@@ -0,0 +1,95 @@
+package lsp
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/sourcegraph/go-lsp"
+
+	"github.com/gruntwork-io/terragrunt/config"
+	"github.com/gruntwork-io/terragrunt/util"
+)
+
+// definition returns the location of the configuration referenced at the position: the path of an `include` block, the
+// `config_path` of a `dependency` block, or the argument of a `read_terragrunt_config` call.
+func (server *Server) definition(ctx context.Context, params lsp.TextDocumentPositionParams) ([]lsp.Location, error) {
+	doc, err := server.document(params.TextDocument.URI)
+	if err != nil {
+		return nil, err
+	}
+
+	expr := doc.configPathExpr(doc.offset(params.Position))
+	if expr == nil {
+		return []lsp.Location{}, nil
+	}
+
+	evalCtx, err := server.evalContext(ctx, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	path, ok := evalString(expr, evalCtx)
+	if !ok {
+		return []lsp.Location{}, nil
+	}
+
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(filepath.Dir(doc.path), path)
+	}
+
+	// Dependencies and `read_terragrunt_config` also accept the directory of a unit.
+	path = config.GetDefaultConfigPath(path)
+	if !util.FileExists(path) {
+		return []lsp.Location{}, nil
+	}
+
+	return []lsp.Location{{URI: pathToURI(path)}}, nil
+}
+
+// configPathExpr returns the expression of the configuration path referenced at the offset.
+func (doc *document) configPathExpr(offset int) hcl.Expression {
+	if doc.body == nil {
+		return nil
+	}
+
+	var expr hcl.Expression
+
+	_ = hclsyntax.VisitAll(doc.body, func(node hclsyntax.Node) hcl.Diagnostics {
+		call, ok := node.(*hclsyntax.FunctionCallExpr)
+		if ok && call.Name == config.FuncNameReadTerragruntConfig && len(call.Args) > 0 && call.Range().ContainsOffset(offset) {
+			expr = call.Args[0]
+		}
+
+		return nil
+	})
+
+	if expr != nil {
+		return expr
+	}
+
+	pathAttrs := map[string]string{
+		config.MetadataInclude:    "path",
+		config.MetadataDependency: "config_path",
+	}
+
+	for _, block := range doc.body.Blocks {
+		attrName, ok := pathAttrs[block.Type]
+		if !ok {
+			continue
+		}
+
+		attr, ok := block.Body.Attributes[attrName]
+		if !ok {
+			continue
+		}
+
+		// The path is resolved from the block header as well as from the attribute.
+		if block.DefRange().ContainsOffset(offset) || attr.SrcRange.ContainsOffset(offset) {
+			return attr.Expr
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package lsp
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/sourcegraph/go-lsp"
+	"github.com/sourcegraph/jsonrpc2"
+
+	"github.com/gruntwork-io/terragrunt/config"
+	"github.com/gruntwork-io/terragrunt/config/hclparse"
+)
+
+const diagnosticSource = "terragrunt"
+
+// publishDiagnostics reads the configuration of the document, as it is saved on disk, and publishes its diagnostics.
+func (server *Server) publishDiagnostics(ctx context.Context, conn *jsonrpc2.Conn, doc *document) error {
+	return conn.Notify(ctx, "textDocument/publishDiagnostics", &lsp.PublishDiagnosticsParams{
+		URI:         doc.uri,
+		Diagnostics: server.diagnostics(ctx, doc),
+	})
+}
+
+func (server *Server) diagnostics(ctx context.Context, doc *document) []lsp.Diagnostic {
+	diags := []lsp.Diagnostic{}
+
+	opts, err := server.unitOptions(doc)
+	if err != nil {
+		return append(diags, errorDiagnostic(err))
+	}
+
+	var hclDiags hcl.Diagnostics
+
+	// As `hclvalidate` does, the diagnostics are collected and parsing continues, to report as many of them as possible.
+	parserOpts := append(config.DefaultParserOptions(opts), hclparse.WithDiagnosticsHandler(func(_ *hcl.File, fileDiags hcl.Diagnostics) (hcl.Diagnostics, error) {
+		hclDiags = append(hclDiags, fileDiags...)
+		return nil, nil
+	}))
+
+	_, err = config.ReadTerragruntConfig(ctx, opts, parserOpts)
+
+	for _, hclDiag := range hclDiags {
+		diags = append(diags, doc.diagnostic(hclDiag))
+	}
+
+	if err != nil && len(hclDiags) == 0 {
+		diags = append(diags, errorDiagnostic(err))
+	}
+
+	return diags
+}
+
+// diagnostic converts the HCL diagnostic. The diagnostics of other files, such as included configurations, are reported
+// at the beginning of the document, with the name of the file.
+func (doc *document) diagnostic(hclDiag *hcl.Diagnostic) lsp.Diagnostic {
+	diag := lsp.Diagnostic{
+		Severity: lsp.Error,
+		Source:   diagnosticSource,
+		Message:  hclDiag.Summary,
+	}
+
+	if hclDiag.Severity == hcl.DiagWarning {
+		diag.Severity = lsp.Warning
+	}
+
+	if hclDiag.Detail != "" {
+		diag.Message += ": " + hclDiag.Detail
+	}
+
+	if hclDiag.Subject == nil {
+		return diag
+	}
+
+	if filepath.Clean(hclDiag.Subject.Filename) != filepath.Clean(doc.path) {
+		diag.Message = hclDiag.Subject.Filename + ": " + diag.Message
+		return diag
+	}
+
+	diag.Range = doc.lspRange(*hclDiag.Subject)
+
+	return diag
+}
+
+func errorDiagnostic(err error) lsp.Diagnostic {
+	return lsp.Diagnostic{
+		Severity: lsp.Error,
+		Source:   diagnosticSource,
+		Message:  err.Error(),
+	}
+}
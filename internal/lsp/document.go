@@ -0,0 +1,104 @@
+package lsp
+
+import (
+	"bytes"
+	"net/url"
+	"path/filepath"
+	"unicode/utf8"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/sourcegraph/go-lsp"
+)
+
+// document is a configuration file opened by the client. Its text is the content of the editor buffer, which may differ
+// from the file on disk until it is saved.
+type document struct {
+	uri  lsp.DocumentURI
+	path string
+	text []byte
+	body *hclsyntax.Body
+}
+
+func newDocument(uri lsp.DocumentURI, text string) *document {
+	doc := &document{
+		uri:  uri,
+		path: uriToPath(uri),
+		text: []byte(text),
+	}
+
+	// The body is also returned for configurations with syntax errors, which is the case most of the time while editing.
+	file, _ := hclsyntax.ParseConfig(doc.text, doc.path, hcl.InitialPos)
+	if body, ok := file.Body.(*hclsyntax.Body); ok {
+		doc.body = body
+	}
+
+	return doc
+}
+
+// offset returns the byte offset of the given position. The characters of LSP positions are counted in UTF-16 code units.
+func (doc *document) offset(pos lsp.Position) int {
+	offset := 0
+
+	for line := 0; line < pos.Line; line++ {
+		next := bytes.IndexByte(doc.text[offset:], '\n')
+		if next < 0 {
+			return len(doc.text)
+		}
+
+		offset += next + 1
+	}
+
+	for units := 0; units < pos.Character && offset < len(doc.text) && doc.text[offset] != '\n'; {
+		r, size := utf8.DecodeRune(doc.text[offset:])
+		offset += size
+		units += utf16Len(r)
+	}
+
+	return offset
+}
+
+// position returns the LSP position of the given byte offset.
+func (doc *document) position(offset int) lsp.Position {
+	offset = min(offset, len(doc.text))
+
+	lineStart := bytes.LastIndexByte(doc.text[:offset], '\n') + 1
+
+	pos := lsp.Position{Line: bytes.Count(doc.text[:lineStart], []byte{'\n'})}
+
+	for _, r := range string(doc.text[lineStart:offset]) {
+		pos.Character += utf16Len(r)
+	}
+
+	return pos
+}
+
+// lspRange returns the LSP range of the given HCL range of the document.
+func (doc *document) lspRange(rng hcl.Range) lsp.Range {
+	return lsp.Range{
+		Start: doc.position(rng.Start.Byte),
+		End:   doc.position(rng.End.Byte),
+	}
+}
+
+func utf16Len(r rune) int {
+	if r >= 0x10000 {
+		return 2
+	}
+
+	return 1
+}
+
+func uriToPath(uri lsp.DocumentURI) string {
+	parsed, err := url.Parse(string(uri))
+	if err != nil || parsed.Scheme != "file" {
+		return string(uri)
+	}
+
+	return filepath.FromSlash(parsed.Path)
+}
+
+func pathToURI(path string) lsp.DocumentURI {
+	uri := url.URL{Scheme: "file", Path: filepath.ToSlash(path)}
+	return lsp.DocumentURI(uri.String())
+}
@@ -0,0 +1,16 @@
+package lsp
+
+import (
+	"fmt"
+
+	"github.com/sourcegraph/go-lsp"
+)
+
+// DocumentNotOpenedError is returned for requests about documents that are not opened by the client.
+type DocumentNotOpenedError struct {
+	URI lsp.DocumentURI
+}
+
+func (err DocumentNotOpenedError) Error() string {
+	return fmt.Sprintf("Document %s is not opened", err.URI)
+}
@@ -0,0 +1,98 @@
+package lsp
+
+import (
+	"context"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/gruntwork-io/terragrunt/config"
+	"github.com/gruntwork-io/terragrunt/config/hclparse"
+	"github.com/gruntwork-io/terragrunt/options"
+)
+
+// unitOptions returns the options to evaluate the configuration of the document.
+func (server *Server) unitOptions(doc *document) (*options.TerragruntOptions, error) {
+	opts, err := server.opts.CloneWithConfigPath(doc.path)
+	if err != nil {
+		return nil, err
+	}
+
+	// The outputs of the dependencies are never read from their state, since it would run `tofu`/`terraform` on each evaluation,
+	// only their `mock_outputs` are used.
+	opts.SkipOutput = true
+	opts.NonInteractive = true
+
+	return opts, nil
+}
+
+// evaluate returns the evaluated configuration of the document, as it is saved on disk. The configurations that cannot
+// be evaluated are also cached, so that they are only evaluated again once a document is saved.
+func (server *Server) evaluate(ctx context.Context, doc *document) (cty.Value, bool) {
+	if val, ok := server.evaluations[doc.path]; ok {
+		return val, val != cty.NilVal
+	}
+
+	opts, err := server.unitOptions(doc)
+	if err != nil {
+		return cty.NilVal, false
+	}
+
+	// The diagnostics are published on save, so they are returned as errors instead of being logged.
+	parserOpts := append(config.DefaultParserOptions(opts), hclparse.WithDiagnosticsHandler(func(_ *hcl.File, diags hcl.Diagnostics) (hcl.Diagnostics, error) {
+		return nil, diags
+	}))
+
+	val, err := config.ParseTerragruntConfig(config.NewParsingContext(ctx, opts).WithParseOption(parserOpts), doc.path, nil)
+	if err != nil {
+		server.opts.Logger.Debugf("Failed to evaluate %s: %v", doc.path, err)
+
+		val = cty.NilVal
+	}
+
+	server.evaluations[doc.path] = val
+
+	return val, val != cty.NilVal
+}
+
+// evalContext returns the context to evaluate the expressions of the document, with the Terragrunt functions and, if the
+// configuration can be evaluated, the `local` and `dependency` variables.
+func (server *Server) evalContext(ctx context.Context, doc *document) (*hcl.EvalContext, error) {
+	opts, err := server.unitOptions(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	evalCtx, err := config.NewEvalContext(config.NewParsingContext(ctx, opts), doc.path)
+	if err != nil {
+		return nil, err
+	}
+
+	val, ok := server.evaluate(ctx, doc)
+	if !ok {
+		return evalCtx, nil
+	}
+
+	variables := map[string]string{
+		config.MetadataLocal:      config.MetadataLocals,
+		config.MetadataDependency: config.MetadataDependency,
+	}
+
+	for name, attr := range variables {
+		if val.Type().IsObjectType() && val.Type().HasAttribute(attr) && !val.GetAttr(attr).IsNull() {
+			evalCtx.Variables[name] = val.GetAttr(attr)
+		}
+	}
+
+	return evalCtx, nil
+}
+
+// evalString evaluates the expression to a string, if it can be evaluated.
+func evalString(expr hcl.Expression, evalCtx *hcl.EvalContext) (string, bool) {
+	val, diags := expr.Value(evalCtx)
+	if diags.HasErrors() || !val.IsWhollyKnown() || val.IsNull() || val.Type() != cty.String {
+		return "", false
+	}
+
+	return val.AsString(), true
+}
@@ -0,0 +1,77 @@
+package lsp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/sourcegraph/go-lsp"
+
+	"github.com/gruntwork-io/terragrunt/config"
+)
+
+// hover returns the evaluated value of the `local.*` or `dependency.*` reference at the position, with its sensitive values
+// redacted. The outputs of the dependencies are their `mock_outputs`, see `unitOptions`.
+func (server *Server) hover(ctx context.Context, params lsp.TextDocumentPositionParams) (*lsp.Hover, error) {
+	doc, err := server.document(params.TextDocument.URI)
+	if err != nil {
+		return nil, err
+	}
+
+	expr := doc.traversalExpr(doc.offset(params.Position))
+	if expr == nil {
+		return nil, nil
+	}
+
+	evalCtx, err := server.evalContext(ctx, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	rng := doc.lspRange(expr.SrcRange)
+	hover := &lsp.Hover{Range: &rng}
+
+	val, diags := expr.Value(evalCtx)
+
+	switch {
+	case diags.HasErrors():
+		hover.Contents = []lsp.MarkedString{lsp.RawMarkedString("The value cannot be evaluated: " + diags.Error())}
+	case !val.IsWhollyKnown():
+		hover.Contents = []lsp.MarkedString{lsp.RawMarkedString("The value is not known until the configuration is applied.")}
+	default:
+		tokens := hclwrite.TokensForValue(config.RedactMarkedValues(val))
+
+		hover.Contents = []lsp.MarkedString{{
+			Language: "hcl",
+			Value:    strings.TrimSpace(string(hclwrite.Format(tokens.Bytes()))),
+		}}
+	}
+
+	return hover, nil
+}
+
+// traversalExpr returns the `local.*` or `dependency.*` reference at the offset.
+func (doc *document) traversalExpr(offset int) *hclsyntax.ScopeTraversalExpr {
+	if doc.body == nil {
+		return nil
+	}
+
+	var expr *hclsyntax.ScopeTraversalExpr
+
+	_ = hclsyntax.VisitAll(doc.body, func(node hclsyntax.Node) hcl.Diagnostics {
+		traversal, ok := node.(*hclsyntax.ScopeTraversalExpr)
+		if !ok || !traversal.SrcRange.ContainsOffset(offset) {
+			return nil
+		}
+
+		if root := traversal.Traversal.RootName(); root == config.MetadataLocal || root == config.MetadataDependency {
+			expr = traversal
+		}
+
+		return nil
+	})
+
+	return expr
+}
@@ -0,0 +1,199 @@
+// Package lsp implements a language server for Terragrunt configurations, speaking the Language Server Protocol.
+package lsp
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+
+	"github.com/sourcegraph/go-lsp"
+	"github.com/sourcegraph/jsonrpc2"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/options"
+)
+
+// Server is a language server for the Terragrunt configurations opened by a client.
+//
+// The requests are handled sequentially, in the order they are received, so the server state is not guarded.
+type Server struct {
+	opts *options.TerragruntOptions
+
+	documents map[lsp.DocumentURI]*document
+
+	// evaluations caches the evaluated configurations by path, they are invalidated when a document is saved.
+	evaluations map[string]cty.Value
+}
+
+func NewServer(opts *options.TerragruntOptions) *Server {
+	return &Server{
+		opts:        opts,
+		documents:   map[lsp.DocumentURI]*document{},
+		evaluations: map[string]cty.Value{},
+	}
+}
+
+// Serve serves the client connected to the given streams, until the client exits or the context is cancelled.
+func (server *Server) Serve(ctx context.Context, in io.Reader, out io.Writer) error {
+	stream := jsonrpc2.NewBufferedStream(&readWriteCloser{in, out}, jsonrpc2.VSCodeObjectCodec{})
+	conn := jsonrpc2.NewConn(ctx, stream, jsonrpc2.HandlerWithError(server.handle))
+
+	select {
+	case <-conn.DisconnectNotify():
+	case <-ctx.Done():
+		return conn.Close()
+	}
+
+	return nil
+}
+
+func (server *Server) handle(ctx context.Context, conn *jsonrpc2.Conn, req *jsonrpc2.Request) (any, error) {
+	switch req.Method {
+	case "initialize":
+		return server.initialize()
+	case "initialized", "$/cancelRequest", "$/setTrace":
+		return nil, nil
+	case "shutdown":
+		return nil, nil
+	case "exit":
+		return nil, conn.Close()
+	case "textDocument/didOpen":
+		var params lsp.DidOpenTextDocumentParams
+		if err := unmarshalParams(req, &params); err != nil {
+			return nil, err
+		}
+
+		doc := newDocument(params.TextDocument.URI, params.TextDocument.Text)
+		server.documents[doc.uri] = doc
+
+		return nil, server.publishDiagnostics(ctx, conn, doc)
+	case "textDocument/didChange":
+		var params lsp.DidChangeTextDocumentParams
+		if err := unmarshalParams(req, &params); err != nil {
+			return nil, err
+		}
+
+		// The server only supports full document synchronization, so the last change is the whole text.
+		if len(params.ContentChanges) > 0 {
+			doc := newDocument(params.TextDocument.URI, params.ContentChanges[len(params.ContentChanges)-1].Text)
+			server.documents[doc.uri] = doc
+		}
+
+		return nil, nil
+	case "textDocument/didSave":
+		var params lsp.DidSaveTextDocumentParams
+		if err := unmarshalParams(req, &params); err != nil {
+			return nil, err
+		}
+
+		// Any configuration may depend on the saved one, through includes or dependencies.
+		clear(server.evaluations)
+
+		if doc, ok := server.documents[params.TextDocument.URI]; ok {
+			return nil, server.publishDiagnostics(ctx, conn, doc)
+		}
+
+		return nil, nil
+	case "textDocument/didClose":
+		var params lsp.DidCloseTextDocumentParams
+		if err := unmarshalParams(req, &params); err != nil {
+			return nil, err
+		}
+
+		delete(server.documents, params.TextDocument.URI)
+
+		return nil, conn.Notify(ctx, "textDocument/publishDiagnostics", &lsp.PublishDiagnosticsParams{
+			URI:         params.TextDocument.URI,
+			Diagnostics: []lsp.Diagnostic{},
+		})
+	case "textDocument/definition":
+		var params lsp.TextDocumentPositionParams
+		if err := unmarshalParams(req, &params); err != nil {
+			return nil, err
+		}
+
+		return server.definition(ctx, params)
+	case "textDocument/hover":
+		var params lsp.TextDocumentPositionParams
+		if err := unmarshalParams(req, &params); err != nil {
+			return nil, err
+		}
+
+		return server.hover(ctx, params)
+	case "textDocument/completion":
+		var params lsp.CompletionParams
+		if err := unmarshalParams(req, &params); err != nil {
+			return nil, err
+		}
+
+		return server.completion(ctx, params)
+	}
+
+	if req.Notif {
+		return nil, nil
+	}
+
+	return nil, &jsonrpc2.Error{Code: jsonrpc2.CodeMethodNotFound, Message: "method not supported: " + req.Method}
+}
+
+func (server *Server) initialize() (*lsp.InitializeResult, error) {
+	return &lsp.InitializeResult{
+		Capabilities: lsp.ServerCapabilities{
+			TextDocumentSync: &lsp.TextDocumentSyncOptionsOrKind{
+				Options: &lsp.TextDocumentSyncOptions{
+					OpenClose: true,
+					Change:    lsp.TDSKFull,
+					Save:      &lsp.SaveOptions{},
+				},
+			},
+			HoverProvider:      true,
+			DefinitionProvider: true,
+			CompletionProvider: &lsp.CompletionOptions{
+				TriggerCharacters: []string{"."},
+			},
+		},
+	}, nil
+}
+
+// document returns the opened document with the given URI.
+func (server *Server) document(uri lsp.DocumentURI) (*document, error) {
+	doc, ok := server.documents[uri]
+	if !ok {
+		return nil, errors.New(DocumentNotOpenedError{URI: uri})
+	}
+
+	return doc, nil
+}
+
+func unmarshalParams(req *jsonrpc2.Request, params any) error {
+	if req.Params == nil {
+		return &jsonrpc2.Error{Code: jsonrpc2.CodeInvalidParams, Message: "missing params"}
+	}
+
+	if err := json.Unmarshal(*req.Params, params); err != nil {
+		return &jsonrpc2.Error{Code: jsonrpc2.CodeInvalidParams, Message: err.Error()}
+	}
+
+	return nil
+}
+
+// readWriteCloser joins the input and output streams of the client connection.
+type readWriteCloser struct {
+	io.Reader
+	io.Writer
+}
+
+func (rwc *readWriteCloser) Close() error {
+	if closer, ok := rwc.Reader.(io.Closer); ok {
+		if err := closer.Close(); err != nil {
+			return err
+		}
+	}
+
+	if closer, ok := rwc.Writer.(io.Closer); ok {
+		return closer.Close()
+	}
+
+	return nil
+}
@@ -0,0 +1,223 @@
+package lsp_test
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sourcegraph/go-lsp"
+	"github.com/sourcegraph/jsonrpc2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gruntwork-io/terragrunt/config"
+	tglsp "github.com/gruntwork-io/terragrunt/internal/lsp"
+	"github.com/gruntwork-io/terragrunt/options"
+)
+
+const testRootConfig = `
+locals {
+  env = "dev"
+}
+`
+
+const testUnitConfig = `include "root" {
+  path = find_in_parent_folders("root.hcl")
+}
+
+locals {
+  name  = "app"
+  token = get_env("MY_TOKEN", "s3cr3t")
+}
+
+terraform {
+}
+
+inputs = {
+  name   = local.name
+  vpc_id = dependency.vpc.outputs.id
+  token  = local.token
+}
+
+dependency "vpc" {
+  config_path  = "../vpc"
+  mock_outputs = { id = "vpc-mock" }
+}
+`
+
+func TestServerHover(t *testing.T) {
+	t.Parallel()
+
+	client, uri := startServer(t)
+
+	var hover lsp.Hover
+	require.NoError(t, client.call("textDocument/hover", positionParams(uri, 13, 14), &hover))
+	require.Len(t, hover.Contents, 1)
+	assert.Equal(t, "hcl", hover.Contents[0].Language)
+	assert.Equal(t, `"app"`, hover.Contents[0].Value)
+	assert.Equal(t, lsp.Range{Start: lsp.Position{Line: 13, Character: 11}, End: lsp.Position{Line: 13, Character: 21}}, *hover.Range)
+
+	// The outputs of the dependencies are their mock outputs.
+	require.NoError(t, client.call("textDocument/hover", positionParams(uri, 14, 14), &hover))
+	require.Len(t, hover.Contents, 1)
+	assert.Equal(t, `"vpc-mock"`, hover.Contents[0].Value)
+
+	// The sensitive values are redacted.
+	require.NoError(t, client.call("textDocument/hover", positionParams(uri, 15, 14), &hover))
+	require.Len(t, hover.Contents, 1)
+	assert.Equal(t, `"`+config.RedactedValue+`"`, hover.Contents[0].Value)
+}
+
+func TestServerDefinition(t *testing.T) {
+	t.Parallel()
+
+	client, uri := startServer(t)
+
+	var locations []lsp.Location
+	require.NoError(t, client.call("textDocument/definition", positionParams(uri, 0, 3), &locations))
+	require.Len(t, locations, 1)
+	assert.Equal(t, "root.hcl", filepath.Base(string(locations[0].URI)))
+
+	locations = nil
+	require.NoError(t, client.call("textDocument/definition", positionParams(uri, 5, 3), &locations))
+	assert.Empty(t, locations)
+}
+
+func TestServerCompletion(t *testing.T) {
+	t.Parallel()
+
+	client, uri := startServer(t)
+
+	testCases := []struct {
+		name     string
+		line     int
+		expected []string
+		missing  []string
+	}{
+		{
+			name:     "top level",
+			line:     8,
+			expected: []string{"terraform", "inputs", "dependency", "find_in_parent_folders"},
+			missing:  []string{"source"},
+		},
+		{
+			name:     "terraform block",
+			line:     10,
+			expected: []string{"source", "before_hook", "find_in_parent_folders"},
+			missing:  []string{"inputs"},
+		},
+	}
+
+	for _, tc := range testCases {
+		var list lsp.CompletionList
+		require.NoError(t, client.call("textDocument/completion", positionParams(uri, tc.line, 0), &list), tc.name)
+
+		labels := make([]string, 0, len(list.Items))
+		for _, item := range list.Items {
+			labels = append(labels, item.Label)
+		}
+
+		for _, label := range tc.expected {
+			assert.Contains(t, labels, label, tc.name)
+		}
+
+		for _, label := range tc.missing {
+			assert.NotContains(t, labels, label, tc.name)
+		}
+	}
+}
+
+func TestServerDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	client, uri := startServer(t)
+
+	params := <-client.diagnostics
+	assert.Equal(t, uri, params.URI)
+	assert.Empty(t, params.Diagnostics)
+
+	path := filepath.Join(filepath.Dir(string(uri)[len("file://"):]), "terragrunt.hcl")
+	require.NoError(t, os.WriteFile(path, []byte("inputs = {\n  name = local.missing\n}\n"), 0644))
+	require.NoError(t, client.conn.Notify(context.Background(), "textDocument/didSave", &lsp.DidSaveTextDocumentParams{
+		TextDocument: lsp.TextDocumentIdentifier{URI: uri},
+	}))
+
+	params = <-client.diagnostics
+	require.NotEmpty(t, params.Diagnostics)
+	assert.Equal(t, lsp.Error, params.Diagnostics[0].Severity)
+	assert.Equal(t, 1, params.Diagnostics[0].Range.Start.Line)
+}
+
+type testClient struct {
+	conn        *jsonrpc2.Conn
+	diagnostics chan lsp.PublishDiagnosticsParams
+}
+
+func (client *testClient) call(method string, params, result any) error {
+	return client.conn.Call(context.Background(), method, params, result)
+}
+
+// startServer starts a server with an opened unit including a root configuration.
+func startServer(t *testing.T) (*testClient, lsp.DocumentURI) {
+	t.Helper()
+
+	dir := t.TempDir()
+	unitPath := filepath.Join(dir, "app", "terragrunt.hcl")
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "root.hcl"), []byte(testRootConfig), 0644))
+	require.NoError(t, os.MkdirAll(filepath.Dir(unitPath), 0755))
+	require.NoError(t, os.WriteFile(unitPath, []byte(testUnitConfig), 0644))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "vpc"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "vpc", "terragrunt.hcl"), []byte(""), 0644))
+
+	opts, err := options.NewTerragruntOptionsForTest(unitPath)
+	require.NoError(t, err)
+
+	opts.WorkingDir = filepath.Dir(unitPath)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	serverConn, clientConn := net.Pipe()
+
+	go tglsp.NewServer(opts).Serve(ctx, serverConn, serverConn) //nolint:errcheck
+
+	client := &testClient{diagnostics: make(chan lsp.PublishDiagnosticsParams, 10)}
+	client.conn = jsonrpc2.NewConn(ctx, jsonrpc2.NewBufferedStream(clientConn, jsonrpc2.VSCodeObjectCodec{}), jsonrpc2.HandlerWithError(
+		func(_ context.Context, _ *jsonrpc2.Conn, req *jsonrpc2.Request) (any, error) {
+			if req.Method == "textDocument/publishDiagnostics" && req.Params != nil {
+				var params lsp.PublishDiagnosticsParams
+				if err := json.Unmarshal(*req.Params, &params); err != nil {
+					return nil, err
+				}
+
+				client.diagnostics <- params
+			}
+
+			return nil, nil
+		}))
+
+	t.Cleanup(func() {
+		client.conn.Close()
+		cancel()
+	})
+
+	var result lsp.InitializeResult
+	require.NoError(t, client.call("initialize", &lsp.InitializeParams{}, &result))
+	assert.True(t, result.Capabilities.HoverProvider)
+
+	uri := lsp.DocumentURI("file://" + filepath.ToSlash(unitPath))
+	require.NoError(t, client.conn.Notify(ctx, "textDocument/didOpen", &lsp.DidOpenTextDocumentParams{
+		TextDocument: lsp.TextDocumentItem{URI: uri, LanguageID: "terragrunt", Text: testUnitConfig},
+	}))
+
+	return client, uri
+}
+
+func positionParams(uri lsp.DocumentURI, line, character int) *lsp.TextDocumentPositionParams {
+	return &lsp.TextDocumentPositionParams{
+		TextDocument: lsp.TextDocumentIdentifier{URI: uri},
+		Position:     lsp.Position{Line: line, Character: character},
+	}
+}
@@ -26,6 +26,7 @@ type Cmd struct {
 	usePTY bool
 
 	forwardSignalDelay time.Duration
+	killDelay          time.Duration
 	interruptSignal    os.Signal
 }
 
@@ -61,7 +62,19 @@ func (cmd *Cmd) Start() error {
 		if err := runCommandWithPTY(cmd.logger, cmd.Cmd); err != nil {
 			return err
 		}
-	} else if err := cmd.Cmd.Start(); err != nil {
+
+		return nil
+	}
+
+	if cmd.killDelay > 0 {
+		// The command runs in its own process group, so that the processes it started are killed along with it,
+		// and do not keep its output open.
+		setProcessGroup(cmd.Cmd)
+
+		cmd.WaitDelay = cmd.killDelay
+	}
+
+	if err := cmd.Cmd.Start(); err != nil {
 		return errors.New(err)
 	}
 
@@ -75,6 +88,7 @@ func (cmd *Cmd) Start() error {
 //     Thus we will send the signal to the executed command with a delay or immediately if Terragrunt receives this same signal again.
 //  2. If the context does not contain any causes, this means that there was some failure and we need to terminate all executed commands,
 //     in this situation we are sure that commands did not receive any signal, so we send them an interrupt signal immediately.
+//     If the kill delay is set, the command is killed if it is still running after this delay, since it may ignore the interrupt signal.
 func (cmd *Cmd) RegisterGracefullyShutdown(ctx context.Context) func() {
 	ctxShutdown, cancelShutdown := context.WithCancel(context.Background())
 
@@ -89,6 +103,16 @@ func (cmd *Cmd) RegisterGracefullyShutdown(ctx context.Context) func() {
 			}
 
 			cmd.SendSignal(cmd.interruptSignal)
+
+			if cmd.killDelay <= 0 {
+				return
+			}
+
+			select {
+			case <-ctxShutdown.Done():
+			case <-time.After(cmd.killDelay):
+				cmd.Kill()
+			}
 		}
 	}()
 
@@ -131,3 +155,12 @@ func (cmd *Cmd) SendSignal(sig os.Signal) {
 		cmd.logger.Errorf("Failed to forwarding signal %s to %s: %v", sig, cmd.filename, err)
 	}
 }
+
+// Kill kills the executed command, along with the processes it started if it runs in its own process group.
+func (cmd *Cmd) Kill() {
+	cmd.logger.Debugf("%s is still running %s after the interrupt signal, killing it", cmd.filename, cmd.killDelay)
+
+	if err := killProcess(cmd.Cmd); err != nil {
+		cmd.logger.Errorf("Failed to kill %s: %v", cmd.filename, err)
+	}
+}
@@ -4,6 +4,8 @@
 package exec_test
 
 import (
+	"bytes"
+	"context"
 	"errors"
 	"os"
 	"strconv"
@@ -112,3 +114,30 @@ func TestNewSignalsForwarderMultipleUnix(t *testing.T) {
 	assert.LessOrEqual(t, retCode, interrupts, "Subprocess received wrong number of signals")
 	assert.Equal(t, expectedInterrupts, retCode, "Subprocess didn't receive multiple signals")
 }
+
+func TestKillDelayUnix(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	var stdout bytes.Buffer
+
+	cmd := exec.Command("testdata/test_sigint_ignore.sh")
+	cmd.Stdout = &stdout
+	cmd.Configure(exec.WithKillDelay(500 * time.Millisecond))
+
+	start := time.Now()
+
+	require.NoError(t, cmd.Start())
+
+	cancelShutdown := cmd.RegisterGracefullyShutdown(ctx)
+	defer cancelShutdown()
+
+	err := cmd.Wait()
+	require.Error(t, err)
+
+	// The script and its `sleep` child are killed, instead of running for 30 seconds.
+	assert.Less(t, time.Since(start), 5*time.Second)
+	assert.Empty(t, stdout.String())
+}
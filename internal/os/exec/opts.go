@@ -39,3 +39,11 @@ func WithForwardSignalDelay(delay time.Duration) Option {
 		cmd.forwardSignalDelay = delay
 	}
 }
+
+// WithKillDelay sets the delay after which the Cmd is killed, along with the processes it started, if it is still
+// running once interrupted because its context is done, such as when its timeout is exceeded.
+func WithKillDelay(delay time.Duration) Option {
+	return func(cmd *Cmd) {
+		cmd.killDelay = delay
+	}
+}
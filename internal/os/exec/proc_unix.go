@@ -0,0 +1,38 @@
+//go:build !windows
+// +build !windows
+
+package exec
+
+import (
+	"os"
+	"os/exec"
+	"syscall"
+
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+)
+
+// setProcessGroup makes the command run in a new process group, whose ID is the PID of the command.
+func setProcessGroup(cmd *exec.Cmd) {
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+
+	cmd.SysProcAttr.Setpgid = true
+}
+
+// killProcess kills the command, and the whole process group if the command runs in its own.
+func killProcess(cmd *exec.Cmd) error {
+	if cmd.SysProcAttr != nil && cmd.SysProcAttr.Setpgid {
+		if err := syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL); err != nil && !errors.Is(err, syscall.ESRCH) {
+			return errors.New(err)
+		}
+
+		return nil
+	}
+
+	if err := cmd.Process.Kill(); err != nil && !errors.Is(err, os.ErrProcessDone) {
+		return errors.New(err)
+	}
+
+	return nil
+}
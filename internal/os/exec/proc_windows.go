@@ -0,0 +1,23 @@
+//go:build windows
+// +build windows
+
+package exec
+
+import (
+	"os"
+	"os/exec"
+
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+)
+
+// setProcessGroup does nothing on Windows, where the processes started by the command are not killed with it.
+func setProcessGroup(_ *exec.Cmd) {}
+
+// killProcess kills the command.
+func killProcess(cmd *exec.Cmd) error {
+	if err := cmd.Process.Kill(); err != nil && !errors.Is(err, os.ErrProcessDone) {
+		return errors.New(err)
+	}
+
+	return nil
+}
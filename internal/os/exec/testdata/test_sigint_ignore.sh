@@ -0,0 +1,8 @@
+#!/bin/bash
+
+# ignores the interrupt and termination signals, and keeps running in a child process
+trap '' INT TERM
+
+sleep 30
+
+echo "not killed"
@@ -13,3 +13,6 @@ var InterruptSignal = syscall.SIGINT //nolint:gochecknoglobals
 
 // InterruptSignals contains a list of signals that are treated as interrupts.
 var InterruptSignals = []os.Signal{syscall.SIGTERM, syscall.SIGINT} //nolint:gochecknoglobals
+
+// ReloadSignals contains a list of signals that are treated as a request to reload the configuration.
+var ReloadSignals = []os.Signal{syscall.SIGHUP} //nolint:gochecknoglobals
@@ -12,3 +12,6 @@ var InterruptSignal os.Signal = nil
 
 // InterruptSignals contains a list of signals that are treated as interrupts.
 var InterruptSignals []os.Signal = []os.Signal{}
+
+// ReloadSignals contains a list of signals that are treated as a request to reload the configuration.
+var ReloadSignals []os.Signal = []os.Signal{}
@@ -0,0 +1,141 @@
+package policy
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+	"github.com/gruntwork-io/terragrunt/config/hclparse"
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+)
+
+const (
+	celConfigVarName = "config"
+	celUnitVarName   = "unit"
+)
+
+// Rule is a policy declared with a `rule` block, whose condition is a CEL expression.
+type Rule struct {
+	Name        string  `hcl:"name,label"`
+	Condition   string  `hcl:"condition,attr"`
+	Description *string `hcl:"description,optional"`
+	Severity    *string `hcl:"severity,optional"`
+	Attribute   *string `hcl:"attribute,optional"`
+
+	filename string
+	program  cel.Program
+}
+
+type rulesFile struct {
+	Rules []*Rule `hcl:"rule,block"`
+}
+
+// loadRules loads the `rule` blocks from the given file and compiles their conditions.
+func loadRules(filename string) ([]*Rule, error) {
+	file, err := hclparse.NewParser().ParseFromFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var content rulesFile
+	if err := file.Decode(&content, nil); err != nil {
+		return nil, err
+	}
+
+	env, err := celEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rule := range content.Rules {
+		rule.filename = filename
+
+		if err := rule.compile(env); err != nil {
+			return nil, err
+		}
+	}
+
+	return content.Rules, nil
+}
+
+// celEnv returns the CEL environment of the rule conditions. Only the standard CEL functions and macros are available, with
+// the `config` and `unit` variables.
+func celEnv() (*cel.Env, error) {
+	env, err := cel.NewEnv(
+		cel.Variable(celConfigVarName, cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable(celUnitVarName, cel.MapType(cel.StringType, cel.DynType)),
+	)
+	if err != nil {
+		return nil, errors.New(err)
+	}
+
+	return env, nil
+}
+
+func (rule *Rule) compile(env *cel.Env) error {
+	if rule.Severity != nil && *rule.Severity != SeverityError && *rule.Severity != SeverityWarning {
+		return errors.New(InvalidRuleError{Rule: rule.Name, Filename: rule.filename, Reason: fmt.Sprintf("severity must be %q or %q, got %q", SeverityError, SeverityWarning, *rule.Severity)})
+	}
+
+	ast, issues := env.Compile(rule.Condition)
+	if issues != nil && issues.Err() != nil {
+		return errors.New(InvalidRuleError{Rule: rule.Name, Filename: rule.filename, Reason: issues.Err().Error()})
+	}
+
+	if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+		return errors.New(InvalidRuleError{Rule: rule.Name, Filename: rule.filename, Reason: "condition must be a bool expression, got " + ast.OutputType().String()})
+	}
+
+	program, err := env.Program(ast)
+	if err != nil {
+		return errors.New(InvalidRuleError{Rule: rule.Name, Filename: rule.filename, Reason: err.Error()})
+	}
+
+	rule.program = program
+
+	return nil
+}
+
+// Evaluate evaluates the condition of the rule against the given input, and returns a violation if it is false.
+func (rule *Rule) Evaluate(input *Input) (*Violation, error) {
+	out, _, err := rule.program.Eval(map[string]any{
+		celConfigVarName: input.Config,
+		celUnitVarName: map[string]any{
+			"path":        input.Unit.Path,
+			"config_path": input.Unit.ConfigPath,
+			"functions":   input.Unit.Functions,
+		},
+	})
+	if err != nil {
+		return nil, errors.New(RuleEvaluationError{Rule: rule.Name, Unit: input.Unit.Path, Err: err})
+	}
+
+	pass, ok := out.Value().(bool)
+	if !ok {
+		return nil, errors.New(RuleEvaluationError{Rule: rule.Name, Unit: input.Unit.Path, Err: fmt.Errorf("condition returned %v instead of a bool", out.Value())})
+	}
+
+	if pass {
+		return nil, nil
+	}
+
+	violation := &Violation{
+		Policy:   rule.Name,
+		Message:  fmt.Sprintf("The condition %q is not met.", strings.TrimSpace(rule.Condition)),
+		Severity: SeverityError,
+	}
+
+	if rule.Description != nil {
+		violation.Message = *rule.Description
+	}
+
+	if rule.Severity != nil {
+		violation.Severity = *rule.Severity
+	}
+
+	if rule.Attribute != nil {
+		violation.Attribute = *rule.Attribute
+	}
+
+	return violation, nil
+}
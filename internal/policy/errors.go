@@ -0,0 +1,61 @@
+package policy
+
+import "fmt"
+
+// PolicyDirNotFoundError is returned when the policy directory does not exist.
+type PolicyDirNotFoundError struct {
+	Dir string
+}
+
+func (err PolicyDirNotFoundError) Error() string {
+	return fmt.Sprintf("Policy directory %s does not exist", err.Dir)
+}
+
+// NoPoliciesFoundError is returned when the policy directory contains no policy.
+type NoPoliciesFoundError struct {
+	Dir string
+}
+
+func (err NoPoliciesFoundError) Error() string {
+	return fmt.Sprintf("No policies found in %s, expected .hcl files with rule blocks or .rego files", err.Dir)
+}
+
+// InvalidRuleError is returned when a rule cannot be compiled.
+type InvalidRuleError struct {
+	Rule     string
+	Filename string
+	Reason   string
+}
+
+func (err InvalidRuleError) Error() string {
+	return fmt.Sprintf("Invalid rule %s in %s: %s", err.Rule, err.Filename, err.Reason)
+}
+
+// RuleEvaluationError is returned when the condition of a rule cannot be evaluated.
+type RuleEvaluationError struct {
+	Err  error
+	Rule string
+	Unit string
+}
+
+func (err RuleEvaluationError) Error() string {
+	return fmt.Sprintf("Error evaluating rule %s for unit %s: %v", err.Rule, err.Unit, err.Err)
+}
+
+func (err RuleEvaluationError) Unwrap() error {
+	return err.Err
+}
+
+// RegoEvaluationError is returned when the Rego policies cannot be evaluated.
+type RegoEvaluationError struct {
+	Err  error
+	Unit string
+}
+
+func (err RegoEvaluationError) Error() string {
+	return fmt.Sprintf("Error evaluating Rego policies with %s for unit %s: %v", opaBinary, err.Unit, err.Err)
+}
+
+func (err RegoEvaluationError) Unwrap() error {
+	return err.Err
+}
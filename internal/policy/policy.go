@@ -0,0 +1,134 @@
+// Package policy evaluates policies against the rendered configuration of units.
+//
+// Policies are loaded from a directory, which can contain:
+//   - HCL files with `rule` blocks, whose `condition` is a CEL expression that must be true for the unit to comply.
+//   - Rego files, evaluated with the `opa` binary, whose `data.terragrunt.deny` and `data.terragrunt.warn` rules
+//     produce the violations.
+package policy
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/util"
+)
+
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+
+	hclFileExt  = ".hcl"
+	regoFileExt = ".rego"
+)
+
+// Input is the document the policies are evaluated against.
+type Input struct {
+	// Config is the configuration of the unit, as rendered by `render-json`.
+	Config map[string]any `json:"config"`
+	// Unit describes the unit the configuration belongs to.
+	Unit Unit `json:"unit"`
+}
+
+// Unit describes a unit under evaluation.
+type Unit struct {
+	// Path is the path of the unit directory, relative to the working directory.
+	Path string `json:"path"`
+	// ConfigPath is the absolute path of the unit configuration.
+	ConfigPath string `json:"config_path"`
+	// Functions are the names of the functions called in the configuration of the unit and the configurations it includes.
+	Functions []string `json:"functions"`
+}
+
+// Violation is a policy the unit does not comply with.
+type Violation struct {
+	Policy   string
+	Message  string
+	Severity string
+	// Attribute is the name of the top level attribute or block of the unit configuration that caused the violation, if known.
+	Attribute string
+}
+
+// IsError returns true if the violation should fail the check.
+func (violation *Violation) IsError() bool {
+	return violation.Severity != SeverityWarning
+}
+
+// Policies is a set of policies loaded from a directory.
+type Policies struct {
+	dir   string
+	rules []*Rule
+	rego  bool
+}
+
+// Load loads the policies from the given directory.
+func Load(dir string) (*Policies, error) {
+	if !util.IsDir(dir) {
+		return nil, errors.New(PolicyDirNotFoundError{Dir: dir})
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, errors.New(err)
+	}
+
+	policies := &Policies{dir: dir}
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		filename := filepath.Join(dir, entry.Name())
+
+		switch filepath.Ext(filename) {
+		case hclFileExt:
+			rules, err := loadRules(filename)
+			if err != nil {
+				return nil, err
+			}
+
+			policies.rules = append(policies.rules, rules...)
+		case regoFileExt:
+			policies.rego = true
+		}
+	}
+
+	if len(policies.rules) == 0 && !policies.rego {
+		return nil, errors.New(NoPoliciesFoundError{Dir: dir})
+	}
+
+	return policies, nil
+}
+
+// Evaluate evaluates all the policies against the given input, and returns the violations sorted by policy name.
+func (policies *Policies) Evaluate(ctx context.Context, opts *options.TerragruntOptions, input *Input) ([]*Violation, error) {
+	var violations []*Violation
+
+	for _, rule := range policies.rules {
+		violation, err := rule.Evaluate(input)
+		if err != nil {
+			return nil, err
+		}
+
+		if violation != nil {
+			violations = append(violations, violation)
+		}
+	}
+
+	if policies.rego {
+		regoViolations, err := evaluateRego(ctx, opts, policies.dir, input)
+		if err != nil {
+			return nil, err
+		}
+
+		violations = append(violations, regoViolations...)
+	}
+
+	sort.SliceStable(violations, func(i, j int) bool { return violations[i].Policy < violations[j].Policy })
+
+	return violations, nil
+}
@@ -0,0 +1,206 @@
+package policy_test
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/internal/policy"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writePolicies(t *testing.T, content string) string {
+	t.Helper()
+
+	return writePolicyFile(t, "rules.hcl", content)
+}
+
+func writePolicyFile(t *testing.T, filename, content string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, filename), []byte(content), 0644))
+
+	return dir
+}
+
+func TestEvaluateRules(t *testing.T) {
+	t.Parallel()
+
+	dir := writePolicies(t, `
+rule "prod_prevent_destroy" {
+  description = "Units in prod must set prevent_destroy."
+  condition   = "!unit.path.startsWith('prod/') || (has(config.prevent_destroy) && config.prevent_destroy == true)"
+  attribute   = "prevent_destroy"
+}
+
+rule "no_run_cmd" {
+  severity  = "warning"
+  condition = "!('run_cmd' in unit.functions)"
+}
+`)
+
+	policies, err := policy.Load(dir)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		input    *policy.Input
+		expected []*policy.Violation
+	}{
+		{
+			input: &policy.Input{
+				Config: map[string]any{"prevent_destroy": true},
+				Unit:   policy.Unit{Path: "prod/app", Functions: []string{"find_in_parent_folders"}},
+			},
+		},
+		{
+			input: &policy.Input{
+				Config: map[string]any{"prevent_destroy": false},
+				Unit:   policy.Unit{Path: "dev/app"},
+			},
+		},
+		{
+			input: &policy.Input{
+				Config: map[string]any{},
+				Unit:   policy.Unit{Path: "prod/app", Functions: []string{"run_cmd"}},
+			},
+			expected: []*policy.Violation{
+				{
+					Policy:   "no_run_cmd",
+					Message:  `The condition "!('run_cmd' in unit.functions)" is not met.`,
+					Severity: policy.SeverityWarning,
+				},
+				{
+					Policy:    "prod_prevent_destroy",
+					Message:   "Units in prod must set prevent_destroy.",
+					Severity:  policy.SeverityError,
+					Attribute: "prevent_destroy",
+				},
+			},
+		},
+	}
+
+	opts, err := options.NewTerragruntOptionsForTest("")
+	require.NoError(t, err)
+
+	for i, tc := range testCases {
+		violations, err := policies.Evaluate(context.Background(), opts, tc.input)
+		require.NoError(t, err, "case %d", i)
+		assert.Equal(t, tc.expected, violations, "case %d", i)
+	}
+}
+
+func TestEvaluateRego(t *testing.T) {
+	t.Parallel()
+
+	if _, err := exec.LookPath("opa"); err != nil {
+		t.Skip("opa binary not found in PATH")
+	}
+
+	dir := writePolicyFile(t, "policy.rego", `
+package terragrunt
+
+import rego.v1
+
+deny contains {"msg": "Units in prod must set prevent_destroy.", "attribute": "prevent_destroy"} if {
+	startswith(input.unit.path, "prod/")
+	not input.config.prevent_destroy
+}
+
+warn contains "run_cmd must not be used in configurations." if {
+	"run_cmd" in input.unit.functions
+}
+`)
+
+	policies, err := policy.Load(dir)
+	require.NoError(t, err)
+
+	opts, err := options.NewTerragruntOptionsForTest("")
+	require.NoError(t, err)
+
+	violations, err := policies.Evaluate(context.Background(), opts, &policy.Input{
+		Config: map[string]any{"prevent_destroy": true},
+		Unit:   policy.Unit{Path: "prod/app"},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, violations)
+
+	violations, err = policies.Evaluate(context.Background(), opts, &policy.Input{
+		Config: map[string]any{},
+		Unit:   policy.Unit{Path: "prod/app", Functions: []string{"run_cmd"}},
+	})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []*policy.Violation{
+		{
+			Policy:    "terragrunt.deny",
+			Message:   "Units in prod must set prevent_destroy.",
+			Severity:  policy.SeverityError,
+			Attribute: "prevent_destroy",
+		},
+		{
+			Policy:   "terragrunt.warn",
+			Message:  "run_cmd must not be used in configurations.",
+			Severity: policy.SeverityWarning,
+		},
+	}, violations)
+}
+
+func TestEvaluateRuleMissingKey(t *testing.T) {
+	t.Parallel()
+
+	dir := writePolicies(t, `
+rule "encryption" {
+  condition = "config.remote_state.config.encrypt == true"
+}
+`)
+
+	policies, err := policy.Load(dir)
+	require.NoError(t, err)
+
+	opts, err := options.NewTerragruntOptionsForTest("")
+	require.NoError(t, err)
+
+	_, err = policies.Evaluate(context.Background(), opts, &policy.Input{Config: map[string]any{}, Unit: policy.Unit{Path: "app"}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Error evaluating rule encryption for unit app")
+}
+
+func TestLoadInvalidRules(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		content  string
+		expected string
+	}{
+		{
+			content: `
+rule "bad_severity" {
+  condition = "true"
+  severity  = "fatal"
+}`,
+			expected: `severity must be "error" or "warning", got "fatal"`,
+		},
+		{
+			content:  `rule "not_bool" { condition = "size(unit.functions) + 1" }`,
+			expected: "condition must be a bool expression",
+		},
+		{
+			content:  `rule "syntax" { condition = "unit.path ==" }`,
+			expected: "Invalid rule syntax",
+		},
+		{
+			content:  ``,
+			expected: "No policies found",
+		},
+	}
+
+	for _, tc := range testCases {
+		_, err := policy.Load(writePolicies(t, tc.content))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), tc.expected)
+	}
+}
@@ -0,0 +1,115 @@
+package policy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/shell"
+)
+
+const (
+	opaBinary = "opa"
+
+	regoDenyRuleName = "deny"
+	regoWarnRuleName = "warn"
+
+	// regoQuery returns the `deny` and `warn` rules of the `terragrunt` package, defaulting to empty sets if they are not declared.
+	regoQuery = `{"deny": object.get(data, ["terragrunt", "deny"], []), "warn": object.get(data, ["terragrunt", "warn"], [])}`
+)
+
+// regoResult is the output of `opa eval --format json`.
+type regoResult struct {
+	Result []struct {
+		Expressions []struct {
+			Value map[string][]any `json:"value"`
+		} `json:"expressions"`
+	} `json:"result"`
+}
+
+// evaluateRego evaluates the Rego policies of the given directory with the `opa` binary. The rules can produce either messages, or objects
+// with the `msg` and optionally `attribute` keys.
+func evaluateRego(ctx context.Context, opts *options.TerragruntOptions, dir string, input *Input) ([]*Violation, error) {
+	inputFile, err := writeInputFile(input)
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(inputFile) //nolint:errcheck
+
+	evalOpts := opts.Clone()
+	evalOpts.Writer = io.Discard
+	evalOpts.ErrWriter = io.Discard
+
+	output, err := shell.RunCommandWithOutput(ctx, evalOpts, "", true, false, opaBinary, "eval", "--format", "json", "--input", inputFile, "--data", dir, regoQuery)
+	if err != nil {
+		return nil, errors.New(RegoEvaluationError{Unit: input.Unit.Path, Err: err})
+	}
+
+	var result regoResult
+	if err := json.Unmarshal(output.Stdout.Bytes(), &result); err != nil {
+		return nil, errors.New(RegoEvaluationError{Unit: input.Unit.Path, Err: err})
+	}
+
+	var violations []*Violation
+
+	for _, res := range result.Result {
+		for _, expr := range res.Expressions {
+			for _, ruleName := range []string{regoDenyRuleName, regoWarnRuleName} {
+				severity := SeverityError
+				if ruleName == regoWarnRuleName {
+					severity = SeverityWarning
+				}
+
+				for _, val := range expr.Value[ruleName] {
+					violations = append(violations, regoViolation(ruleName, severity, val))
+				}
+			}
+		}
+	}
+
+	return violations, nil
+}
+
+func writeInputFile(input *Input) (string, error) {
+	file, err := os.CreateTemp("", "terragrunt-policy-input-*.json")
+	if err != nil {
+		return "", errors.New(err)
+	}
+	defer file.Close() //nolint:errcheck
+
+	if err := json.NewEncoder(file).Encode(input); err != nil {
+		return "", errors.New(err)
+	}
+
+	return file.Name(), nil
+}
+
+func regoViolation(ruleName, severity string, val any) *Violation {
+	violation := &Violation{
+		Policy:   "terragrunt." + ruleName,
+		Severity: severity,
+	}
+
+	switch val := val.(type) {
+	case string:
+		violation.Message = val
+	case map[string]any:
+		if msg, ok := val["msg"].(string); ok {
+			violation.Message = msg
+		}
+
+		if attribute, ok := val["attribute"].(string); ok {
+			violation.Attribute = attribute
+		}
+	}
+
+	if violation.Message == "" {
+		violation.Message = fmt.Sprintf("%v", val)
+	}
+
+	return violation
+}
@@ -0,0 +1,118 @@
+package secrets
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/ssm"
+
+	"github.com/gruntwork-io/terragrunt/awshelper"
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/options"
+)
+
+const (
+	awsParamRegion       = "region"
+	awsParamEndpoint     = "endpoint"
+	awsParamVersionStage = "version_stage"
+	awsParamVersionID    = "version_id"
+)
+
+// awsSecretsManagerProvider reads the secrets of AWS Secrets Manager, referenced by their name or ARN.
+type awsSecretsManagerProvider struct{}
+
+func (provider *awsSecretsManagerProvider) Params() []string {
+	return []string{awsParamRegion, awsParamEndpoint, awsParamVersionStage, awsParamVersionID}
+}
+
+func (provider *awsSecretsManagerProvider) Secret(ctx context.Context, opts *options.TerragruntOptions, ref string, params map[string]string) (string, error) {
+	sess, cfg, err := awsSession(opts, params)
+	if err != nil {
+		return "", err
+	}
+
+	input := &secretsmanager.GetSecretValueInput{SecretId: aws.String(ref)}
+
+	if stage, ok := params[awsParamVersionStage]; ok {
+		input.VersionStage = aws.String(stage)
+	}
+
+	if id, ok := params[awsParamVersionID]; ok {
+		input.VersionId = aws.String(id)
+	}
+
+	output, err := secretsmanager.New(sess, cfg).GetSecretValueWithContext(ctx, input)
+	if err != nil {
+		if isAWSNotFound(err, secretsmanager.ErrCodeResourceNotFoundException) {
+			return "", errors.New(SecretNotFoundError{Provider: ProviderAWSSecretsManager, Ref: ref})
+		}
+
+		return "", errors.New(err)
+	}
+
+	if output.SecretString != nil {
+		return aws.StringValue(output.SecretString), nil
+	}
+
+	return string(output.SecretBinary), nil
+}
+
+// awsSSMProvider reads the parameters of AWS Systems Manager Parameter Store, decrypting the secure strings.
+type awsSSMProvider struct{}
+
+func (provider *awsSSMProvider) Params() []string {
+	return []string{awsParamRegion, awsParamEndpoint}
+}
+
+func (provider *awsSSMProvider) Secret(ctx context.Context, opts *options.TerragruntOptions, ref string, params map[string]string) (string, error) {
+	sess, cfg, err := awsSession(opts, params)
+	if err != nil {
+		return "", err
+	}
+
+	output, err := ssm.New(sess, cfg).GetParameterWithContext(ctx, &ssm.GetParameterInput{
+		Name:           aws.String(ref),
+		WithDecryption: aws.Bool(true),
+	})
+	if err != nil {
+		if isAWSNotFound(err, ssm.ErrCodeParameterNotFound) {
+			return "", errors.New(SecretNotFoundError{Provider: ProviderAWSSSM, Ref: ref})
+		}
+
+		return "", errors.New(err)
+	}
+
+	return aws.StringValue(output.Parameter.Value), nil
+}
+
+// awsSession returns the session with the credentials of Terragrunt, and the config of the clients, with the endpoint
+// passed in the parameters, such as the endpoint of a local stand-in for AWS.
+func awsSession(opts *options.TerragruntOptions, params map[string]string) (*session.Session, *aws.Config, error) {
+	var sessionConfig *awshelper.AwsSessionConfig
+
+	if region, ok := params[awsParamRegion]; ok {
+		sessionConfig = &awshelper.AwsSessionConfig{Region: region}
+	}
+
+	sess, err := awshelper.CreateAwsSession(sessionConfig, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cfg := aws.NewConfig()
+
+	if endpoint, ok := params[awsParamEndpoint]; ok {
+		cfg = cfg.WithEndpoint(endpoint)
+	}
+
+	return sess, cfg, nil
+}
+
+func isAWSNotFound(err error, code string) bool {
+	var awsErr awserr.Error
+
+	return errors.As(err, &awsErr) && awsErr.Code() == code
+}
@@ -0,0 +1,24 @@
+package secrets
+
+import (
+	"context"
+
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/options"
+)
+
+// envProvider reads the secrets from the environment variables, referenced by their name.
+type envProvider struct{}
+
+func (provider *envProvider) Params() []string {
+	return nil
+}
+
+func (provider *envProvider) Secret(_ context.Context, opts *options.TerragruntOptions, ref string, _ map[string]string) (string, error) {
+	secret, ok := opts.Env[ref]
+	if !ok {
+		return "", errors.New(SecretNotFoundError{Provider: ProviderEnv, Ref: ref})
+	}
+
+	return secret, nil
+}
@@ -0,0 +1,86 @@
+package secrets
+
+import (
+	"fmt"
+	"strings"
+)
+
+// UnknownProviderError is returned when no provider is registered with the name passed to `get_secret`.
+type UnknownProviderError struct {
+	Name string
+}
+
+func (err UnknownProviderError) Error() string {
+	return fmt.Sprintf("Unknown secret provider %q", err.Name)
+}
+
+// EmptyRefError is returned when the reference to the secret is empty.
+type EmptyRefError struct {
+	Provider string
+}
+
+func (err EmptyRefError) Error() string {
+	return fmt.Sprintf("The reference to the %s secret is empty", err.Provider)
+}
+
+// UnknownParamError is returned when a parameter is not accepted by the provider.
+type UnknownParamError struct {
+	Provider string
+	Name     string
+	Params   []string
+}
+
+func (err UnknownParamError) Error() string {
+	return fmt.Sprintf("Unknown parameter %q of the %s secret provider, the accepted parameters are: %s", err.Name, err.Provider, strings.Join(err.Params, ", "))
+}
+
+// SecretNotFoundError is returned when the provider has no secret with the given reference.
+type SecretNotFoundError struct {
+	Provider string
+	Ref      string
+}
+
+func (err SecretNotFoundError) Error() string {
+	return fmt.Sprintf("Secret %q not found by the %s secret provider", err.Ref, err.Provider)
+}
+
+// SecretNotStructuredError is returned when a key is passed for a secret that is not a JSON or YAML document.
+type SecretNotStructuredError struct {
+	Provider string
+	Ref      string
+}
+
+func (err SecretNotStructuredError) Error() string {
+	return fmt.Sprintf("Secret %q of the %s secret provider is not a JSON or YAML document, so its keys cannot be selected", err.Ref, err.Provider)
+}
+
+// SecretKeyNotFoundError is returned when the secret has no field at the given key.
+type SecretKeyNotFoundError struct {
+	Provider string
+	Ref      string
+	Key      string
+}
+
+func (err SecretKeyNotFoundError) Error() string {
+	return fmt.Sprintf("Key %q not found in the secret %q of the %s secret provider", err.Key, err.Ref, err.Provider)
+}
+
+// VaultRequestError is returned when Vault responds with an error.
+type VaultRequestError struct {
+	Ref        string
+	StatusCode int
+	Errors     []string
+}
+
+func (err VaultRequestError) Error() string {
+	return fmt.Sprintf("Failed to read the secret %q from Vault, status %d: %s", err.Ref, err.StatusCode, strings.Join(err.Errors, ", "))
+}
+
+// NoAgeIdentityError is returned when no identity is available to decrypt an age encrypted file.
+type NoAgeIdentityError struct {
+	Ref string
+}
+
+func (err NoAgeIdentityError) Error() string {
+	return fmt.Sprintf("No age identity to decrypt %s, set the identity_file parameter, or the SOPS_AGE_KEY or SOPS_AGE_KEY_FILE environment variable", err.Ref)
+}
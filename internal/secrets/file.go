@@ -0,0 +1,116 @@
+package secrets
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"filippo.io/age"
+	"filippo.io/age/armor"
+
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/util"
+)
+
+const ageParamIdentityFile = "identity_file"
+
+// ageProvider decrypts the files encrypted with age, referenced by their path relative to the configuration. The
+// identities are read from the file passed in the `identity_file` parameter, or from the environment variables used by
+// sops for age: `SOPS_AGE_KEY` and `SOPS_AGE_KEY_FILE`.
+type ageProvider struct{}
+
+func (provider *ageProvider) Params() []string {
+	return []string{ageParamIdentityFile}
+}
+
+func (provider *ageProvider) Secret(_ context.Context, opts *options.TerragruntOptions, ref string, params map[string]string) (string, error) {
+	_, data, err := readSecretFile(opts, ProviderAge, ref)
+	if err != nil {
+		return "", err
+	}
+
+	identities, err := ageIdentities(opts, ref, params)
+	if err != nil {
+		return "", err
+	}
+
+	var src io.Reader = bytes.NewReader(data)
+
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte(armor.Header)) {
+		src = armor.NewReader(bytes.NewReader(bytes.TrimSpace(data)))
+	}
+
+	cleartext, err := age.Decrypt(src, identities...)
+	if err != nil {
+		return "", errors.New(err)
+	}
+
+	secret, err := io.ReadAll(cleartext)
+	if err != nil {
+		return "", errors.New(err)
+	}
+
+	return string(secret), nil
+}
+
+func ageIdentities(opts *options.TerragruntOptions, ref string, params map[string]string) ([]age.Identity, error) {
+	var keys string
+
+	switch identityFile := params[ageParamIdentityFile]; {
+	case identityFile != "":
+		if !filepath.IsAbs(identityFile) {
+			identityFile = filepath.Join(filepath.Dir(opts.TerragruntConfigPath), identityFile)
+		}
+
+		content, err := os.ReadFile(identityFile)
+		if err != nil {
+			return nil, errors.New(err)
+		}
+
+		keys = string(content)
+	case opts.Env["SOPS_AGE_KEY"] != "":
+		keys = opts.Env["SOPS_AGE_KEY"]
+	case opts.Env["SOPS_AGE_KEY_FILE"] != "":
+		content, err := os.ReadFile(opts.Env["SOPS_AGE_KEY_FILE"])
+		if err != nil {
+			return nil, errors.New(err)
+		}
+
+		keys = string(content)
+	default:
+		return nil, errors.New(NoAgeIdentityError{Ref: ref})
+	}
+
+	identities, err := age.ParseIdentities(bufio.NewReader(strings.NewReader(keys)))
+	if err != nil {
+		return nil, errors.New(err)
+	}
+
+	return identities, nil
+}
+
+// readSecretFile reads the file referenced by ref, relative to the directory of the configuration.
+func readSecretFile(opts *options.TerragruntOptions, providerName, ref string) (string, []byte, error) {
+	path, err := util.CanonicalPath(ref, filepath.Dir(opts.TerragruntConfigPath))
+	if err != nil {
+		return "", nil, errors.New(err)
+	}
+
+	if !util.FileExists(path) {
+		return "", nil, errors.New(SecretNotFoundError{Provider: providerName, Ref: ref})
+	}
+
+	opts.AppendReadFile(path, opts.WorkingDir)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", nil, errors.New(err)
+	}
+
+	return path, data, nil
+}
@@ -0,0 +1,146 @@
+// Package secrets resolves the secrets referenced by the `get_secret` function from their providers, such as AWS
+// Secrets Manager or HashiCorp Vault.
+//
+// A secret is referenced by the name of its provider and a reference whose meaning depends on the provider: the name
+// of the secret for AWS Secrets Manager, the path of the file for sops. The optional parameters configure the provider,
+// and the `key` parameter selects a field of a secret that is a JSON or YAML document.
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/options"
+)
+
+const (
+	ProviderAWSSecretsManager = "aws-secretsmanager"
+	ProviderAWSSSM            = "aws-ssm"
+	ProviderVault             = "vault"
+	ProviderEnv               = "env"
+	ProviderSops              = "sops"
+	ProviderAge               = "age"
+
+	// ParamKey is the parameter accepted by all the providers, that selects a field of the secret.
+	ParamKey = "key"
+)
+
+// Provider fetches the secrets of a secret store.
+type Provider interface {
+	// Params returns the names of the parameters accepted by the provider.
+	Params() []string
+
+	// Secret returns the secret referenced by ref.
+	Secret(ctx context.Context, opts *options.TerragruntOptions, ref string, params map[string]string) (string, error)
+}
+
+var (
+	providersMu sync.RWMutex
+	providers   = map[string]Provider{
+		ProviderAWSSecretsManager: &awsSecretsManagerProvider{},
+		ProviderAWSSSM:            &awsSSMProvider{},
+		ProviderVault:             &vaultProvider{},
+		ProviderEnv:               &envProvider{},
+		ProviderAge:               &ageProvider{},
+		// The `sops` provider is registered by the config package, which decrypts the files with `sops_decrypt_file`.
+	}
+)
+
+// Register registers the provider with the given name, replacing the provider previously registered with this name.
+func Register(name string, provider Provider) {
+	providersMu.Lock()
+	defer providersMu.Unlock()
+
+	providers[name] = provider
+}
+
+// Resolve returns the secret referenced by ref from the named provider, or the field of the secret selected by the
+// `key` parameter.
+func Resolve(ctx context.Context, opts *options.TerragruntOptions, providerName, ref string, params map[string]string) (string, error) {
+	providersMu.RLock()
+	provider, ok := providers[providerName]
+	providersMu.RUnlock()
+
+	if !ok {
+		return "", errors.New(UnknownProviderError{Name: providerName})
+	}
+
+	if ref == "" {
+		return "", errors.New(EmptyRefError{Provider: providerName})
+	}
+
+	providerParams := provider.Params()
+
+	for name := range params {
+		if name != ParamKey && !slices.Contains(providerParams, name) {
+			return "", errors.New(UnknownParamError{Provider: providerName, Name: name, Params: append([]string{ParamKey}, providerParams...)})
+		}
+	}
+
+	secret, err := provider.Secret(ctx, opts, ref, params)
+	if err != nil {
+		return "", err
+	}
+
+	if key, ok := params[ParamKey]; ok {
+		return secretField(secret, providerName, ref, key)
+	}
+
+	return secret, nil
+}
+
+// secretField returns the field of a JSON or YAML secret at the dotted path key, such as `database.password` or
+// `users.0.name`. A field that is not a string is returned as JSON.
+func secretField(secret, providerName, ref, key string) (string, error) {
+	var doc any
+
+	if err := yaml.Unmarshal([]byte(secret), &doc); err != nil {
+		return "", errors.New(SecretNotStructuredError{Provider: providerName, Ref: ref})
+	}
+
+	field := doc
+
+	for _, name := range strings.Split(key, ".") {
+		switch node := field.(type) {
+		case map[string]any:
+			val, ok := node[name]
+			if !ok {
+				return "", errors.New(SecretKeyNotFoundError{Provider: providerName, Ref: ref, Key: key})
+			}
+
+			field = val
+		case []any:
+			idx, err := strconv.Atoi(name)
+			if err != nil || idx < 0 || idx >= len(node) {
+				return "", errors.New(SecretKeyNotFoundError{Provider: providerName, Ref: ref, Key: key})
+			}
+
+			field = node[idx]
+		default:
+			return "", errors.New(SecretKeyNotFoundError{Provider: providerName, Ref: ref, Key: key})
+		}
+	}
+
+	return stringify(field)
+}
+
+// stringify returns the field of a secret as a string, the fields that are not strings are returned as JSON.
+func stringify(field any) (string, error) {
+	if str, ok := field.(string); ok {
+		return str, nil
+	}
+
+	content, err := json.Marshal(field)
+	if err != nil {
+		return "", errors.New(err)
+	}
+
+	return string(content), nil
+}
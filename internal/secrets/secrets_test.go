@@ -0,0 +1,240 @@
+package secrets_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"filippo.io/age"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gruntwork-io/terragrunt/internal/secrets"
+	"github.com/gruntwork-io/terragrunt/options"
+)
+
+func testOptions(t *testing.T, configDir string, env map[string]string) *options.TerragruntOptions {
+	t.Helper()
+
+	opts, err := options.NewTerragruntOptionsForTest(filepath.Join(configDir, "terragrunt.hcl"))
+	require.NoError(t, err)
+
+	opts.Env = env
+
+	return opts
+}
+
+func TestResolveEnv(t *testing.T) {
+	t.Parallel()
+
+	opts := testOptions(t, t.TempDir(), map[string]string{
+		"DB_PASSWORD": "hunter2",
+		"DB_CONFIG":   `{"user": "admin", "hosts": ["a", "b"], "port": 5432}`,
+	})
+
+	testCases := []struct {
+		expectedErr error
+		params      map[string]string
+		name        string
+		ref         string
+		expected    string
+	}{
+		{name: "plain", ref: "DB_PASSWORD", expected: "hunter2"},
+		{name: "key", ref: "DB_CONFIG", params: map[string]string{"key": "user"}, expected: "admin"},
+		{name: "list index", ref: "DB_CONFIG", params: map[string]string{"key": "hosts.1"}, expected: "b"},
+		{name: "non string field", ref: "DB_CONFIG", params: map[string]string{"key": "hosts"}, expected: `["a","b"]`},
+		{
+			name:        "missing key",
+			ref:         "DB_CONFIG",
+			params:      map[string]string{"key": "password"},
+			expectedErr: secrets.SecretKeyNotFoundError{Provider: secrets.ProviderEnv, Ref: "DB_CONFIG", Key: "password"},
+		},
+		{name: "missing secret", ref: "MISSING", expectedErr: secrets.SecretNotFoundError{Provider: secrets.ProviderEnv, Ref: "MISSING"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			secret, err := secrets.Resolve(context.Background(), opts, secrets.ProviderEnv, tc.ref, tc.params)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, secret)
+		})
+	}
+}
+
+func TestResolveInvalidArgs(t *testing.T) {
+	t.Parallel()
+
+	opts := testOptions(t, t.TempDir(), nil)
+
+	_, err := secrets.Resolve(context.Background(), opts, "keepass", "db", nil)
+	require.ErrorIs(t, err, secrets.UnknownProviderError{Name: "keepass"})
+
+	_, err = secrets.Resolve(context.Background(), opts, secrets.ProviderEnv, "", nil)
+	require.ErrorIs(t, err, secrets.EmptyRefError{Provider: secrets.ProviderEnv})
+
+	var paramErr secrets.UnknownParamError
+
+	_, err = secrets.Resolve(context.Background(), opts, secrets.ProviderEnv, "DB_PASSWORD", map[string]string{"region": "us-east-1"})
+	require.ErrorAs(t, err, &paramErr)
+	assert.Equal(t, "region", paramErr.Name)
+	assert.Equal(t, []string{"key"}, paramErr.Params)
+}
+
+func TestResolveVault(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "s.token" {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"errors": ["permission denied"]}`)) //nolint:errcheck
+
+			return
+		}
+
+		switch r.URL.Path {
+		case "/v1/secret/data/app/db":
+			w.Write([]byte(`{"data": {"data": {"password": "hunter2"}, "metadata": {"version": 3}}}`)) //nolint:errcheck
+		case "/v1/kv/app/db":
+			w.Write([]byte(`{"data": {"password": "hunter1"}}`)) //nolint:errcheck
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"errors": []}`)) //nolint:errcheck
+		}
+	}))
+	defer server.Close()
+
+	opts := testOptions(t, t.TempDir(), map[string]string{"VAULT_ADDR": server.URL, "VAULT_TOKEN": "s.token"})
+
+	secret, err := secrets.Resolve(context.Background(), opts, secrets.ProviderVault, "secret/app/db", map[string]string{"key": "password"})
+	require.NoError(t, err)
+	assert.Equal(t, "hunter2", secret)
+
+	secret, err = secrets.Resolve(context.Background(), opts, secrets.ProviderVault, "app/db", map[string]string{"key": "password", "mount": "kv", "kv_version": "1"})
+	require.NoError(t, err)
+	assert.Equal(t, "hunter1", secret)
+
+	_, err = secrets.Resolve(context.Background(), opts, secrets.ProviderVault, "secret/app/missing", nil)
+	require.ErrorIs(t, err, secrets.SecretNotFoundError{Provider: secrets.ProviderVault, Ref: "secret/app/missing"})
+
+	opts = testOptions(t, t.TempDir(), map[string]string{"VAULT_ADDR": server.URL, "VAULT_TOKEN": "s.other"})
+
+	var requestErr secrets.VaultRequestError
+
+	_, err = secrets.Resolve(context.Background(), opts, secrets.ProviderVault, "secret/app/db", nil)
+	require.ErrorAs(t, err, &requestErr)
+	assert.Equal(t, http.StatusForbidden, requestErr.StatusCode)
+	assert.Equal(t, []string{"permission denied"}, requestErr.Errors)
+}
+
+func TestResolveAWS(t *testing.T) {
+	t.Parallel()
+
+	// A stand-in for the JSON APIs of AWS Secrets Manager and Systems Manager.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var input map[string]any
+
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&input))
+
+		var output any
+
+		switch target := r.Header.Get("X-Amz-Target"); {
+		case target == "secretsmanager.GetSecretValue" && input["SecretId"] == "prod/db":
+			output = map[string]any{"Name": "prod/db", "SecretString": `{"password": "hunter2"}`}
+		case target == "AmazonSSM.GetParameter" && input["Name"] == "/prod/db/password" && input["WithDecryption"] == true:
+			output = map[string]any{"Parameter": map[string]any{"Name": "/prod/db/password", "Value": "hunter3"}}
+		case target == "secretsmanager.GetSecretValue":
+			w.WriteHeader(http.StatusBadRequest)
+			output = map[string]any{"__type": "ResourceNotFoundException", "message": "not found"}
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			output = map[string]any{"__type": "ParameterNotFound", "message": "not found"}
+		}
+
+		require.NoError(t, json.NewEncoder(w).Encode(output))
+	}))
+	defer server.Close()
+
+	opts := testOptions(t, t.TempDir(), map[string]string{"AWS_ACCESS_KEY_ID": "test", "AWS_SECRET_ACCESS_KEY": "test"})
+	params := map[string]string{"region": "us-east-1", "endpoint": server.URL}
+
+	secret, err := secrets.Resolve(context.Background(), opts, secrets.ProviderAWSSecretsManager, "prod/db", map[string]string{"region": "us-east-1", "endpoint": server.URL, "key": "password"})
+	require.NoError(t, err)
+	assert.Equal(t, "hunter2", secret)
+
+	_, err = secrets.Resolve(context.Background(), opts, secrets.ProviderAWSSecretsManager, "prod/missing", params)
+	require.ErrorIs(t, err, secrets.SecretNotFoundError{Provider: secrets.ProviderAWSSecretsManager, Ref: "prod/missing"})
+
+	secret, err = secrets.Resolve(context.Background(), opts, secrets.ProviderAWSSSM, "/prod/db/password", params)
+	require.NoError(t, err)
+	assert.Equal(t, "hunter3", secret)
+
+	_, err = secrets.Resolve(context.Background(), opts, secrets.ProviderAWSSSM, "/prod/missing", params)
+	require.ErrorIs(t, err, secrets.SecretNotFoundError{Provider: secrets.ProviderAWSSSM, Ref: "/prod/missing"})
+}
+
+func TestResolveAge(t *testing.T) {
+	t.Parallel()
+
+	identity, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+
+	configDir := t.TempDir()
+
+	var encrypted bytes.Buffer
+
+	writer, err := age.Encrypt(&encrypted, identity.Recipient())
+	require.NoError(t, err)
+	_, err = writer.Write([]byte("db_password: hunter2\n"))
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+	require.NoError(t, os.WriteFile(filepath.Join(configDir, "secrets.yaml.age"), encrypted.Bytes(), 0600))
+
+	opts := testOptions(t, configDir, map[string]string{"SOPS_AGE_KEY": identity.String()})
+
+	secret, err := secrets.Resolve(context.Background(), opts, secrets.ProviderAge, "secrets.yaml.age", map[string]string{"key": "db_password"})
+	require.NoError(t, err)
+	assert.Equal(t, "hunter2", secret)
+
+	require.NoError(t, os.WriteFile(filepath.Join(configDir, "key.txt"), []byte(identity.String()+"\n"), 0600))
+
+	secret, err = secrets.Resolve(context.Background(), testOptions(t, configDir, nil), secrets.ProviderAge, "secrets.yaml.age", map[string]string{"identity_file": "key.txt"})
+	require.NoError(t, err)
+	assert.Equal(t, "db_password: hunter2\n", secret)
+
+	_, err = secrets.Resolve(context.Background(), testOptions(t, configDir, nil), secrets.ProviderAge, "secrets.yaml.age", nil)
+	require.ErrorIs(t, err, secrets.NoAgeIdentityError{Ref: "secrets.yaml.age"})
+}
+
+func TestResolveVaultTimeout(t *testing.T) {
+	t.Parallel()
+
+	done := make(chan struct{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		<-done
+	}))
+	defer server.Close()
+	defer close(done)
+
+	opts := testOptions(t, t.TempDir(), map[string]string{"VAULT_ADDR": server.URL, "VAULT_TOKEN": "s.token", "VAULT_CLIENT_TIMEOUT": "100ms"})
+
+	start := time.Now()
+
+	_, err := secrets.Resolve(context.Background(), opts, secrets.ProviderVault, "secret/app/db", nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Client.Timeout exceeded")
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
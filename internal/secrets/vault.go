@@ -0,0 +1,170 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/options"
+)
+
+const (
+	vaultParamAddress   = "address"
+	vaultParamNamespace = "namespace"
+	vaultParamMount     = "mount"
+	vaultParamKVVersion = "kv_version"
+	vaultParamVersion   = "version"
+
+	vaultDefaultAddress = "https://127.0.0.1:8200"
+	vaultTokenFile      = ".vault-token"
+	vaultKVVersion1     = "1"
+
+	// vaultDefaultClientTimeout bounds the requests to Vault, unless `VAULT_CLIENT_TIMEOUT` is set, as the Vault CLI does.
+	vaultDefaultClientTimeout = 60 * time.Second
+)
+
+// vaultProvider reads the secrets of a HashiCorp Vault KV secrets engine, referenced by their path including the mount,
+// such as `secret/app/db`. The address and the token are read from the `VAULT_ADDR` and `VAULT_TOKEN` environment
+// variables, or from `~/.vault-token` for the token, as the Vault CLI does.
+type vaultProvider struct{}
+
+func (provider *vaultProvider) Params() []string {
+	return []string{vaultParamAddress, vaultParamNamespace, vaultParamMount, vaultParamKVVersion, vaultParamVersion}
+}
+
+func (provider *vaultProvider) Secret(ctx context.Context, opts *options.TerragruntOptions, ref string, params map[string]string) (string, error) {
+	address := params[vaultParamAddress]
+	if address == "" {
+		address = opts.Env["VAULT_ADDR"]
+	}
+
+	if address == "" {
+		address = vaultDefaultAddress
+	}
+
+	mount, path, ok := strings.Cut(strings.Trim(ref, "/"), "/")
+	if mountParam, hasMount := params[vaultParamMount]; hasMount {
+		mount, path, ok = strings.Trim(mountParam, "/"), strings.Trim(ref, "/"), true
+	}
+
+	if !ok {
+		return "", errors.New(SecretNotFoundError{Provider: ProviderVault, Ref: ref})
+	}
+
+	reqURL := strings.TrimSuffix(address, "/") + "/v1/" + mount + "/data/" + path
+	if params[vaultParamKVVersion] == vaultKVVersion1 {
+		reqURL = strings.TrimSuffix(address, "/") + "/v1/" + mount + "/" + path
+	} else if version, ok := params[vaultParamVersion]; ok {
+		reqURL += "?version=" + url.QueryEscape(version)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return "", errors.New(err)
+	}
+
+	token, err := vaultToken(opts)
+	if err != nil {
+		return "", err
+	}
+
+	req.Header.Set("X-Vault-Token", token)
+
+	namespace := params[vaultParamNamespace]
+	if namespace == "" {
+		namespace = opts.Env["VAULT_NAMESPACE"]
+	}
+
+	if namespace != "" {
+		req.Header.Set("X-Vault-Namespace", namespace)
+	}
+
+	client := &http.Client{Timeout: vaultClientTimeout(opts)}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", errors.New(err)
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode == http.StatusNotFound {
+		return "", errors.New(SecretNotFoundError{Provider: ProviderVault, Ref: ref})
+	}
+
+	var body struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []string        `json:"errors"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", errors.New(err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.New(VaultRequestError{Ref: ref, StatusCode: resp.StatusCode, Errors: body.Errors})
+	}
+
+	data := body.Data
+
+	// The version 2 of the KV secrets engine wraps the secret with its metadata.
+	if params[vaultParamKVVersion] != vaultKVVersion1 {
+		var versioned struct {
+			Data json.RawMessage `json:"data"`
+		}
+
+		if err := json.Unmarshal(data, &versioned); err != nil {
+			return "", errors.New(err)
+		}
+
+		data = versioned.Data
+	}
+
+	return string(data), nil
+}
+
+// vaultClientTimeout returns the timeout of the requests to Vault, set in `VAULT_CLIENT_TIMEOUT` as a duration such as
+// `30s`, or as a number of seconds.
+func vaultClientTimeout(opts *options.TerragruntOptions) time.Duration {
+	value := opts.Env["VAULT_CLIENT_TIMEOUT"]
+	if value == "" {
+		return vaultDefaultClientTimeout
+	}
+
+	if timeout, err := time.ParseDuration(value); err == nil && timeout > 0 {
+		return timeout
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+
+	opts.Logger.Warnf("Invalid VAULT_CLIENT_TIMEOUT %q, using the default timeout of %s", value, vaultDefaultClientTimeout)
+
+	return vaultDefaultClientTimeout
+}
+
+func vaultToken(opts *options.TerragruntOptions) (string, error) {
+	if token := opts.Env["VAULT_TOKEN"]; token != "" {
+		return token, nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", errors.New(err)
+	}
+
+	token, err := os.ReadFile(filepath.Join(home, vaultTokenFile))
+	if err != nil && !os.IsNotExist(err) {
+		return "", errors.New(err)
+	}
+
+	return strings.TrimSpace(string(token)), nil
+}
@@ -24,6 +24,8 @@ type Diagnostic struct {
 	Detail   string             `json:"detail"`
 	Range    *Range             `json:"range,omitempty"`
 	Snippet  *Snippet           `json:"snippet,omitempty"`
+	// Rule is the name of the rule that produced the diagnostic, if any, such as a lint rule.
+	Rule string `json:"rule,omitempty"`
 }
 
 func NewDiagnostic(file *hcl.File, hclDiag *hcl.Diagnostic) *Diagnostic {
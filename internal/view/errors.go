@@ -0,0 +1,14 @@
+package view
+
+import (
+	"fmt"
+	"strings"
+)
+
+type UnknownFormatError struct {
+	Format string
+}
+
+func (err UnknownFormatError) Error() string {
+	return fmt.Sprintf("Unknown output format %q, expected one of: %s", err.Format, strings.Join(Formats(), ", "))
+}
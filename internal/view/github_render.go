@@ -0,0 +1,81 @@
+package view
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gruntwork-io/terragrunt/internal/view/diagnostic"
+)
+
+const githubLevelNotice = "notice"
+
+// GitHubRender renders diagnostics as GitHub Actions workflow commands, which GitHub shows as annotations of the files of a pull request.
+// See https://docs.github.com/en/actions/writing-workflows/choosing-what-your-workflow-does/workflow-commands-for-github-actions
+type GitHubRender struct {
+	baseDir string
+}
+
+// NewGitHubRender returns a GitHub render, the paths of the diagnostics are made relative to `baseDir`, which must be the root of the repository.
+func NewGitHubRender(baseDir string) Render {
+	return &GitHubRender{baseDir: baseDir}
+}
+
+func (render *GitHubRender) Diagnostics(diags diagnostic.Diagnostics) (string, error) {
+	var buf strings.Builder
+
+	for _, diag := range diags {
+		var params []string
+
+		if diag.Range != nil {
+			params = append(params,
+				"file="+githubEscapeProperty(relPath(render.baseDir, diag.Range.Filename)),
+				fmt.Sprintf("line=%d", diag.Range.Start.Line),
+				fmt.Sprintf("col=%d", diag.Range.Start.Column),
+				fmt.Sprintf("endLine=%d", diag.Range.End.Line),
+				fmt.Sprintf("endColumn=%d", diag.Range.End.Column),
+			)
+		}
+
+		params = append(params, "title="+githubEscapeProperty(diag.Summary))
+
+		message := diag.Detail
+		if message == "" {
+			message = diag.Summary
+		}
+
+		fmt.Fprintf(&buf, "::%s %s::%s\n", githubLevel(diag.Severity), strings.Join(params, ","), githubEscapeData(message))
+	}
+
+	return buf.String(), nil
+}
+
+// ShowConfigPath renders the paths the same way as the human render, there is no workflow command for a list of files.
+func (render *GitHubRender) ShowConfigPath(filenames []string) (string, error) {
+	var buf strings.Builder
+
+	for _, filename := range filenames {
+		buf.WriteString(filename)
+		buf.WriteByte('\n')
+	}
+
+	return buf.String(), nil
+}
+
+func githubLevel(severity diagnostic.DiagnosticSeverity) string {
+	switch severity.String() {
+	case diagnostic.DiagnosticSeverityError, diagnostic.DiagnosticSeverityWarning:
+		return severity.String()
+	default:
+		return githubLevelNotice
+	}
+}
+
+// githubEscapeData escapes the message of a workflow command.
+func githubEscapeData(val string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A").Replace(val)
+}
+
+// githubEscapeProperty escapes the value of a parameter of a workflow command.
+func githubEscapeProperty(val string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A", ":", "%3A", ",", "%2C").Replace(val)
+}
@@ -0,0 +1,97 @@
+package view_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gruntwork-io/terragrunt/internal/view"
+	"github.com/gruntwork-io/terragrunt/internal/view/diagnostic"
+)
+
+func TestGitHubRenderDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	baseDir := filepath.FromSlash("/repo")
+
+	testCases := []struct {
+		name     string
+		diag     *diagnostic.Diagnostic
+		expected string
+	}{
+		{
+			name: "error with range",
+			diag: &diagnostic.Diagnostic{
+				Severity: diagnostic.DiagnosticSeverity(hcl.DiagError),
+				Summary:  "Unused local",
+				Detail:   "The local is never used.",
+				Range: &diagnostic.Range{
+					Filename: filepath.Join(baseDir, "units", "app", "terragrunt.hcl"),
+					Start:    diagnostic.Pos{Line: 2, Column: 3},
+					End:      diagnostic.Pos{Line: 4, Column: 5},
+				},
+			},
+			expected: "::error file=units/app/terragrunt.hcl,line=2,col=3,endLine=4,endColumn=5,title=Unused local::The local is never used.\n",
+		},
+		{
+			name: "warning without detail",
+			diag: &diagnostic.Diagnostic{
+				Severity: diagnostic.DiagnosticSeverity(hcl.DiagWarning),
+				Summary:  "Deprecated attribute",
+			},
+			expected: "::warning title=Deprecated attribute::Deprecated attribute\n",
+		},
+		{
+			name: "unknown severity",
+			diag: &diagnostic.Diagnostic{
+				Severity: diagnostic.DiagnosticSeverity(hcl.DiagInvalid),
+				Summary:  "Note",
+			},
+			expected: "::notice title=Note::Note\n",
+		},
+		{
+			name: "escaped property",
+			diag: &diagnostic.Diagnostic{
+				Severity: diagnostic.DiagnosticSeverity(hcl.DiagError),
+				Summary:  "100% failed: a, b\r\nnext",
+				Detail:   "detail",
+			},
+			expected: "::error title=100%25 failed%3A a%2C b%0D%0Anext::detail\n",
+		},
+		{
+			name: "escaped data",
+			diag: &diagnostic.Diagnostic{
+				Severity: diagnostic.DiagnosticSeverity(hcl.DiagError),
+				Summary:  "summary",
+				Detail:   "100% failed: a, b\r\nnext",
+			},
+			expected: "::error title=summary::100%25 failed: a, b%0D%0Anext\n",
+		},
+		{
+			name: "escaped file outside the base dir",
+			diag: &diagnostic.Diagnostic{
+				Severity: diagnostic.DiagnosticSeverity(hcl.DiagError),
+				Summary:  "summary",
+				Range: &diagnostic.Range{
+					Filename: filepath.FromSlash("/other/a,b%/terragrunt.hcl"),
+					Start:    diagnostic.Pos{Line: 1, Column: 1},
+					End:      diagnostic.Pos{Line: 1, Column: 2},
+				},
+			},
+			expected: "::error file=" + filepath.ToSlash(filepath.FromSlash("/other/a%2Cb%25/terragrunt.hcl")) + ",line=1,col=1,endLine=1,endColumn=2,title=summary::summary\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			output, err := view.NewGitHubRender(baseDir).Diagnostics(diagnostic.Diagnostics{tc.diag})
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, output)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package view
+
+import (
+	"context"
+	"sort"
+
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/shell"
+)
+
+const (
+	HumanFormat  = "human"
+	JSONFormat   = "json"
+	SARIFFormat  = "sarif"
+	GitHubFormat = "github"
+
+	githubWorkspaceEnvName = "GITHUB_WORKSPACE"
+)
+
+// RenderOptions are the options passed to the renders, each render uses the ones it needs.
+type RenderOptions struct {
+	// BaseDir is the directory the paths of the diagnostics are made relative to, by the renders that need relative paths.
+	BaseDir string
+	// DisableColors disables the colors of the human render.
+	DisableColors bool
+}
+
+// RepoRootDir returns the directory the paths of the diagnostics are made relative to by the SARIF and GitHub renders, whose consumers
+// expect paths relative to the root of the repository: the GitHub Actions workspace if set, otherwise the top-level directory of
+// the git repository of the working dir, or the working dir itself outside of a git repository.
+func RepoRootDir(ctx context.Context, opts *options.TerragruntOptions) string {
+	if workspace := opts.Env[githubWorkspaceEnvName]; workspace != "" {
+		return workspace
+	}
+
+	topLevelDir, err := shell.GitTopLevelDir(ctx, opts, opts.WorkingDir)
+	if err != nil {
+		opts.Logger.Debugf("Unable to find the git repository of %s, paths are made relative to the working dir: %v", opts.WorkingDir, err)
+
+		return opts.WorkingDir
+	}
+
+	return topLevelDir
+}
+
+// NewRenderFunc returns a new render.
+type NewRenderFunc func(opts *RenderOptions) Render
+
+// renders are the renders available to the commands, by format.
+var renders = map[string]NewRenderFunc{
+	HumanFormat: func(opts *RenderOptions) Render {
+		return NewHumanRender(opts.DisableColors)
+	},
+	JSONFormat: func(_ *RenderOptions) Render {
+		return NewJSONRender()
+	},
+	SARIFFormat: func(opts *RenderOptions) Render {
+		return NewSARIFRender(opts.BaseDir)
+	},
+	GitHubFormat: func(opts *RenderOptions) Render {
+		return NewGitHubRender(opts.BaseDir)
+	},
+}
+
+// Formats returns the names of the available formats, sorted.
+func Formats() []string {
+	formats := make([]string, 0, len(renders))
+
+	for format := range renders {
+		formats = append(formats, format)
+	}
+
+	sort.Strings(formats)
+
+	return formats
+}
+
+// NewRender returns the render of the given format.
+func NewRender(format string, opts *RenderOptions) (Render, error) {
+	newRender, ok := renders[format]
+	if !ok {
+		return nil, errors.New(UnknownFormatError{Format: format})
+	}
+
+	return newRender(opts), nil
+}
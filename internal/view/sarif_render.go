@@ -0,0 +1,184 @@
+package view
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/internal/view/diagnostic"
+)
+
+const (
+	sarifVersion   = "2.1.0"
+	sarifSchema    = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifToolName  = "terragrunt"
+	sarifToolURI   = "https://terragrunt.gruntwork.io"
+	sarifLevelNote = "note"
+
+	// sarifDefaultRuleID is the rule of the diagnostics that were not produced by a named rule, such as HCL parsing errors.
+	sarifDefaultRuleID = "hcl"
+)
+
+// SARIFRender renders diagnostics in the SARIF format, which is understood by code scanning tools such as GitHub code scanning.
+type SARIFRender struct {
+	baseDir string
+}
+
+// NewSARIFRender returns a SARIF render, the paths of the diagnostics are made relative to `baseDir`, usually the root of the repository.
+func NewSARIFRender(baseDir string) Render {
+	return &SARIFRender{baseDir: baseDir}
+}
+
+type sarifLog struct {
+	Version string     `json:"version"`
+	Schema  string     `json:"$schema"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID string `json:"id"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations,omitempty"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn"`
+	EndLine     int `json:"endLine"`
+	EndColumn   int `json:"endColumn"`
+}
+
+func (render *SARIFRender) Diagnostics(diags diagnostic.Diagnostics) (string, error) {
+	run := sarifRun{
+		Tool: sarifTool{
+			Driver: sarifDriver{
+				Name:           sarifToolName,
+				InformationURI: sarifToolURI,
+				Rules:          []sarifRule{},
+			},
+		},
+		Results: []sarifResult{},
+	}
+
+	var ruleIDs []string
+
+	for _, diag := range diags {
+		result := sarifResult{
+			RuleID:  diag.Rule,
+			Level:   sarifLevel(diag.Severity),
+			Message: sarifMessage{Text: diag.Summary},
+		}
+
+		if result.RuleID == "" {
+			result.RuleID = sarifDefaultRuleID
+		}
+
+		if diag.Detail != "" {
+			result.Message.Text += "\n\n" + diag.Detail
+		}
+
+		if diag.Range != nil {
+			result.Locations = []sarifLocation{render.location(diag.Range)}
+		}
+
+		if !slices.Contains(ruleIDs, result.RuleID) {
+			ruleIDs = append(ruleIDs, result.RuleID)
+		}
+
+		run.Results = append(run.Results, result)
+	}
+
+	slices.Sort(ruleIDs)
+
+	for _, id := range ruleIDs {
+		run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, sarifRule{ID: id})
+	}
+
+	return render.toJSON(sarifLog{
+		Version: sarifVersion,
+		Schema:  sarifSchema,
+		Runs:    []sarifRun{run},
+	})
+}
+
+// ShowConfigPath renders the paths the same way as the human render, SARIF has no representation for a list of files.
+func (render *SARIFRender) ShowConfigPath(filenames []string) (string, error) {
+	var buf strings.Builder
+
+	for _, filename := range filenames {
+		buf.WriteString(filename)
+		buf.WriteByte('\n')
+	}
+
+	return buf.String(), nil
+}
+
+func (render *SARIFRender) location(rng *diagnostic.Range) sarifLocation {
+	return sarifLocation{
+		PhysicalLocation: sarifPhysicalLocation{
+			ArtifactLocation: sarifArtifactLocation{URI: relPath(render.baseDir, rng.Filename)},
+			Region: &sarifRegion{
+				StartLine:   rng.Start.Line,
+				StartColumn: rng.Start.Column,
+				EndLine:     rng.End.Line,
+				EndColumn:   rng.End.Column,
+			},
+		},
+	}
+}
+
+func (render *SARIFRender) toJSON(val any) (string, error) {
+	jsonBytes, err := json.MarshalIndent(val, "", "  ")
+	if err != nil {
+		return "", errors.New(err)
+	}
+
+	return string(jsonBytes) + "\n", nil
+}
+
+func sarifLevel(severity diagnostic.DiagnosticSeverity) string {
+	switch severity.String() {
+	case diagnostic.DiagnosticSeverityError, diagnostic.DiagnosticSeverityWarning:
+		return severity.String()
+	default:
+		return sarifLevelNote
+	}
+}
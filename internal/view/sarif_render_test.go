@@ -0,0 +1,261 @@
+package view_test
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gruntwork-io/terragrunt/internal/view"
+	"github.com/gruntwork-io/terragrunt/internal/view/diagnostic"
+)
+
+type sarifLog struct {
+	Version string `json:"version"`
+	Schema  string `json:"$schema"`
+	Runs    []struct {
+		Tool struct {
+			Driver struct {
+				Name  string `json:"name"`
+				Rules []struct {
+					ID string `json:"id"`
+				} `json:"rules"`
+			} `json:"driver"`
+		} `json:"tool"`
+		Results []sarifResult `json:"results"`
+	} `json:"runs"`
+}
+
+type sarifResult struct {
+	RuleID  string `json:"ruleId"`
+	Level   string `json:"level"`
+	Message struct {
+		Text string `json:"text"`
+	} `json:"message"`
+	Locations []struct {
+		PhysicalLocation struct {
+			ArtifactLocation struct {
+				URI string `json:"uri"`
+			} `json:"artifactLocation"`
+			Region struct {
+				StartLine   int `json:"startLine"`
+				StartColumn int `json:"startColumn"`
+				EndLine     int `json:"endLine"`
+				EndColumn   int `json:"endColumn"`
+			} `json:"region"`
+		} `json:"physicalLocation"`
+	} `json:"locations"`
+}
+
+func renderSARIF(t *testing.T, baseDir string, diags diagnostic.Diagnostics) sarifLog {
+	t.Helper()
+
+	output, err := view.NewSARIFRender(baseDir).Diagnostics(diags)
+	require.NoError(t, err)
+
+	var log sarifLog
+
+	require.NoError(t, json.Unmarshal([]byte(output), &log))
+	require.Len(t, log.Runs, 1)
+
+	return log
+}
+
+func TestSARIFRenderStructure(t *testing.T) {
+	t.Parallel()
+
+	baseDir := filepath.FromSlash("/repo")
+
+	log := renderSARIF(t, baseDir, diagnostic.Diagnostics{
+		{
+			Severity: diagnostic.DiagnosticSeverity(hcl.DiagError),
+			Summary:  "Unused local",
+			Detail:   "The local \"foo\" is never used.",
+			Rule:     "unused-locals",
+			Range: &diagnostic.Range{
+				Filename: filepath.Join(baseDir, "units", "app", "terragrunt.hcl"),
+				Start:    diagnostic.Pos{Line: 2, Column: 3},
+				End:      diagnostic.Pos{Line: 2, Column: 12},
+			},
+		},
+		{
+			Severity: diagnostic.DiagnosticSeverity(hcl.DiagError),
+			Summary:  "Missing closing brace",
+		},
+	})
+
+	assert.Equal(t, "2.1.0", log.Version)
+	assert.Equal(t, "https://json.schemastore.org/sarif-2.1.0.json", log.Schema)
+	assert.Equal(t, "terragrunt", log.Runs[0].Tool.Driver.Name)
+
+	results := log.Runs[0].Results
+	require.Len(t, results, 2)
+
+	assert.Equal(t, "unused-locals", results[0].RuleID)
+	assert.Equal(t, "Unused local\n\nThe local \"foo\" is never used.", results[0].Message.Text)
+	require.Len(t, results[0].Locations, 1)
+
+	location := results[0].Locations[0].PhysicalLocation
+	assert.Equal(t, "units/app/terragrunt.hcl", location.ArtifactLocation.URI)
+	assert.Equal(t, 2, location.Region.StartLine)
+	assert.Equal(t, 3, location.Region.StartColumn)
+	assert.Equal(t, 2, location.Region.EndLine)
+	assert.Equal(t, 12, location.Region.EndColumn)
+
+	// The diagnostics that were not produced by a named rule, such as HCL parsing errors, have no location if they have no range.
+	assert.Equal(t, "hcl", results[1].RuleID)
+	assert.Equal(t, "Missing closing brace", results[1].Message.Text)
+	assert.Empty(t, results[1].Locations)
+}
+
+func TestSARIFRenderRules(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		rules    []string
+		expected []string
+	}{
+		{
+			name:     "no diagnostics",
+			expected: []string{},
+		},
+		{
+			name:     "single rule",
+			rules:    []string{"unused-locals"},
+			expected: []string{"unused-locals"},
+		},
+		{
+			name:     "duplicated rules",
+			rules:    []string{"unused-locals", "deprecated-attribute", "unused-locals", "deprecated-attribute"},
+			expected: []string{"deprecated-attribute", "unused-locals"},
+		},
+		{
+			name:     "default rule",
+			rules:    []string{"", "unused-locals", ""},
+			expected: []string{"hcl", "unused-locals"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := diagnostic.Diagnostics{}
+
+			for _, rule := range tc.rules {
+				diags = append(diags, &diagnostic.Diagnostic{Summary: "summary", Rule: rule})
+			}
+
+			log := renderSARIF(t, "", diags)
+
+			ids := []string{}
+
+			for _, rule := range log.Runs[0].Tool.Driver.Rules {
+				ids = append(ids, rule.ID)
+			}
+
+			assert.Equal(t, tc.expected, ids)
+			assert.Len(t, log.Runs[0].Results, len(tc.rules))
+		})
+	}
+}
+
+func TestSARIFRenderLevels(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		severity hcl.DiagnosticSeverity
+		expected string
+	}{
+		{"error", hcl.DiagError, "error"},
+		{"warning", hcl.DiagWarning, "warning"},
+		{"invalid", hcl.DiagInvalid, "note"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			log := renderSARIF(t, "", diagnostic.Diagnostics{
+				{Severity: diagnostic.DiagnosticSeverity(tc.severity), Summary: "summary"},
+			})
+
+			require.Len(t, log.Runs[0].Results, 1)
+			assert.Equal(t, tc.expected, log.Runs[0].Results[0].Level)
+		})
+	}
+}
+
+func TestSARIFRenderPaths(t *testing.T) {
+	t.Parallel()
+
+	baseDir := filepath.FromSlash("/repo")
+
+	testCases := []struct {
+		name     string
+		baseDir  string
+		filename string
+		expected string
+	}{
+		{
+			name:     "inside the base dir",
+			baseDir:  baseDir,
+			filename: filepath.FromSlash("/repo/units/app/terragrunt.hcl"),
+			expected: "units/app/terragrunt.hcl",
+		},
+		{
+			name:     "directory starting with two dots inside the base dir",
+			baseDir:  baseDir,
+			filename: filepath.FromSlash("/repo/..units/terragrunt.hcl"),
+			expected: "..units/terragrunt.hcl",
+		},
+		{
+			name:     "outside the base dir",
+			baseDir:  baseDir,
+			filename: filepath.FromSlash("/other/terragrunt.hcl"),
+			expected: filepath.ToSlash(filepath.FromSlash("/other/terragrunt.hcl")),
+		},
+		{
+			name:     "sibling of the base dir with the same prefix",
+			baseDir:  baseDir,
+			filename: filepath.FromSlash("/repo-other/terragrunt.hcl"),
+			expected: filepath.ToSlash(filepath.FromSlash("/repo-other/terragrunt.hcl")),
+		},
+		{
+			name:     "parent of the base dir",
+			baseDir:  baseDir,
+			filename: filepath.FromSlash("/terragrunt.hcl"),
+			expected: filepath.ToSlash(filepath.FromSlash("/terragrunt.hcl")),
+		},
+		{
+			name:     "relative path outside the base dir",
+			baseDir:  baseDir,
+			filename: filepath.FromSlash("units/terragrunt.hcl"),
+			expected: "units/terragrunt.hcl",
+		},
+		{
+			name:     "no base dir",
+			filename: filepath.FromSlash("/repo/units/terragrunt.hcl"),
+			expected: filepath.ToSlash(filepath.FromSlash("/repo/units/terragrunt.hcl")),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			log := renderSARIF(t, tc.baseDir, diagnostic.Diagnostics{
+				{Summary: "summary", Range: &diagnostic.Range{Filename: tc.filename}},
+			})
+
+			require.Len(t, log.Runs[0].Results, 1)
+			require.Len(t, log.Runs[0].Results[0].Locations, 1)
+			assert.Equal(t, tc.expected, log.Runs[0].Results[0].Locations[0].PhysicalLocation.ArtifactLocation.URI)
+		})
+	}
+}
@@ -1,2 +1,18 @@
 // Package view contains the rendering logic for terragrunt.
 package view
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// relPath returns the path of the file relative to `baseDir`, with forward slashes, or the path itself if it is not under `baseDir`.
+func relPath(baseDir, filename string) string {
+	if baseDir != "" {
+		if rel, err := filepath.Rel(baseDir, filename); err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			filename = rel
+		}
+	}
+
+	return filepath.ToSlash(filename)
+}
@@ -46,6 +46,11 @@ const (
 
 	DefaultSignalsFile = "error-signals.json"
 
+	// Default to naming it `terragrunt-config-profile.folded` in the working directory.
+	DefaultProfileConfigFile = "terragrunt-config-profile.folded"
+
+	DefaultProfileConfigTop = 10
+
 	DefaultTFDataDir = ".terraform"
 
 	DefaultIAMAssumeRoleDuration = 3600
@@ -246,9 +251,15 @@ type TerragruntOptions struct {
 	// If True then HCL from StdIn must should be formatted.
 	HclFromStdin bool
 
+	// The format of the diagnostics of hclfmt, such as the files that are not formatted in check mode. If empty, they are logged.
+	HclDiagnosticsFormat string
+
 	// The file path that terragrunt should use when rendering the terragrunt.hcl config as json.
 	JSONOut string
 
+	// The directory with the policies that `policy check` evaluates, and that `run-all` evaluates before running the stack if set.
+	PolicyDir string
+
 	// When used with `run-all`, restrict the modules in the stack to only those that include at least one of the files
 	// in this list.
 	ModulesThatInclude []string
@@ -264,6 +275,15 @@ type TerragruntOptions struct {
 	// circular dependency).
 	RunTerragrunt func(ctx context.Context, opts *TerragruntOptions) error
 
+	// A command that can be used to run an OpenTofu/Terraform command with the given options, such as the
+	// `before_retry` command of the retry blocks. Like RunTerragrunt, it is declared here since it is defined in the
+	// tf package, which depends on this package.
+	RunTerraformCommand func(ctx context.Context, opts *TerragruntOptions, args ...string) error
+
+	// A command that can be used to run any command with the given options, such as the `before_retry_execute` command
+	// of the retry blocks. It is declared here since it is defined in the shell package, which depends on this package.
+	RunShellCommand func(ctx context.Context, opts *TerragruntOptions, command string, args ...string) error
+
 	// True if terragrunt should run in debug mode, writing terragrunt-debug.tfvars to working folder to help
 	// root-cause issues.
 	Debug bool
@@ -284,6 +304,43 @@ type TerragruntOptions struct {
 	// Enables caching of includes during partial parsing operations.
 	UsePartialParseConfigCache bool
 
+	// The path to the directory storing the results of `run_cmd` calls cached on disk. By default, 'terragrunt/run-cmd'
+	// folder in the user cache directory.
+	RunCmdCacheDir string
+
+	// Ignore the results of `run_cmd` calls cached on disk, running the commands again and caching their new results.
+	RunCmdCacheRefresh bool
+
+	// Do not run the commands of `run_cmd`, which returns the placeholder values of RunCmdPlaceholders instead.
+	NoRunCmd bool
+
+	// The values returned by `run_cmd` with NoRunCmd, by command. The `*` key sets the value for the other commands.
+	RunCmdPlaceholders map[string]string
+
+	// The executables that `run_cmd` is allowed to run, as names, paths or glob patterns. All the executables are
+	// allowed when empty.
+	RunCmdAllow []string
+
+	// The maximum duration of each command run by `run_cmd`. The commands have no timeout when zero.
+	RunCmdTimeout time.Duration
+
+	// Run the commands of `run_cmd` with a stripped environment, keeping only the base variables, such as PATH, and the
+	// variables of RunCmdKeepEnv.
+	RunCmdStripEnv bool
+
+	// The environment variables kept in the stripped environment of the commands of `run_cmd`.
+	RunCmdKeepEnv []string
+
+	// Record the time spent and the number of calls when evaluating the configs, per file, include, function call and
+	// dependency output fetch.
+	ProfileConfig bool
+
+	// The path of the file the config profile is written to as folded stacks, relative to the working directory.
+	ProfileConfigFile string
+
+	// The number of entries of the config profile summary, with the most time spent.
+	ProfileConfigTop int
+
 	// Include fields metadata in render-json
 	RenderJSONWithMetadata bool
 
@@ -338,6 +395,15 @@ type TerragruntOptions struct {
 	// The list of remote registries to cached by Terragrunt Provider Cache server.
 	ProviderCacheRegistryNames []string
 
+	// Use the provider cache server already started by `terragrunt provider-cache serve` instead of starting a new one.
+	ProviderCacheExternalServer bool
+
+	// Serve only the providers that are already cached or available in filesystem mirrors, without reaching out to the registries.
+	ProviderCacheOffline bool
+
+	// The list of platforms, in the `os_arch` format, whose hashes are added to the dependency lock files by the Terragrunt Provider Cache.
+	ProviderCacheLockPlatforms []string
+
 	// Folder to store output files.
 	OutputFolder string
 
@@ -379,6 +445,10 @@ type TerragruntOptions struct {
 	// that read them using HCL functions in the unit.
 	ReadFiles *xsync.MapOf[string, []string] `clone:"shadowcopy"`
 
+	// HookOutputs is a map of units to the JSON values
+	// their hooks wrote to the hook `output_file`, by hook name.
+	HookOutputs *xsync.MapOf[string, map[string]any] `clone:"shadowcopy"`
+
 	// Errors is a configuration for error handling.
 	Errors *ErrorsConfig
 
@@ -502,16 +572,25 @@ func NewTerragruntOptionsWithWriters(stdout, stderr io.Writer) *TerragruntOption
 		UsePartialParseConfigCache:     false,
 		ForwardTFStdout:                false,
 		JSONOut:                        DefaultJSONOutName,
+		ProfileConfigFile:              DefaultProfileConfigFile,
+		ProfileConfigTop:               DefaultProfileConfigTop,
 		TerraformImplementation:        UnknownImpl,
 		JSONDisableDependentModules:    false,
 		RunTerragrunt: func(ctx context.Context, opts *TerragruntOptions) error {
 			return errors.New(ErrRunTerragruntCommandNotSet)
 		},
+		RunTerraformCommand: func(ctx context.Context, opts *TerragruntOptions, args ...string) error {
+			return errors.New(ErrRunTerraformCommandNotSet)
+		},
+		RunShellCommand: func(ctx context.Context, opts *TerragruntOptions, command string, args ...string) error {
+			return errors.New(ErrRunShellCommandNotSet)
+		},
 		ProviderCacheRegistryNames: defaultProviderCacheRegistryNames,
 		OutputFolder:               "",
 		JSONOutputFolder:           "",
 		FeatureFlags:               xsync.NewMapOf[string, string](),
 		ReadFiles:                  xsync.NewMapOf[string, []string](),
+		HookOutputs:                xsync.NewMapOf[string, map[string]any](),
 		StrictControls:             controls.New(),
 		Experiments:                experiment.NewExperiments(),
 	}
@@ -742,6 +821,37 @@ func (opts *TerragruntOptions) DidReadFile(file, unit string) bool {
 	return false
 }
 
+// AddHookOutput records the output of the hook `hookName` of the given unit.
+func (opts *TerragruntOptions) AddHookOutput(unit, hookName string, output any) {
+	if opts.HookOutputs == nil {
+		opts.HookOutputs = xsync.NewMapOf[string, map[string]any]()
+	}
+
+	// Atomic insert, the outputs are never modified in place, since they can be read concurrently.
+	_, _ = opts.HookOutputs.Compute(unit, func(oldOutputs map[string]any, loaded bool) (map[string]any, bool) {
+		newOutputs := make(map[string]any, len(oldOutputs)+1)
+
+		for name, value := range oldOutputs {
+			newOutputs[name] = value
+		}
+
+		newOutputs[hookName] = output
+
+		return newOutputs, false
+	})
+}
+
+// UnitHookOutputs returns the outputs of the hooks of the given unit, by hook name.
+func (opts *TerragruntOptions) UnitHookOutputs(unit string) map[string]any {
+	if opts.HookOutputs == nil {
+		return nil
+	}
+
+	outputs, _ := opts.HookOutputs.Load(unit)
+
+	return outputs
+}
+
 // CloneReadFiles creates a copy of the ReadFiles map.
 func (opts *TerragruntOptions) CloneReadFiles(readFiles *xsync.MapOf[string, []string]) {
 	if readFiles == nil {
@@ -768,10 +878,11 @@ func identifyDefaultWrappedExecutable() string {
 
 // EngineOptions Options for the Terragrunt engine.
 type EngineOptions struct {
-	Source  string
-	Version string
-	Type    string
-	Meta    map[string]interface{}
+	Source   string
+	Version  string
+	Type     string
+	Checksum string
+	Meta     map[string]interface{}
 }
 
 // ErrorsConfig extracted errors handling configuration.
@@ -786,6 +897,11 @@ type RetryConfig struct {
 	RetryableErrors  []*ErrorsPattern
 	MaxAttempts      int
 	SleepIntervalSec int
+	Backoff          RetryBackoff
+	MaxSleepSec      int
+	Jitter           bool
+	BeforeRetry      []string
+	BeforeRetryExec  []string
 }
 
 // IgnoreConfig represents the configuration for ignoring specific errors.
@@ -830,7 +946,7 @@ func (opts *TerragruntOptions) RunWithErrorHandling(ctx context.Context, operati
 
 			// Handle ignore signals if any are configured
 			if len(action.IgnoreSignals) > 0 {
-				if err := opts.handleIgnoreSignals(action.IgnoreSignals); err != nil {
+				if err := opts.WriteErrorSignals(action.IgnoreSignals); err != nil {
 					return err
 				}
 			}
@@ -840,21 +956,33 @@ func (opts *TerragruntOptions) RunWithErrorHandling(ctx context.Context, operati
 
 		if action.ShouldRetry {
 			opts.Logger.Warnf(
-				"Encountered retryable error: %s\nAttempt %d of %d. Waiting %d second(s) before retrying...",
+				"Encountered retryable error: %s\nAttempt %d of %d. Waiting %s before retrying...",
 				action.RetryMessage,
 				currentAttempt,
 				action.RetryAttempts,
-				action.RetrySleepSecs,
+				action.RetrySleep,
 			)
 
 			// Sleep before retry
 			select {
-			case <-time.After(time.Duration(action.RetrySleepSecs) * time.Second):
+			case <-time.After(action.RetrySleep):
 				// try again
 			case <-ctx.Done():
 				return errors.New(ctx.Err())
 			}
 
+			if len(action.RetryBeforeCommand) > 0 {
+				if err := opts.runBeforeRetryCommand(ctx, action.RetryBeforeCommand); err != nil {
+					return err
+				}
+			}
+
+			if len(action.RetryBeforeExec) > 0 {
+				if err := opts.runBeforeRetryExec(ctx, action.RetryBeforeExec); err != nil {
+					return err
+				}
+			}
+
 			currentAttempt++
 
 			continue
@@ -864,9 +992,14 @@ func (opts *TerragruntOptions) RunWithErrorHandling(ctx context.Context, operati
 	}
 }
 
-func (opts *TerragruntOptions) handleIgnoreSignals(signals map[string]interface{}) error {
-	workingDir := opts.WorkingDir
-	signalsFile := filepath.Join(workingDir, DefaultSignalsFile)
+// ErrorSignalsFile returns the path of the file the signals of the ignored errors are written to, next to the unit configuration.
+func (opts *TerragruntOptions) ErrorSignalsFile() string {
+	return filepath.Join(filepath.Dir(opts.TerragruntConfigPath), DefaultSignalsFile)
+}
+
+// WriteErrorSignals writes the signals of the ignored error to the unit error signals file.
+func (opts *TerragruntOptions) WriteErrorSignals(signals map[string]interface{}) error {
+	signalsFile := opts.ErrorSignalsFile()
 	signalsJSON, err := json.MarshalIndent(signals, "", "  ")
 
 	if err != nil {
@@ -883,15 +1016,46 @@ func (opts *TerragruntOptions) handleIgnoreSignals(signals map[string]interface{
 	return nil
 }
 
+// RemoveErrorSignals removes the error signals file left by a previous run of the unit, so that hooks and dependents only see the signals of the current run.
+func (opts *TerragruntOptions) RemoveErrorSignals() error {
+	if err := os.Remove(opts.ErrorSignalsFile()); err != nil && !os.IsNotExist(err) {
+		return errors.New(err)
+	}
+
+	return nil
+}
+
+// ReadErrorSignals returns the signals of the errors ignored by the unit located in the given directory, or nil if no errors were ignored.
+func ReadErrorSignals(unitDir string) (map[string]interface{}, error) {
+	signalsJSON, err := os.ReadFile(filepath.Join(unitDir, DefaultSignalsFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+
+		return nil, errors.New(err)
+	}
+
+	var signals map[string]interface{}
+
+	if err := json.Unmarshal(signalsJSON, &signals); err != nil {
+		return nil, errors.Errorf("failed to decode signals file in %s: %w", unitDir, err)
+	}
+
+	return signals, nil
+}
+
 // ErrorAction represents the action to take when an error occurs
 type ErrorAction struct {
-	ShouldIgnore   bool
-	ShouldRetry    bool
-	IgnoreMessage  string
-	IgnoreSignals  map[string]interface{}
-	RetryMessage   string
-	RetryAttempts  int
-	RetrySleepSecs int
+	ShouldIgnore       bool
+	ShouldRetry        bool
+	IgnoreMessage      string
+	IgnoreSignals      map[string]interface{}
+	RetryMessage       string
+	RetryAttempts      int
+	RetrySleep         time.Duration
+	RetryBeforeCommand []string
+	RetryBeforeExec    []string
 }
 
 // ProcessError evaluates an error against the configuration and returns the appropriate action
@@ -906,20 +1070,17 @@ func (c *ErrorsConfig) ProcessError(opts *TerragruntOptions, err error, currentA
 	opts.Logger.Debugf("Processing error message: %s", errStr)
 
 	// First check ignore rules
-	for _, ignoreBlock := range c.Ignore {
-		isIgnorable := matchesAnyRegexpPattern(errStr, ignoreBlock.IgnorableErrors)
-		if isIgnorable {
-			action.ShouldIgnore = true
-			action.IgnoreMessage = ignoreBlock.Message
-			action.IgnoreSignals = make(map[string]interface{})
-
-			// Convert cty.Value map to regular map
-			for k, v := range ignoreBlock.Signals {
-				action.IgnoreSignals[k] = v
-			}
-
-			return action, nil
+	if ignoreBlock := c.matchIgnore(errStr); ignoreBlock != nil {
+		action.ShouldIgnore = true
+		action.IgnoreMessage = ignoreBlock.Message
+		action.IgnoreSignals = make(map[string]interface{})
+
+		// Convert cty.Value map to regular map
+		for k, v := range ignoreBlock.Signals {
+			action.IgnoreSignals[k] = v
 		}
+
+		return action, nil
 	}
 
 	// Then check retry rules
@@ -934,7 +1095,9 @@ func (c *ErrorsConfig) ProcessError(opts *TerragruntOptions, err error, currentA
 			action.RetryMessage = retryBlock.Name
 			action.ShouldRetry = true
 			action.RetryAttempts = retryBlock.MaxAttempts
-			action.RetrySleepSecs = retryBlock.SleepIntervalSec
+			action.RetrySleep = retryBlock.SleepInterval(currentAttempt)
+			action.RetryBeforeCommand = retryBlock.BeforeRetry
+			action.RetryBeforeExec = retryBlock.BeforeRetryExec
 
 			return action, nil
 		}
@@ -943,6 +1106,16 @@ func (c *ErrorsConfig) ProcessError(opts *TerragruntOptions, err error, currentA
 	return nil, err
 }
 
+func (c *ErrorsConfig) matchIgnore(errStr string) *IgnoreConfig {
+	for _, ignoreBlock := range c.Ignore {
+		if matchesAnyRegexpPattern(errStr, ignoreBlock.IgnorableErrors) {
+			return ignoreBlock
+		}
+	}
+
+	return nil
+}
+
 func extractErrorMessage(err error) string {
 	// fetch the error string and remove any ASCII escape sequences
 	multilineText := log.RemoveAllASCISeq(err.Error())
@@ -967,3 +1140,9 @@ func matchesAnyRegexpPattern(input string, patterns []*ErrorsPattern) bool {
 
 // ErrRunTerragruntCommandNotSet is a custom error type indicating that the command is not set.
 var ErrRunTerragruntCommandNotSet = errors.New("the RunTerragrunt option has not been set on this TerragruntOptions object")
+
+// ErrRunTerraformCommandNotSet is a custom error type indicating that the command is not set.
+var ErrRunTerraformCommandNotSet = errors.New("the RunTerraformCommand option has not been set on this TerragruntOptions object")
+
+// ErrRunShellCommandNotSet is a custom error type indicating that the command is not set.
+var ErrRunShellCommandNotSet = errors.New("the RunShellCommand option has not been set on this TerragruntOptions object")
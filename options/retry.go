@@ -0,0 +1,68 @@
+package options
+
+import (
+	"context"
+	"math/rand/v2"
+	"strings"
+	"time"
+)
+
+// RetryBackoff is the strategy used to grow the sleep interval between retry attempts.
+type RetryBackoff string
+
+const (
+	// ConstantBackoff sleeps the same interval before each attempt.
+	ConstantBackoff RetryBackoff = "constant"
+	// LinearBackoff multiplies the interval by the attempt number.
+	LinearBackoff RetryBackoff = "linear"
+	// ExponentialBackoff doubles the interval after each attempt.
+	ExponentialBackoff RetryBackoff = "exponential"
+)
+
+// RetryBackoffs is the list of the supported backoff strategies.
+var RetryBackoffs = []RetryBackoff{ConstantBackoff, LinearBackoff, ExponentialBackoff}
+
+// SleepInterval returns the time to wait after the given failed attempt, starting from 1.
+// The interval is capped by `MaxSleepSec`, if set, and when `Jitter` is enabled it is randomized between half and the full interval,
+// so that parallel units hitting the same rate limit do not retry all at once.
+func (retry *RetryConfig) SleepInterval(attempt int) time.Duration {
+	interval := time.Duration(retry.SleepIntervalSec) * time.Second
+
+	switch retry.Backoff {
+	case LinearBackoff:
+		interval *= time.Duration(attempt)
+	case ExponentialBackoff:
+		// limit the shift to avoid overflowing the duration with a large number of attempts
+		const maxShift = 30
+
+		interval <<= min(max(attempt-1, 0), maxShift)
+	case ConstantBackoff:
+	}
+
+	if maxSleep := time.Duration(retry.MaxSleepSec) * time.Second; retry.MaxSleepSec > 0 && (interval > maxSleep || interval < 0) {
+		interval = maxSleep
+	}
+
+	if retry.Jitter && interval > 0 {
+		half := interval / 2                                        //nolint:mnd
+		interval = half + time.Duration(rand.Int64N(int64(half)+1)) //nolint:gosec
+	}
+
+	return interval
+}
+
+// runBeforeRetryCommand runs the `before_retry` command of the retry block, an OpenTofu/Terraform command such as
+// `init -reconfigure`, in the working directory before the next attempt.
+func (opts *TerragruntOptions) runBeforeRetryCommand(ctx context.Context, args []string) error {
+	opts.Logger.Infof("Running before retry command: %s %s", opts.TerraformPath, strings.Join(args, " "))
+
+	return opts.RunTerraformCommand(ctx, opts, args...)
+}
+
+// runBeforeRetryExec runs the `before_retry_execute` command of the retry block, any program such as a credential
+// refresh script, in the working directory before the next attempt.
+func (opts *TerragruntOptions) runBeforeRetryExec(ctx context.Context, execute []string) error {
+	opts.Logger.Infof("Running before retry command: %s", strings.Join(execute, " "))
+
+	return opts.RunShellCommand(ctx, opts, execute[0], execute[1:]...)
+}
@@ -0,0 +1,169 @@
+package options_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunWithErrorHandlingBeforeRetry(t *testing.T) {
+	t.Parallel()
+
+	opts, err := options.NewTerragruntOptionsForTest("terragrunt.hcl")
+	require.NoError(t, err)
+
+	opts.Errors = &options.ErrorsConfig{
+		Retry: map[string]*options.RetryConfig{
+			"backend": {
+				Name:            "backend",
+				RetryableErrors: []*options.ErrorsPattern{{Pattern: regexp.MustCompile(".*backend initialization required.*")}},
+				MaxAttempts:     3,
+				BeforeRetry:     []string{"init", "-reconfigure"},
+			},
+		},
+	}
+
+	var commands [][]string
+
+	opts.RunTerraformCommand = func(_ context.Context, _ *options.TerragruntOptions, args ...string) error {
+		commands = append(commands, args)
+		return nil
+	}
+
+	attempts := 0
+
+	err = opts.RunWithErrorHandling(context.Background(), func() error {
+		attempts++
+		if attempts < 3 {
+			return errors.New("backend initialization required, please run \"tofu init\"")
+		}
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, attempts)
+	assert.Equal(t, [][]string{{"init", "-reconfigure"}, {"init", "-reconfigure"}}, commands)
+}
+
+func TestRunWithErrorHandlingBeforeRetryFails(t *testing.T) {
+	t.Parallel()
+
+	opts, err := options.NewTerragruntOptionsForTest("terragrunt.hcl")
+	require.NoError(t, err)
+
+	opts.Errors = &options.ErrorsConfig{
+		Retry: map[string]*options.RetryConfig{
+			"backend": {
+				Name:            "backend",
+				RetryableErrors: []*options.ErrorsPattern{{Pattern: regexp.MustCompile(".*")}},
+				MaxAttempts:     3,
+				BeforeRetry:     []string{"init", "-reconfigure"},
+			},
+		},
+	}
+
+	initErr := errors.New("init failed")
+
+	opts.RunTerraformCommand = func(_ context.Context, _ *options.TerragruntOptions, _ ...string) error {
+		return initErr
+	}
+
+	attempts := 0
+
+	err = opts.RunWithErrorHandling(context.Background(), func() error {
+		attempts++
+		return errors.New("apply failed")
+	})
+	require.ErrorIs(t, err, initErr)
+
+	// No further attempt is made once the before retry command fails.
+	assert.Equal(t, 1, attempts)
+}
+
+func TestRunWithErrorHandlingBeforeRetryExecute(t *testing.T) {
+	t.Parallel()
+
+	opts, err := options.NewTerragruntOptionsForTest("terragrunt.hcl")
+	require.NoError(t, err)
+
+	opts.Errors = &options.ErrorsConfig{
+		Retry: map[string]*options.RetryConfig{
+			"credentials": {
+				Name:            "credentials",
+				RetryableErrors: []*options.ErrorsPattern{{Pattern: regexp.MustCompile(".*ExpiredToken.*")}},
+				MaxAttempts:     2,
+				BeforeRetry:     []string{"init", "-reconfigure"},
+				BeforeRetryExec: []string{"./refresh-credentials.sh", "--profile", "dev"},
+			},
+		},
+	}
+
+	var commands []string
+
+	opts.RunTerraformCommand = func(_ context.Context, _ *options.TerragruntOptions, args ...string) error {
+		commands = append(commands, "tofu "+strings.Join(args, " "))
+		return nil
+	}
+
+	opts.RunShellCommand = func(_ context.Context, _ *options.TerragruntOptions, command string, args ...string) error {
+		commands = append(commands, command+" "+strings.Join(args, " "))
+		return nil
+	}
+
+	attempts := 0
+
+	err = opts.RunWithErrorHandling(context.Background(), func() error {
+		attempts++
+		if attempts < 2 {
+			return errors.New("ExpiredToken: the security token included in the request is expired")
+		}
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, attempts)
+	assert.Equal(t, []string{"tofu init -reconfigure", "./refresh-credentials.sh --profile dev"}, commands)
+}
+
+func TestRunWithErrorHandlingBeforeRetryExecuteFails(t *testing.T) {
+	t.Parallel()
+
+	opts, err := options.NewTerragruntOptionsForTest("terragrunt.hcl")
+	require.NoError(t, err)
+
+	opts.Errors = &options.ErrorsConfig{
+		Retry: map[string]*options.RetryConfig{
+			"credentials": {
+				Name:            "credentials",
+				RetryableErrors: []*options.ErrorsPattern{{Pattern: regexp.MustCompile(".*")}},
+				MaxAttempts:     3,
+				BeforeRetryExec: []string{"./refresh-credentials.sh"},
+			},
+		},
+	}
+
+	refreshErr := errors.New("refresh failed")
+
+	opts.RunShellCommand = func(_ context.Context, _ *options.TerragruntOptions, _ string, _ ...string) error {
+		return refreshErr
+	}
+
+	attempts := 0
+
+	err = opts.RunWithErrorHandling(context.Background(), func() error {
+		attempts++
+		return errors.New("apply failed")
+	})
+	require.ErrorIs(t, err, refreshErr)
+
+	// No further attempt is made once the before retry command fails.
+	assert.Equal(t, 1, attempts)
+}
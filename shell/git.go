@@ -15,6 +15,7 @@ import (
 const (
 	gitPrefix = "git::"
 	refsTags  = "refs/tags/"
+	gitHead   = "HEAD"
 
 	tagSplitPart = 2
 )
@@ -53,6 +54,114 @@ func GitTopLevelDir(ctx context.Context, terragruntOptions *options.TerragruntOp
 	return cmdOutput, nil
 }
 
+// GitBranch returns the name of the branch checked out in the git repository of the passed directory, or an empty
+// string if HEAD is detached.
+func GitBranch(ctx context.Context, terragruntOptions *options.TerragruntOptions, path string) (string, error) {
+	topLevelDir, err := GitTopLevelDir(ctx, terragruntOptions, path)
+	if err != nil {
+		return "", err
+	}
+
+	branch, err := gitOutput(ctx, terragruntOptions, "branch-"+topLevelDir, topLevelDir, "rev-parse", "--abbrev-ref", "HEAD")
+	if err != nil {
+		return "", err
+	}
+
+	if branch == gitHead {
+		return "", nil
+	}
+
+	return branch, nil
+}
+
+// GitCommit returns the SHA of the commit checked out in the git repository of the passed directory.
+func GitCommit(ctx context.Context, terragruntOptions *options.TerragruntOptions, path string) (string, error) {
+	topLevelDir, err := GitTopLevelDir(ctx, terragruntOptions, path)
+	if err != nil {
+		return "", err
+	}
+
+	return gitOutput(ctx, terragruntOptions, "commit-"+topLevelDir, topLevelDir, "rev-parse", gitHead)
+}
+
+// GitTags returns the tags pointing at the commit checked out in the git repository of the passed directory.
+func GitTags(ctx context.Context, terragruntOptions *options.TerragruntOptions, path string) ([]string, error) {
+	topLevelDir, err := GitTopLevelDir(ctx, terragruntOptions, path)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := gitOutput(ctx, terragruntOptions, "tags-"+topLevelDir, topLevelDir, "tag", "--points-at", gitHead)
+	if err != nil {
+		return nil, err
+	}
+
+	return strings.Fields(tags), nil
+}
+
+// GitIsDirty returns true if the git repository of the passed directory has uncommitted changes, including untracked
+// files. The error signals files written by Terragrunt next to the unit configurations are not taken into account.
+func GitIsDirty(ctx context.Context, terragruntOptions *options.TerragruntOptions, path string) (bool, error) {
+	topLevelDir, err := GitTopLevelDir(ctx, terragruntOptions, path)
+	if err != nil {
+		return false, err
+	}
+
+	status, err := gitOutput(ctx, terragruntOptions, "status-"+topLevelDir, topLevelDir, "status", "--porcelain", "--", ".", ":(glob,exclude)**/"+options.DefaultSignalsFile)
+	if err != nil {
+		return false, err
+	}
+
+	return status != "", nil
+}
+
+// GitLastAuthor returns the author of the last commit changing the files of the passed directory.
+func GitLastAuthor(ctx context.Context, terragruntOptions *options.TerragruntOptions, path string) (string, error) {
+	return gitOutput(ctx, terragruntOptions, "last-author-"+path, path, "log", "-1", "--format=%an", "--", ".")
+}
+
+// GitChangedSince returns true if the files of the passed directory differ from the passed git ref, including the
+// uncommitted changes to the tracked files.
+func GitChangedSince(ctx context.Context, terragruntOptions *options.TerragruntOptions, path, ref string) (bool, error) {
+	files, err := gitOutput(ctx, terragruntOptions, "changed-since-"+ref+"-"+path, path, "diff", "--name-only", ref, "--", ".")
+	if err != nil {
+		return false, err
+	}
+
+	return files != "", nil
+}
+
+// gitOutput runs the git command in the passed directory and returns its trimmed output, which is cached for the
+// rest of the run with the passed key.
+func gitOutput(ctx context.Context, terragruntOptions *options.TerragruntOptions, cacheKey, path string, args ...string) (string, error) {
+	runCache := cache.ContextCache[string](ctx, cache.RunCmdCacheContextKey)
+	cacheKey = "git-" + cacheKey
+
+	if output, found := runCache.Get(ctx, cacheKey); found {
+		return output, nil
+	}
+
+	opts, err := options.NewTerragruntOptionsWithConfigPath(path)
+	if err != nil {
+		return "", err
+	}
+
+	opts.Logger = terragruntOptions.Logger.Clone()
+	opts.Env = terragruntOptions.Env
+	opts.Writer = &bytes.Buffer{}
+	opts.ErrWriter = &bytes.Buffer{}
+
+	cmd, err := RunCommandWithOutput(ctx, opts, path, true, false, "git", args...)
+	if err != nil {
+		return "", err
+	}
+
+	output := strings.TrimSpace(cmd.Stdout.String())
+	runCache.Put(ctx, cacheKey, output)
+
+	return output, nil
+}
+
 // GitRepoTags fetches git repository tags from passed url.
 func GitRepoTags(ctx context.Context, opts *options.TerragruntOptions, gitRepo *url.URL) ([]string, error) {
 	repoPath := gitRepo.String()
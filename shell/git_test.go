@@ -0,0 +1,91 @@
+package shell_test
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gruntwork-io/terragrunt/internal/cache"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/shell"
+)
+
+func TestGitContext(t *testing.T) {
+	t.Parallel()
+
+	repoDir := t.TempDir()
+	unitDir := filepath.Join(repoDir, "unit")
+	require.NoError(t, os.MkdirAll(unitDir, 0755))
+
+	git := func(args ...string) {
+		t.Helper()
+
+		cmd := exec.Command("git", append([]string{"-c", "user.name=Jane Doe", "-c", "user.email=jane@example.com"}, args...)...)
+		cmd.Dir = repoDir
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+
+	git("init", "--initial-branch=release/1.0")
+	require.NoError(t, os.WriteFile(filepath.Join(repoDir, "README.md"), []byte("readme"), 0644))
+	git("add", ".")
+	git("commit", "-m", "first")
+	git("tag", "v1.0.0")
+	require.NoError(t, os.WriteFile(filepath.Join(unitDir, "terragrunt.hcl"), []byte(""), 0644))
+	git("add", ".")
+	git("commit", "-m", "second")
+	git("tag", "v1.0.1")
+	git("tag", "latest")
+
+	ctx := cache.ContextWithCache(context.Background())
+
+	opts, err := options.NewTerragruntOptionsForTest(filepath.Join(unitDir, "terragrunt.hcl"))
+	require.NoError(t, err)
+
+	branch, err := shell.GitBranch(ctx, opts, unitDir)
+	require.NoError(t, err)
+	assert.Equal(t, "release/1.0", branch)
+
+	commit, err := shell.GitCommit(ctx, opts, unitDir)
+	require.NoError(t, err)
+	assert.Len(t, commit, 40)
+
+	tags, err := shell.GitTags(ctx, opts, unitDir)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"latest", "v1.0.1"}, tags)
+
+	// The error signals file written next to the unit configuration does not make the repository dirty.
+	require.NoError(t, os.WriteFile(filepath.Join(unitDir, options.DefaultSignalsFile), []byte("{}"), 0644))
+
+	dirty, err := shell.GitIsDirty(ctx, opts, unitDir)
+	require.NoError(t, err)
+	assert.False(t, dirty)
+
+	author, err := shell.GitLastAuthor(ctx, opts, unitDir)
+	require.NoError(t, err)
+	assert.Equal(t, "Jane Doe", author)
+
+	changed, err := shell.GitChangedSince(ctx, opts, unitDir, "v1.0.0")
+	require.NoError(t, err)
+	assert.True(t, changed)
+
+	changed, err = shell.GitChangedSince(ctx, opts, unitDir, "v1.0.1")
+	require.NoError(t, err)
+	assert.False(t, changed)
+
+	// The results are cached for the rest of the run.
+	require.NoError(t, os.WriteFile(filepath.Join(unitDir, "main.tf"), []byte(""), 0644))
+
+	dirty, err = shell.GitIsDirty(ctx, opts, unitDir)
+	require.NoError(t, err)
+	assert.False(t, dirty)
+
+	dirty, err = shell.GitIsDirty(cache.ContextWithCache(context.Background()), opts, unitDir)
+	require.NoError(t, err)
+	assert.True(t, dirty)
+}
@@ -27,6 +27,10 @@ import (
 // if it receives the signal directly from the shell, to avoid sending the second interrupt signal to `tofu`/`terraform`.
 const SignalForwardingDelay = time.Second * 15
 
+// TimeoutKillDelay is the time to wait before killing a command which is still running once interrupted because
+// its timeout is exceeded, since the command may ignore the interrupt signal.
+const TimeoutKillDelay = time.Second * 5
+
 // RunCommand runs the given shell command.
 func RunCommand(ctx context.Context, opts *options.TerragruntOptions, command string, args ...string) error {
 	_, err := RunCommandWithOutput(ctx, opts, "", false, false, command, args...)
@@ -113,6 +117,10 @@ func RunCommandWithOutput(
 			exec.WithForwardSignalDelay(SignalForwardingDelay),
 		)
 
+		if _, ok := ctx.Deadline(); ok {
+			cmd.Configure(exec.WithKillDelay(TimeoutKillDelay))
+		}
+
 		if err := cmd.Start(); err != nil { //nolint:contextcheck
 			err = util.ProcessExecutionError{
 				Err:            err,
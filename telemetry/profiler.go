@@ -0,0 +1,238 @@
+package telemetry
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// SubjectAttr is the attribute of a span naming what the span is about, such as the path of the parsed config or the
+// name of the called function. The profiler reports the spans by name and subject.
+const SubjectAttr = "subject"
+
+const summaryPadding = 2
+
+type profilerContextKey byte
+
+const (
+	profilerKey profilerContextKey = iota
+	profileFrameKey
+)
+
+// Profiler records the time spent in the telemetry spans and how many times they run, keeping track of how they are
+// nested. It is enabled for the spans run with a context returned by ContextWithProfiler.
+type Profiler struct {
+	// workingDir is the directory the subjects that are paths are reported relative to.
+	workingDir string
+
+	mu sync.Mutex
+	// stacks are the self times of the spans, by stack of nested span labels separated by `;`.
+	stacks map[string]time.Duration
+	stats  map[profileKey]*ProfileStat
+}
+
+// ProfileStat is the time spent in the spans with the same name and subject, and how many times they ran.
+type ProfileStat struct {
+	Name    string
+	Subject string
+	Calls   int
+	// Total is the time spent in the spans, including the spans nested in them.
+	Total time.Duration
+	// Self is the time spent in the spans, excluding the spans nested in them.
+	Self time.Duration
+}
+
+type profileKey struct {
+	name    string
+	subject string
+}
+
+type profileFrame struct {
+	stack string
+
+	mu sync.Mutex
+	// children is the time spent in the spans nested in the frame.
+	children time.Duration
+}
+
+// NewProfiler returns a new profiler, reporting the subjects that are paths relative to the working directory.
+func NewProfiler(workingDir string) *Profiler {
+	return &Profiler{
+		workingDir: workingDir,
+		stacks:     map[string]time.Duration{},
+		stats:      map[profileKey]*ProfileStat{},
+	}
+}
+
+// ContextWithProfiler returns a context recording the spans run with it in the profiler.
+func ContextWithProfiler(ctx context.Context, profiler *Profiler) context.Context {
+	return context.WithValue(ctx, profilerKey, profiler)
+}
+
+// ProfilerFromContext returns the profiler of the context, nil if the spans are not profiled.
+func ProfilerFromContext(ctx context.Context) *Profiler {
+	if ctx == nil {
+		return nil
+	}
+
+	profiler, _ := ctx.Value(profilerKey).(*Profiler)
+
+	return profiler
+}
+
+// Profile - record time and calls for function execution, in the profiler of the context if any.
+func Profile(ctx context.Context, name string, attrs map[string]interface{}, fn func(childCtx context.Context) error) error {
+	profiler := ProfilerFromContext(ctx)
+	if profiler == nil {
+		return fn(ctx)
+	}
+
+	var subject string
+	if val, ok := attrs[SubjectAttr]; ok {
+		subject = profiler.relSubject(fmt.Sprintf("%v", val))
+	}
+
+	label := name
+	if subject != "" {
+		label += " " + subject
+	}
+
+	// `;` separates the frames of the folded stacks.
+	label = strings.ReplaceAll(label, ";", ",")
+
+	parent, _ := ctx.Value(profileFrameKey).(*profileFrame)
+
+	frame := &profileFrame{stack: label}
+	if parent != nil {
+		frame.stack = parent.stack + ";" + label
+	}
+
+	startTime := time.Now()
+	err := fn(context.WithValue(ctx, profileFrameKey, frame))
+
+	profiler.record(parent, frame, profileKey{name: name, subject: subject}, time.Since(startTime))
+
+	return err
+}
+
+func (profiler *Profiler) record(parent, frame *profileFrame, key profileKey, elapsed time.Duration) {
+	frame.mu.Lock()
+	// The nested spans may run in parallel, such as the fetches of the dependency outputs.
+	self := max(elapsed-frame.children, 0)
+	frame.mu.Unlock()
+
+	if parent != nil {
+		parent.mu.Lock()
+		parent.children += elapsed
+		parent.mu.Unlock()
+	}
+
+	profiler.mu.Lock()
+	defer profiler.mu.Unlock()
+
+	profiler.stacks[frame.stack] += self
+
+	stat, ok := profiler.stats[key]
+	if !ok {
+		stat = &ProfileStat{Name: key.name, Subject: key.subject}
+		profiler.stats[key] = stat
+	}
+
+	stat.Calls++
+	stat.Total += elapsed
+	stat.Self += self
+}
+
+func (profiler *Profiler) relSubject(subject string) string {
+	if !filepath.IsAbs(subject) || profiler.workingDir == "" {
+		return subject
+	}
+
+	if relPath, err := filepath.Rel(profiler.workingDir, subject); err == nil {
+		return relPath
+	}
+
+	return subject
+}
+
+// Top returns the stats of the n spans with the most total time, all of them if n is not positive.
+func (profiler *Profiler) Top(n int) []*ProfileStat {
+	profiler.mu.Lock()
+	defer profiler.mu.Unlock()
+
+	stats := make([]*ProfileStat, 0, len(profiler.stats))
+
+	for _, stat := range profiler.stats {
+		statCopy := *stat
+		stats = append(stats, &statCopy)
+	}
+
+	slices.SortFunc(stats, func(a, b *ProfileStat) int {
+		return cmp.Or(
+			cmp.Compare(b.Total, a.Total),
+			cmp.Compare(a.Name, b.Name),
+			cmp.Compare(a.Subject, b.Subject),
+		)
+	})
+
+	if n > 0 && len(stats) > n {
+		stats = stats[:n]
+	}
+
+	return stats
+}
+
+// WriteFolded writes the profile in the folded stacks format read by the flamegraph tools, such as flamegraph.pl,
+// inferno and speedscope: a line per stack of nested spans separated by `;`, followed by the time spent in the
+// innermost span, in microseconds.
+func (profiler *Profiler) WriteFolded(w io.Writer) error {
+	profiler.mu.Lock()
+	defer profiler.mu.Unlock()
+
+	stacks := make([]string, 0, len(profiler.stacks))
+	for stack := range profiler.stacks {
+		stacks = append(stacks, stack)
+	}
+
+	slices.Sort(stacks)
+
+	for _, stack := range stacks {
+		if _, err := fmt.Fprintf(w, "%s %d\n", stack, profiler.stacks[stack].Microseconds()); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	return nil
+}
+
+// WriteSummary writes a table of the n spans with the most total time.
+func (profiler *Profiler) WriteSummary(w io.Writer, n int) error {
+	tw := tabwriter.NewWriter(w, 0, 0, summaryPadding, ' ', 0)
+
+	if _, err := fmt.Fprintln(tw, "TOTAL\tSELF\tCALLS\tAVG\tSPAN\tSUBJECT"); err != nil {
+		return errors.WithStack(err)
+	}
+
+	for _, stat := range profiler.Top(n) {
+		avg := stat.Total / time.Duration(stat.Calls)
+
+		if _, err := fmt.Fprintf(tw, "%s\t%s\t%d\t%s\t%s\t%s\n", formatDuration(stat.Total), formatDuration(stat.Self), stat.Calls, formatDuration(avg), stat.Name, stat.Subject); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	return errors.WithStack(tw.Flush())
+}
+
+func formatDuration(duration time.Duration) string {
+	return duration.Round(time.Microsecond).String()
+}
@@ -0,0 +1,85 @@
+package telemetry_test
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gruntwork-io/terragrunt/telemetry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfiler(t *testing.T) {
+	t.Parallel()
+
+	workingDir := t.TempDir()
+	profiler := telemetry.NewProfiler(workingDir)
+	ctx := telemetry.ContextWithProfiler(context.Background(), profiler)
+
+	configPath := filepath.Join(workingDir, "app", "terragrunt.hcl")
+
+	err := telemetry.Telemetry(ctx, nil, "parse_config_file", map[string]interface{}{telemetry.SubjectAttr: configPath}, func(ctx context.Context) error {
+		for range 2 {
+			err := telemetry.Telemetry(ctx, nil, "function", map[string]interface{}{telemetry.SubjectAttr: "run_cmd"}, func(ctx context.Context) error {
+				time.Sleep(10 * time.Millisecond)
+
+				return nil
+			})
+			require.NoError(t, err)
+		}
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	top := profiler.Top(0)
+	require.Len(t, top, 2)
+
+	assert.Equal(t, "parse_config_file", top[0].Name)
+	assert.Equal(t, filepath.Join("app", "terragrunt.hcl"), top[0].Subject)
+	assert.Equal(t, 1, top[0].Calls)
+	assert.GreaterOrEqual(t, top[0].Total, 20*time.Millisecond)
+	assert.Less(t, top[0].Self, top[0].Total)
+
+	assert.Equal(t, "function", top[1].Name)
+	assert.Equal(t, "run_cmd", top[1].Subject)
+	assert.Equal(t, 2, top[1].Calls)
+	assert.Equal(t, top[1].Total, top[1].Self)
+
+	assert.Len(t, profiler.Top(1), 1)
+
+	var folded bytes.Buffer
+	require.NoError(t, profiler.WriteFolded(&folded))
+
+	lines := strings.Split(strings.TrimSpace(folded.String()), "\n")
+	require.Len(t, lines, 2)
+
+	stack := "parse_config_file " + filepath.Join("app", "terragrunt.hcl")
+	assert.Regexp(t, "^"+stack+` \d+$`, lines[0])
+	assert.Regexp(t, "^"+stack+`;function run_cmd \d+$`, lines[1])
+
+	var summary bytes.Buffer
+	require.NoError(t, profiler.WriteSummary(&summary, 10))
+	assert.Contains(t, summary.String(), "TOTAL")
+	assert.Regexp(t, `\s2\s+\S+\s+function\s+run_cmd`, summary.String())
+}
+
+func TestProfilerDisabled(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, telemetry.ProfilerFromContext(context.Background()))
+
+	called := false
+
+	err := telemetry.Profile(context.Background(), "parse_config_file", nil, func(ctx context.Context) error {
+		called = true
+
+		return nil
+	})
+	require.NoError(t, err)
+	assert.True(t, called)
+}
@@ -73,11 +73,13 @@ func ShutdownTelemetry(ctx context.Context) error {
 	return nil
 }
 
-// Telemetry - collect telemetry from function execution - metrics and traces.
+// Telemetry - collect telemetry from function execution - metrics, traces and profile.
 func Telemetry(ctx context.Context, opts *options.TerragruntOptions, name string, attrs map[string]interface{}, fn func(childCtx context.Context) error) error {
-	// wrap telemetry collection with trace and time metric
-	return Trace(ctx, name, attrs, func(ctx context.Context) error {
-		return Time(ctx, name, attrs, fn)
+	// wrap telemetry collection with profile, trace and time metric
+	return Profile(ctx, name, attrs, func(ctx context.Context) error {
+		return Trace(ctx, name, attrs, func(ctx context.Context) error {
+			return Time(ctx, name, attrs, fn)
+		})
 	})
 }
 
@@ -0,0 +1,11 @@
+errors {
+  retry "script_errors" {
+    retryable_errors   = [".*Script error.*"]
+    max_attempts       = 3
+    sleep_interval_sec = 1
+    backoff            = "exponential"
+    max_sleep_sec      = 2
+    jitter             = true
+    before_retry       = ["init", "-reconfigure"]
+  }
+}
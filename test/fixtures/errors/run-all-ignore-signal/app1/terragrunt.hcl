@@ -0,0 +1,22 @@
+terraform {
+  after_hook "signals" {
+    commands     = ["apply"]
+    execute      = ["sh", "-c", "cp \"$TG_CTX_ERROR_SIGNALS_FILE\" hook-signals.json"]
+    working_dir  = get_terragrunt_dir()
+    run_on_error = true
+  }
+}
+
+errors {
+  ignore "example1" {
+    ignorable_errors = [
+      ".*example1.*",
+    ]
+    message = "Ignoring error example1"
+
+    signals = {
+      safe_to_revert = true
+      message        = "Failed example1"
+    }
+  }
+}
@@ -0,0 +1,7 @@
+variable "upstream_message" {
+  type = string
+}
+
+output "upstream_message" {
+  value = "upstream: ${var.upstream_message}"
+}
@@ -0,0 +1,7 @@
+dependencies {
+  paths = ["../app1"]
+}
+
+inputs = {
+  upstream_message = try(get_error_signals("../app1").message, "no signals")
+}
@@ -0,0 +1,14 @@
+terraform {
+  before_hook "write_output" {
+    commands    = ["apply"]
+    execute     = ["sh", "-c", "echo \"{\\\"greeting\\\": \\\"$GREETING\\\"}\" > before_hook_output.json"]
+    env         = { GREETING = "hello from env" }
+    output_file = "before_hook_output.json"
+  }
+
+  after_hook "read_output" {
+    commands = ["apply"]
+    execute  = ["sh", "-c", "echo \"outputs: $TG_CTX_HOOK_OUTPUTS hook: $TG_CTX_HOOK_NAME\""]
+    env      = { TG_CTX_HOOK_NAME = "overridden" }
+  }
+}
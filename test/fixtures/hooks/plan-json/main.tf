@@ -0,0 +1,12 @@
+variable "bucket_name" {
+  type    = string
+  default = "allowed"
+}
+
+resource "terraform_data" "bucket" {
+  input = var.bucket_name
+}
+
+output "bucket_name" {
+  value = terraform_data.bucket.input
+}
@@ -0,0 +1,7 @@
+terraform {
+  after_hook "policy" {
+    commands  = ["plan"]
+    execute   = ["sh", "-c", "cp \"$TG_CTX_PLAN_JSON_FILE\" \"$TG_CTX_HOOK_NAME.json\" && ! grep -q forbidden \"$TG_CTX_PLAN_JSON_FILE\""]
+    plan_json = true
+  }
+}
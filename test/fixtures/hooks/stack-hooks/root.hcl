@@ -0,0 +1,19 @@
+stack_hooks {
+  before_all "prepare" {
+    commands    = ["apply"]
+    execute     = ["sh", "-c", "echo \"$TG_CTX_HOOK_NAME $TG_CTX_COMMAND\" > before-all.txt"]
+    working_dir = get_parent_terragrunt_dir()
+  }
+
+  after_all "report" {
+    commands    = ["apply"]
+    execute     = ["sh", "-c", "cp \"$TG_CTX_RESULTS_FILE\" results.json"]
+    working_dir = get_parent_terragrunt_dir()
+  }
+
+  on_error_all "alert" {
+    commands    = ["apply"]
+    execute     = ["sh", "-c", "echo failed > on-error-all.txt"]
+    working_dir = get_parent_terragrunt_dir()
+  }
+}
@@ -0,0 +1,8 @@
+terraform {
+  before_hook "slow" {
+    commands = ["apply"]
+    # The hook ignores the interrupt signal, so it is killed after its timeout.
+    execute = ["bash", "-c", "trap '' INT TERM; sleep 30"]
+    timeout = "1s"
+  }
+}
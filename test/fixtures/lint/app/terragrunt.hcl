@@ -0,0 +1,18 @@
+include "root" {
+  path = find_in_parent_folders("root.hcl")
+}
+
+locals {
+  name   = "app"
+  unused = "unused"
+}
+
+generate "provider" {
+  path      = "provider.tf"
+  if_exists = "overwrite"
+  contents  = "# provider"
+}
+
+inputs = {
+  name = local.name
+}
@@ -0,0 +1,7 @@
+variable "name" {
+  type = string
+}
+
+variable "root" {
+  type = string
+}
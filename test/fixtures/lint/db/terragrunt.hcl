@@ -0,0 +1,12 @@
+locals {
+  root = find_in_parent_folders()
+}
+
+dependency "app" {
+  config_path = "../app"
+}
+
+inputs = {
+  name = dependency.app.outputs.name
+  root = local.root
+}
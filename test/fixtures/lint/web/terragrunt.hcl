@@ -0,0 +1,4 @@
+inputs = {
+  name  = "web"
+  extra = "extra"
+}
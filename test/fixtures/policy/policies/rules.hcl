@@ -0,0 +1,17 @@
+rule "prod_prevent_destroy" {
+  description = "Units in prod must set prevent_destroy."
+  condition   = "!unit.path.startsWith('prod/') || (has(config.prevent_destroy) && config.prevent_destroy == true)"
+  attribute   = "prevent_destroy"
+}
+
+rule "remote_state_encryption" {
+  description = "The S3 remote state must be encrypted."
+  condition   = "!has(config.remote_state) || config.remote_state.backend != 's3' || config.remote_state.config.encrypt == true"
+  attribute   = "remote_state"
+}
+
+rule "no_run_cmd" {
+  description = "run_cmd must not be used in configurations."
+  severity    = "warning"
+  condition   = "!('run_cmd' in unit.functions)"
+}
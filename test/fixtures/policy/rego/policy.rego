@@ -0,0 +1,12 @@
+package terragrunt
+
+import rego.v1
+
+deny contains {"msg": "Units in prod must set prevent_destroy.", "attribute": "prevent_destroy"} if {
+	startswith(input.unit.path, "prod/")
+	not input.config.prevent_destroy
+}
+
+warn contains "run_cmd must not be used in configurations." if {
+	"run_cmd" in input.unit.functions
+}
@@ -0,0 +1 @@
+output "ok" { value = "ok" }
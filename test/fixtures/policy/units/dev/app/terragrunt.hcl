@@ -0,0 +1 @@
+prevent_destroy = false
@@ -0,0 +1,15 @@
+prevent_destroy = false
+
+remote_state {
+  backend = "s3"
+  config = {
+    bucket  = "my-bucket"
+    key     = "prod/app/terraform.tfstate"
+    region  = "us-east-1"
+    encrypt = false
+  }
+}
+
+locals {
+  user = run_cmd("--terragrunt-quiet", "echo", "ci")
+}
@@ -0,0 +1,28 @@
+variable "name" {
+  type = string
+
+  validation {
+    condition     = length(var.name) > 2
+    error_message = "The name must be longer than 2 characters."
+  }
+}
+
+variable "node_groups" {
+  type = list(object({
+    name          = string
+    instance_type = string
+    min_size      = optional(number, 1)
+  }))
+}
+
+variable "tags" {
+  type = map(string)
+}
+
+output "node_groups" {
+  value = var.node_groups
+}
+
+variable "region" {
+  type = string
+}
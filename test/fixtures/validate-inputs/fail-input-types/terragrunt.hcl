@@ -0,0 +1,14 @@
+inputs = {
+  name = "ab"
+
+  node_groups = [
+    { name = "a", instance_type = "t3.small" },
+    { name = "b", instance_type = "t3.small", min_size = 2 },
+    { name = "c", instance_type = ["t3.small"] },
+  ]
+
+  tags = {
+    env  = "dev"
+    team = { name = "platform" }
+  }
+}
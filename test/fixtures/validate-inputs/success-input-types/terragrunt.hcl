@@ -0,0 +1,13 @@
+inputs = {
+  name = "app"
+
+  node_groups = [
+    { name = "a", instance_type = "t3.small" },
+    { name = "b", instance_type = "t3.small", min_size = 2 },
+  ]
+
+  tags = {
+    env  = "dev"
+    team = "platform"
+  }
+}
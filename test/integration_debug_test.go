@@ -110,6 +110,24 @@ func TestTerragruntValidateInputs(t *testing.T) {
 	}
 }
 
+func TestTerragruntValidateInputsTypes(t *testing.T) {
+	t.Parallel()
+
+	moduleDir := filepath.Join("fixtures/validate-inputs", "fail-input-types")
+
+	_, stderr, err := helpers.RunTerragruntCommandWithOutput(t, "terragrunt validate-inputs --terragrunt-non-interactive --terragrunt-working-dir "+moduleDir)
+	require.Error(t, err)
+
+	// Both the invalid inputs and the missing inputs are reported.
+	assert.Contains(t, err.Error(), "3 input(s) that do not match their variable and misaligned inputs")
+	assert.Contains(t, stderr, "The name must be longer than 2 characters.")
+	assert.Contains(t, stderr, "The following required inputs are missing")
+	assert.Contains(t, stderr, "- region")
+
+	_, _, err = helpers.RunTerragruntCommandWithOutput(t, "terragrunt validate-inputs --terragrunt-strict-validate --terragrunt-non-interactive --terragrunt-working-dir "+filepath.Join("fixtures/validate-inputs", "success-input-types"))
+	require.NoError(t, err)
+}
+
 func TestTerragruntValidateInputsWithCLIVars(t *testing.T) {
 	t.Parallel()
 
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -18,8 +19,10 @@ const (
 	testIgnoreErrors          = "fixtures/errors/ignore"
 	testIgnoreSignalErrors    = "fixtures/errors/ignore-signal"
 	testRunAllIgnoreErrors    = "fixtures/errors/run-all-ignore"
+	testRunAllIgnoreSignal    = "fixtures/errors/run-all-ignore-signal"
 	testRetryErrors           = "fixtures/errors/retry"
 	testRetryFailErrors       = "fixtures/errors/retry-fail"
+	testRetryBackoffErrors    = "fixtures/errors/retry-backoff"
 	testRunAllErrors          = "fixtures/errors/run-all"
 	testNegativePatternErrors = "fixtures/errors/ignore-negative-pattern"
 	testMultiLineErrors       = "fixtures/errors/multi-line"
@@ -80,6 +83,21 @@ func TestRetryError(t *testing.T) {
 	assert.NotContains(t, stderr, "aws_errors")
 }
 
+func TestRetryBackoffError(t *testing.T) {
+	t.Parallel()
+
+	cleanupTerraformFolder(t, testRetryBackoffErrors)
+	tmpEnvPath := helpers.CopyEnvironment(t, testRetryBackoffErrors)
+	rootPath := util.JoinPath(tmpEnvPath, testRetryBackoffErrors)
+
+	_, stderr, err := helpers.RunTerragruntCommandWithOutput(t, "terragrunt apply -auto-approve --non-interactive --working-dir "+rootPath)
+
+	require.NoError(t, err)
+	assert.Contains(t, stderr, "Encountered retryable error: script_errors")
+	assert.Equal(t, 2, strings.Count(stderr, "Running before retry command: "))
+	assert.Contains(t, stderr, "init -reconfigure")
+}
+
 func TestRetryFailError(t *testing.T) {
 	t.Parallel()
 
@@ -121,6 +139,29 @@ func TestIgnoreSignal(t *testing.T) {
 	assert.Equal(t, "Failed example1", signals.Message, "Unexpected error message")
 }
 
+func TestRunAllIgnoreSignal(t *testing.T) {
+	t.Parallel()
+
+	cleanupTerraformFolder(t, testRunAllIgnoreSignal)
+	tmpEnvPath := helpers.CopyEnvironment(t, testRunAllIgnoreSignal)
+	rootPath := util.JoinPath(tmpEnvPath, testRunAllIgnoreSignal)
+
+	stdout, stderr, err := helpers.RunTerragruntCommandWithOutput(t, "terragrunt run-all apply -auto-approve --terragrunt-non-interactive --terragrunt-working-dir "+rootPath)
+
+	require.NoError(t, err)
+	assert.Contains(t, stderr, "Ignoring error example1")
+	assert.Contains(t, stderr, "Errors were ignored in 1 unit(s)")
+	assert.Contains(t, stderr, `app1: {"message":"Failed example1","safe_to_revert":true}`)
+
+	// The dependent unit reads the signals of the upstream unit
+	assert.Contains(t, stdout, "upstream: Failed example1")
+
+	// The after hook receives the signals file path
+	content, err := os.ReadFile(filepath.Join(rootPath, "app1", "hook-signals.json"))
+	require.NoError(t, err)
+	assert.Contains(t, string(content), `"safe_to_revert": true`)
+}
+
 func TestRunAllError(t *testing.T) {
 	t.Parallel()
 
@@ -10,7 +10,9 @@ import (
 	"testing"
 
 	terragruntinfo "github.com/gruntwork-io/terragrunt/cli/commands/terragrunt-info"
+	"github.com/gruntwork-io/terragrunt/configstack"
 	"github.com/gruntwork-io/terragrunt/test/helpers"
+	"github.com/gruntwork-io/terragrunt/tf"
 	"github.com/gruntwork-io/terragrunt/util"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -34,6 +36,10 @@ const (
 	testFixtureHooksInitOnceWithSourceNoBackendSuppressHookStdout = "fixtures/hooks/init-once/with-source-no-backend-suppress-hook-stdout"
 	testFixtureHooksInitOnceWithSourceWithBackend                 = "fixtures/hooks/init-once/with-source-with-backend"
 	testFixtureTerragruntHookIfParameter                          = "fixtures/hooks/if-parameter"
+	testFixtureHooksEnvAndOutputFile                              = "fixtures/hooks/env-and-output-file"
+	testFixtureHooksTimeout                                       = "fixtures/hooks/timeout"
+	testFixtureHooksPlanJSON                                      = "fixtures/hooks/plan-json"
+	testFixtureHooksStackHooks                                    = "fixtures/hooks/stack-hooks"
 )
 
 func TestTerragruntHookIfParameter(t *testing.T) {
@@ -58,6 +64,107 @@ func TestTerragruntHookIfParameter(t *testing.T) {
 	assert.NotContains(t, output, "skip after hook")
 }
 
+func TestTerragruntHookEnvAndOutputFile(t *testing.T) {
+	t.Parallel()
+
+	helpers.CleanupTerraformFolder(t, testFixtureHooksEnvAndOutputFile)
+	tmpEnvPath := helpers.CopyEnvironment(t, testFixtureHooksEnvAndOutputFile)
+	rootPath := util.JoinPath(tmpEnvPath, testFixtureHooksEnvAndOutputFile)
+
+	var (
+		stdout bytes.Buffer
+		stderr bytes.Buffer
+	)
+
+	err := helpers.RunTerragruntCommand(t, "terragrunt apply -auto-approve --terragrunt-non-interactive --terragrunt-working-dir "+rootPath, &stdout, &stderr)
+
+	require.NoError(t, err)
+
+	// The output of the before hook is passed to the after hook, the `TG_CTX_*` vars cannot be overridden.
+	assert.Contains(t, stdout.String(), `outputs: {"write_output":{"greeting":"hello from env"}} hook: read_output`)
+}
+
+func TestTerragruntHookTimeout(t *testing.T) {
+	t.Parallel()
+
+	helpers.CleanupTerraformFolder(t, testFixtureHooksTimeout)
+	tmpEnvPath := helpers.CopyEnvironment(t, testFixtureHooksTimeout)
+	rootPath := util.JoinPath(tmpEnvPath, testFixtureHooksTimeout)
+
+	var (
+		stdout bytes.Buffer
+		stderr bytes.Buffer
+	)
+
+	err := helpers.RunTerragruntCommand(t, "terragrunt apply -auto-approve --terragrunt-non-interactive --terragrunt-working-dir "+rootPath, &stdout, &stderr)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Hook slow timed out after 1s")
+}
+
+func TestTerragruntStackHooks(t *testing.T) {
+	t.Parallel()
+
+	helpers.CleanupTerraformFolder(t, testFixtureHooksStackHooks)
+	tmpEnvPath := helpers.CopyEnvironment(t, testFixtureHooksStackHooks)
+	rootPath := util.JoinPath(tmpEnvPath, testFixtureHooksStackHooks)
+
+	_, _, err := helpers.RunTerragruntCommandWithOutput(t, "terragrunt run-all apply --non-interactive --working-dir "+rootPath)
+	require.NoError(t, err)
+
+	beforeAll, err := os.ReadFile(filepath.Join(rootPath, "before-all.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "prepare apply\n", string(beforeAll))
+
+	resultsJSON, err := os.ReadFile(filepath.Join(rootPath, "results.json"))
+	require.NoError(t, err)
+
+	var results []configstack.UnitResult
+	require.NoError(t, json.Unmarshal(resultsJSON, &results))
+	require.Len(t, results, 2)
+
+	for _, result := range results {
+		assert.Equal(t, configstack.UnitStatusSucceeded, result.Status)
+	}
+
+	assert.NoFileExists(t, filepath.Join(rootPath, "on-error-all.txt"))
+}
+
+func TestTerragruntPlanJSONHook(t *testing.T) {
+	t.Parallel()
+
+	helpers.CleanupTerraformFolder(t, testFixtureHooksPlanJSON)
+	tmpEnvPath := helpers.CopyEnvironment(t, testFixtureHooksPlanJSON)
+	rootPath := util.JoinPath(tmpEnvPath, testFixtureHooksPlanJSON)
+
+	// The plan file is given as a separate arg of `-out`.
+	_, _, err := helpers.RunTerragruntCommandWithOutput(t, "terragrunt plan --non-interactive --working-dir "+rootPath+" -- -out custom.tfplan")
+	require.NoError(t, err)
+
+	assert.FileExists(t, filepath.Join(rootPath, "custom.tfplan"))
+
+	planJSON, err := os.ReadFile(filepath.Join(rootPath, "policy.json"))
+	require.NoError(t, err)
+	assert.Contains(t, string(planJSON), `"resource_changes"`)
+
+	planJSONInfo, err := os.Stat(filepath.Join(rootPath, tf.TerraformPlanJSONFile))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), planJSONInfo.Mode().Perm())
+
+	// The failing hook blocks apply
+	_, _, err = helpers.RunTerragruntCommandWithOutput(t, "terragrunt apply -auto-approve --non-interactive --working-dir "+rootPath+" -- -var bucket_name=forbidden")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "the plan_json hooks failed")
+
+	// The checked plan is applied, with the value of the planning flags it was created with.
+	_, _, err = helpers.RunTerragruntCommandWithOutput(t, "terragrunt apply -auto-approve --non-interactive --working-dir "+rootPath+" -- -var bucket_name=approved")
+	require.NoError(t, err)
+
+	stdout, _, err := helpers.RunTerragruntCommandWithOutput(t, "terragrunt output -raw bucket_name --non-interactive --working-dir "+rootPath)
+	require.NoError(t, err)
+	assert.Equal(t, "approved", strings.TrimSpace(stdout))
+}
+
 func TestTerragruntBeforeHook(t *testing.T) {
 	t.Parallel()
 
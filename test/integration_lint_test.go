@@ -0,0 +1,142 @@
+package test_test
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/internal/view/diagnostic"
+	"github.com/gruntwork-io/terragrunt/test/helpers"
+	"github.com/gruntwork-io/terragrunt/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testFixtureLint = "fixtures/lint"
+
+func TestLint(t *testing.T) {
+	t.Parallel()
+
+	tmpEnvPath := helpers.CopyEnvironment(t, testFixtureLint)
+	rootPath := util.JoinPath(tmpEnvPath, testFixtureLint)
+
+	stdout, _, err := helpers.RunTerragruntCommandWithOutput(t, "terragrunt lint --format json --working-dir "+rootPath)
+	require.NoError(t, err)
+
+	var diags diagnostic.Diagnostics
+	require.NoError(t, json.Unmarshal([]byte(stdout), &diags))
+
+	issues := make([]string, 0, len(diags))
+	for _, diag := range diags {
+		issues = append(issues, diag.Rule+": "+diag.Summary)
+	}
+
+	assert.Equal(t, []string{
+		`duplicate-generate: Generate block "provider" is also declared in ` + util.JoinPath(rootPath, "root.hcl"),
+		`unused-locals: Unused local "unused"`,
+		`dependency-mock-outputs: Dependency "app" has no mock_outputs`,
+		`deprecated-functions: Deprecated usage of find_in_parent_folders`,
+		`undeclared-inputs: Undeclared input "extra"`,
+	}, issues)
+}
+
+func TestLintRules(t *testing.T) {
+	t.Parallel()
+
+	tmpEnvPath := helpers.CopyEnvironment(t, testFixtureLint)
+	rootPath := util.JoinPath(tmpEnvPath, testFixtureLint)
+
+	stdout, _, err := helpers.RunTerragruntCommandWithOutput(t, "terragrunt lint --format json --rule unused-locals --rule undeclared-inputs --disable-rule undeclared-inputs --working-dir "+rootPath)
+	require.NoError(t, err)
+
+	var diags diagnostic.Diagnostics
+	require.NoError(t, json.Unmarshal([]byte(stdout), &diags))
+	require.Len(t, diags, 1)
+	assert.Equal(t, "unused-locals", diags[0].Rule)
+
+	_, _, err = helpers.RunTerragruntCommandWithOutput(t, "terragrunt lint --rule unknown --working-dir "+rootPath)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `Unknown lint rule "unknown"`)
+}
+
+func TestLintStrictControl(t *testing.T) {
+	t.Parallel()
+
+	tmpEnvPath := helpers.CopyEnvironment(t, testFixtureLint)
+	rootPath := util.JoinPath(tmpEnvPath, testFixtureLint)
+
+	stdout, _, err := helpers.RunTerragruntCommandWithOutput(t, "terragrunt lint --strict-control root-terragrunt-hcl --working-dir "+rootPath)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "1 lint error(s) found")
+	assert.Contains(t, stdout, "Error: Deprecated usage of find_in_parent_folders")
+}
+
+func TestLintSARIF(t *testing.T) {
+	t.Parallel()
+
+	tmpEnvPath := helpers.CopyEnvironment(t, testFixtureLint)
+	rootPath := util.JoinPath(tmpEnvPath, testFixtureLint)
+
+	stdout, _, err := helpers.RunTerragruntCommandWithOutput(t, "terragrunt lint --format sarif --rule unused-locals --working-dir "+rootPath)
+	require.NoError(t, err)
+
+	var log struct {
+		Version string `json:"version"`
+		Runs    []struct {
+			Results []struct {
+				RuleID    string `json:"ruleId"`
+				Level     string `json:"level"`
+				Locations []struct {
+					PhysicalLocation struct {
+						ArtifactLocation struct {
+							URI string `json:"uri"`
+						} `json:"artifactLocation"`
+						Region struct {
+							StartLine int `json:"startLine"`
+						} `json:"region"`
+					} `json:"physicalLocation"`
+				} `json:"locations"`
+			} `json:"results"`
+		} `json:"runs"`
+	}
+
+	require.NoError(t, json.Unmarshal([]byte(stdout), &log))
+	assert.Equal(t, "2.1.0", log.Version)
+	require.Len(t, log.Runs, 1)
+	require.Len(t, log.Runs[0].Results, 1)
+
+	result := log.Runs[0].Results[0]
+	assert.Equal(t, "unused-locals", result.RuleID)
+	assert.Equal(t, "warning", result.Level)
+	require.Len(t, result.Locations, 1)
+	assert.Equal(t, "app/terragrunt.hcl", result.Locations[0].PhysicalLocation.ArtifactLocation.URI)
+	assert.Equal(t, 7, result.Locations[0].PhysicalLocation.Region.StartLine)
+}
+
+func TestLintFix(t *testing.T) {
+	t.Parallel()
+
+	tmpEnvPath := helpers.CopyEnvironment(t, testFixtureLint)
+	rootPath := util.JoinPath(tmpEnvPath, testFixtureLint)
+
+	stdout, _, err := helpers.RunTerragruntCommandWithOutput(t, "terragrunt lint --fix --format json --working-dir "+rootPath)
+	require.NoError(t, err)
+
+	var diags diagnostic.Diagnostics
+	require.NoError(t, json.Unmarshal([]byte(stdout), &diags))
+
+	for _, diag := range diags {
+		assert.NotContains(t, []string{"unused-locals", "duplicate-generate"}, diag.Rule)
+	}
+
+	content, err := os.ReadFile(util.JoinPath(rootPath, "app", "terragrunt.hcl"))
+	require.NoError(t, err)
+	assert.NotContains(t, string(content), "unused")
+	assert.NotContains(t, string(content), "generate")
+	assert.Contains(t, string(content), `name = "app"`)
+
+	// The fixed configuration is free of the issues that can be fixed.
+	stdout, _, err = helpers.RunTerragruntCommandWithOutput(t, "terragrunt lint --format json --rule unused-locals --rule duplicate-generate --working-dir "+rootPath)
+	require.NoError(t, err)
+	assert.Empty(t, stdout)
+}
@@ -0,0 +1,88 @@
+package test_test
+
+import (
+	"encoding/json"
+	"os/exec"
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/internal/view/diagnostic"
+	"github.com/gruntwork-io/terragrunt/test/helpers"
+	"github.com/gruntwork-io/terragrunt/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testFixturePolicy      = "fixtures/policy"
+	testFixturePolicyUnits = "fixtures/policy/units"
+)
+
+func TestPolicyCheck(t *testing.T) {
+	t.Parallel()
+
+	tmpEnvPath := helpers.CopyEnvironment(t, testFixturePolicy)
+	rootPath := util.JoinPath(tmpEnvPath, testFixturePolicyUnits)
+
+	stdout, _, err := helpers.RunTerragruntCommandWithOutput(t, "terragrunt policy check --json --policy-dir ../policies --working-dir "+rootPath)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "2 policy violation(s) found")
+
+	var diags diagnostic.Diagnostics
+	require.NoError(t, json.Unmarshal([]byte(stdout), &diags))
+
+	summaries := make([]string, 0, len(diags))
+	for _, diag := range diags {
+		summaries = append(summaries, diag.Severity.String()+": "+diag.Summary)
+	}
+
+	assert.Equal(t, []string{
+		"warning: Policy no_run_cmd violated by unit prod/app",
+		"error: Policy prod_prevent_destroy violated by unit prod/app",
+		"error: Policy remote_state_encryption violated by unit prod/app",
+	}, summaries)
+
+	require.NotNil(t, diags[1].Range)
+	assert.Equal(t, util.JoinPath(rootPath, "prod/app/terragrunt.hcl"), diags[1].Range.Filename)
+	assert.Equal(t, 1, diags[1].Range.Start.Line)
+
+	// The violations of rules without an attribute point to the unit configuration.
+	require.NotNil(t, diags[0].Range)
+	assert.Equal(t, util.JoinPath(rootPath, "prod/app/terragrunt.hcl"), diags[0].Range.Filename)
+
+	stdout, _, err = helpers.RunTerragruntCommandWithOutput(t, "terragrunt policy check --no-color --policy-dir ../policies --working-dir "+rootPath)
+	require.Error(t, err)
+	assert.Contains(t, stdout, "Warning: Policy no_run_cmd violated by unit prod/app")
+}
+
+func TestRunAllPolicyPreflight(t *testing.T) {
+	t.Parallel()
+
+	tmpEnvPath := helpers.CopyEnvironment(t, testFixturePolicy)
+	rootPath := util.JoinPath(tmpEnvPath, testFixturePolicyUnits)
+
+	stdout, stderr, err := helpers.RunTerragruntCommandWithOutput(t, "terragrunt run-all plan --non-interactive --policy-dir ../policies --working-dir "+rootPath)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "2 policy violation(s) found")
+	assert.Contains(t, stderr, "Policy prod_prevent_destroy violated by unit prod/app")
+
+	// No unit is run when the policies fail.
+	assert.NotContains(t, stdout, "No changes")
+}
+
+func TestPolicyCheckRego(t *testing.T) {
+	t.Parallel()
+
+	if _, err := exec.LookPath("opa"); err != nil {
+		t.Skip("opa binary not found in PATH")
+	}
+
+	tmpEnvPath := helpers.CopyEnvironment(t, testFixturePolicy)
+	rootPath := util.JoinPath(tmpEnvPath, testFixturePolicyUnits)
+
+	stdout, _, err := helpers.RunTerragruntCommandWithOutput(t, "terragrunt policy check --no-color --policy-dir ../rego --working-dir "+rootPath)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "1 policy violation(s) found")
+	assert.Contains(t, stdout, "Error: Policy terragrunt.deny violated by unit prod/app")
+	assert.Contains(t, stdout, "1: prevent_destroy = false")
+	assert.Contains(t, stdout, "Warning: Policy terragrunt.warn violated by unit prod/app")
+}
@@ -742,6 +742,41 @@ func TestHclvalidateInvalidConfigPath(t *testing.T) {
 	assert.ElementsMatch(t, expectedPaths, actualPaths)
 }
 
+func TestHclvalidateFormats(t *testing.T) {
+	t.Parallel()
+
+	helpers.CleanupTerraformFolder(t, testFixtureHclvalidate)
+	tmpEnvPath := helpers.CopyEnvironment(t, testFixtureHclvalidate)
+	rootPath := util.JoinPath(tmpEnvPath, testFixtureHclvalidate)
+
+	stdout, _, err := helpers.RunTerragruntCommandWithOutput(t, "terragrunt hclvalidate --format github --working-dir "+rootPath)
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "::error file=second/c/terragrunt.hcl,line=6,col=19,endLine=6,endColumn=27,title=Unsupported attribute::This object does not have an attribute named \"outputs\".\n")
+
+	stdout, _, err = helpers.RunTerragruntCommandWithOutput(t, "terragrunt hclvalidate --format sarif --working-dir "+rootPath)
+	require.NoError(t, err)
+
+	var log struct {
+		Version string `json:"version"`
+		Runs    []struct {
+			Results []struct {
+				RuleID string `json:"ruleId"`
+				Level  string `json:"level"`
+			} `json:"results"`
+		} `json:"runs"`
+	}
+
+	require.NoError(t, json.Unmarshal([]byte(stdout), &log))
+	assert.Equal(t, "2.1.0", log.Version)
+	require.Len(t, log.Runs, 1)
+	assert.NotEmpty(t, log.Runs[0].Results)
+
+	for _, result := range log.Runs[0].Results {
+		assert.Equal(t, "hcl", result.RuleID)
+		assert.Equal(t, "error", result.Level)
+	}
+}
+
 func TestTerragruntProviderCacheMultiplePlatforms(t *testing.T) {
 	t.Parallel()
 
@@ -51,6 +51,17 @@ func WithProviderService(service *services.ProviderService) Option {
 	}
 }
 
+// WithSharedProviderService sets the provider service shared by successive servers, such as the servers of `provider-cache serve`
+// rebuilt on each reload. The service is run by the caller rather than by the server, so that the pending caching requests outlive the server.
+func WithSharedProviderService(service *services.ProviderService) Option {
+	return func(cfg Config) Config {
+		cfg.providerService = service
+		cfg.sharedProviderService = true
+
+		return cfg
+	}
+}
+
 func WithProviderHandlers(handlers ...handlers.ProviderHandler) Option {
 	return func(cfg Config) Config {
 		cfg.providerHandlers = handlers
@@ -86,6 +97,7 @@ type Config struct {
 	shutdownTimeout time.Duration
 
 	providerService             *services.ProviderService
+	sharedProviderService       bool
 	providerHandlers            handlers.ProviderHandlers
 	proxyProviderHandler        *handlers.ProxyProviderHandler
 	cacheProviderHTTPStatusCode int
@@ -0,0 +1,62 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gruntwork-io/terragrunt/tf/cache/models"
+	"github.com/gruntwork-io/terragrunt/tf/cache/router"
+	"github.com/gruntwork-io/terragrunt/tf/cache/services"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// URL path to this controller
+	cachePath = "/cache"
+)
+
+// CacheController allows Terragrunt processes that share a single cache server to retrieve the providers cached for their `cache_request_id`.
+// In-process clients call `ProviderService.WaitForCacheReady` directly, this endpoint does the same over HTTP.
+type CacheController struct {
+	*router.Router
+
+	AuthMiddleware  echo.MiddlewareFunc
+	ProviderService *services.ProviderService
+}
+
+// Register implements router.Controller.Register
+func (controller *CacheController) Register(router *router.Router) {
+	controller.Router = router.Group(cachePath)
+
+	if controller.AuthMiddleware != nil {
+		controller.Use(controller.AuthMiddleware)
+	}
+
+	controller.GET("/:cache_request_id", controller.getCachedProvidersAction)
+}
+
+func (controller *CacheController) getCachedProvidersAction(ctx echo.Context) error {
+	cacheRequestID := ctx.Param("cache_request_id")
+
+	providers, err := controller.ProviderService.WaitForCacheReady(cacheRequestID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	cachedProviders := make(models.CachedProviders, 0, len(providers))
+
+	for _, provider := range providers {
+		documentSHA256Sums, err := provider.DocumentSHA256Sums(ctx.Request().Context())
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
+
+		cachedProviders = append(cachedProviders, &models.CachedProvider{
+			Address:            provider.Address(),
+			Version:            provider.Version(),
+			PackageDir:         provider.PackageDir(),
+			DocumentSHA256Sums: documentSHA256Sums,
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, cachedProviders)
+}
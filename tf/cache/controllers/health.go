@@ -0,0 +1,37 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gruntwork-io/terragrunt/tf/cache/router"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	healthPath = "/health"
+
+	healthStatusOK = "ok"
+)
+
+// HealthController exposes an unauthenticated endpoint that reports whether the cache server is up and running.
+// It is primarily used to check the state of a long-running server started with `terragrunt provider-cache serve`.
+type HealthController struct {
+	*router.Router
+}
+
+// Register implements router.Controller.Register
+func (controller *HealthController) Register(router *router.Router) {
+	controller.Router = router.Group(healthPath)
+
+	controller.GET("", controller.healthAction)
+}
+
+func (controller *HealthController) healthAction(ctx echo.Context) error {
+	status := struct {
+		Status string `json:"status"`
+	}{
+		Status: healthStatusOK,
+	}
+
+	return ctx.JSON(http.StatusOK, status)
+}
@@ -60,9 +60,10 @@ func (controller *ProviderController) Register(router *router.Router) {
 
 func (controller *ProviderController) getVersionsAction(ctx echo.Context) error {
 	var (
-		registryName = ctx.Param("registry_name")
-		namespace    = ctx.Param("namespace")
-		name         = ctx.Param("name")
+		registryName   = ctx.Param("registry_name")
+		namespace      = ctx.Param("namespace")
+		name           = ctx.Param("name")
+		cacheRequestID = ctx.Param("cache_request_id")
 	)
 
 	provider := &models.Provider{
@@ -86,6 +87,11 @@ func (controller *ProviderController) getVersionsAction(ctx echo.Context) error
 		}
 	}
 
+	// In offline mode, remember the provider to report it to the client, since Terraform itself only fails with a generic error.
+	if len(allVersions) == 0 && cacheRequestID != "" && controller.ProviderService.Offline() {
+		controller.ProviderService.AddMissingProvider(cacheRequestID, provider)
+	}
+
 	versions := struct {
 		ID       string          `json:"id"`
 		Versions models.Versions `json:"versions"`
@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"github.com/gruntwork-io/terragrunt/tf/cache/models"
+	"github.com/gruntwork-io/terragrunt/util"
+)
+
+var _ ProviderHandler = new(CacheDirProviderHandler)
+
+// CacheDirProviderHandler serves the providers that are already unpacked in a directory with the same layout as the plugin cache dir,
+// e.g. the Terragrunt provider cache directory or the user plugins directory. It never reaches out to the network.
+type CacheDirProviderHandler struct {
+	*CommonProviderHandler
+
+	cacheDir string
+}
+
+func NewCacheDirProviderHandler(logger log.Logger, cacheDir string) *CacheDirProviderHandler {
+	return &CacheDirProviderHandler{
+		CommonProviderHandler: NewCommonProviderHandler(logger, nil, nil),
+		cacheDir:              cacheDir,
+	}
+}
+
+func (handler *CacheDirProviderHandler) String() string {
+	return "cache_dir '" + handler.cacheDir + "'"
+}
+
+// DiscoveryURL implements ProviderHandler.DiscoveryURL
+func (handler *CacheDirProviderHandler) DiscoveryURL(_ context.Context, _ string) (*RegistryURLs, error) {
+	return DefaultRegistryURLs, nil
+}
+
+// GetVersions implements ProviderHandler.GetVersions
+func (handler *CacheDirProviderHandler) GetVersions(_ context.Context, provider *models.Provider) (models.Versions, error) {
+	versionDirs, err := readSubdirs(filepath.Join(handler.cacheDir, provider.Address()))
+	if err != nil {
+		return nil, err
+	}
+
+	var versions = make(models.Versions, 0, len(versionDirs))
+
+	for _, version := range versionDirs {
+		platformDirs, err := readSubdirs(filepath.Join(handler.cacheDir, provider.Address(), version))
+		if err != nil {
+			return nil, err
+		}
+
+		var platforms models.Platforms
+
+		for _, platform := range platformDirs {
+			if goos, goarch, ok := strings.Cut(platform, "_"); ok {
+				platforms = append(platforms, &models.Platform{OS: goos, Arch: goarch})
+			}
+		}
+
+		if len(platforms) > 0 {
+			versions = append(versions, &models.Version{
+				Version:   version,
+				Platforms: platforms,
+			})
+		}
+	}
+
+	return versions, nil
+}
+
+// GetPlatform implements ProviderHandler.GetPlatform
+func (handler *CacheDirProviderHandler) GetPlatform(_ context.Context, provider *models.Provider) (*models.ResponseBody, error) {
+	packageDir := filepath.Join(handler.cacheDir, provider.Address(), provider.Version, provider.Platform())
+
+	if !util.FileExists(packageDir) {
+		return nil, nil
+	}
+
+	return &models.ResponseBody{
+		Platform:    models.Platform{OS: provider.OS, Arch: provider.Arch},
+		DownloadURL: packageDir,
+	}, nil
+}
+
+func readSubdirs(dir string) ([]string, error) {
+	if !util.FileExists(dir) {
+		return nil, nil
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, errors.New(err)
+	}
+
+	var names []string
+
+	for _, entry := range entries {
+		// The user plugins directory may contain symlinks to the unpacked providers.
+		if entry.IsDir() || entry.Type()&os.ModeSymlink != 0 {
+			names = append(names, entry.Name())
+		}
+	}
+
+	return names, nil
+}
@@ -0,0 +1,55 @@
+package handlers_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"github.com/gruntwork-io/terragrunt/tf/cache/handlers"
+	"github.com/gruntwork-io/terragrunt/tf/cache/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheDirProviderHandler(t *testing.T) {
+	t.Parallel()
+
+	cacheDir := t.TempDir()
+
+	for _, dir := range []string{
+		"registry.terraform.io/hashicorp/aws/5.36.0/linux_amd64",
+		"registry.terraform.io/hashicorp/aws/5.36.0/darwin_arm64",
+		"registry.terraform.io/hashicorp/aws/5.37.0/linux_amd64",
+	} {
+		require.NoError(t, os.MkdirAll(filepath.Join(cacheDir, dir), os.ModePerm))
+	}
+
+	ctx := context.Background()
+	handler := handlers.NewCacheDirProviderHandler(log.New(), cacheDir)
+
+	versions, err := handler.GetVersions(ctx, models.ParseProvider("registry.terraform.io/hashicorp/aws"))
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	assert.Equal(t, "5.36.0", versions[0].Version)
+	assert.Len(t, versions[0].Platforms, 2)
+
+	versions, err = handler.GetVersions(ctx, models.ParseProvider("registry.terraform.io/hashicorp/template"))
+	require.NoError(t, err)
+	assert.Empty(t, versions)
+
+	provider := models.ParseProvider("registry.terraform.io/hashicorp/aws")
+	provider.Version, provider.OS, provider.Arch = "5.37.0", "linux", "amd64"
+
+	resp, err := handler.GetPlatform(ctx, provider)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	assert.Equal(t, filepath.Join(cacheDir, "registry.terraform.io/hashicorp/aws/5.37.0/linux_amd64"), resp.DownloadURL)
+
+	provider.Arch = "arm64"
+
+	resp, err = handler.GetPlatform(ctx, provider)
+	require.NoError(t, err)
+	assert.Nil(t, resp)
+}
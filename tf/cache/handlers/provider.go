@@ -4,6 +4,7 @@ package handlers
 import (
 	"context"
 
+	"github.com/gruntwork-io/terragrunt/internal/errors"
 	"github.com/gruntwork-io/terragrunt/pkg/log"
 	"github.com/gruntwork-io/terragrunt/tf/cache/models"
 	"github.com/gruntwork-io/terragrunt/tf/cliconfig"
@@ -68,6 +69,76 @@ func NewProviderHandlers(cliCfg *cliconfig.Config, logger log.Logger, registryNa
 	return providerHandlers, nil
 }
 
+// GetVersions returns all versions of the given provider from all handlers that can serve it.
+func (handlers ProviderHandlers) GetVersions(ctx context.Context, provider *models.Provider) (models.Versions, error) {
+	var (
+		allVersions models.Versions
+		errs        = &errors.MultiError{}
+	)
+
+	for _, handler := range handlers {
+		if !handler.CanHandleProvider(provider) {
+			continue
+		}
+
+		versions, err := handler.GetVersions(ctx, provider)
+		if err != nil {
+			errs = errs.Append(errors.Errorf("failed to get provider versions from %q: %w", handler, err))
+			continue
+		}
+
+		allVersions = append(allVersions, versions...)
+	}
+
+	if len(allVersions) > 0 {
+		return allVersions, nil
+	}
+
+	return nil, errs.ErrorOrNil()
+}
+
+// GetPlatform returns the details of the given provider platform from the first handler that can serve it.
+func (handlers ProviderHandlers) GetPlatform(ctx context.Context, provider *models.Provider) (*models.ResponseBody, error) {
+	errs := &errors.MultiError{}
+
+	for _, handler := range handlers {
+		if !handler.CanHandleProvider(provider) {
+			continue
+		}
+
+		resp, err := handler.GetPlatform(ctx, provider)
+		if err != nil {
+			errs = errs.Append(errors.Errorf("failed to get provider platform from %q: %w", handler, err))
+			continue
+		}
+
+		if resp != nil {
+			return resp, nil
+		}
+	}
+
+	return nil, errs.ErrorOrNil()
+}
+
+// Offline returns only the handlers that do not need network access, i.e. the filesystem mirrors,
+// preceded by the handlers serving the providers already unpacked in the given `cacheDirs`.
+func (handlers ProviderHandlers) Offline(logger log.Logger, cacheDirs ...string) ProviderHandlers {
+	offlineHandlers := make(ProviderHandlers, 0, len(handlers)+len(cacheDirs))
+
+	for _, cacheDir := range cacheDirs {
+		offlineHandlers = append(offlineHandlers, NewCacheDirProviderHandler(logger, cacheDir))
+	}
+
+	for _, handler := range handlers {
+		if handler, ok := handler.(*FilesystemMirrorProviderHandler); ok {
+			// A filesystem mirror may also have the unpacked layout, e.g. populated by `terragrunt provider-cache mirror`.
+			offlineHandlers = append(offlineHandlers, handler, NewCacheDirProviderHandler(logger, handler.filesystemMirrorPath))
+		}
+	}
+
+	return offlineHandlers
+}
+
 // DiscoveryURL looks for the first handler that can handle the given `registryName`,
 // which is determined by the include and exclude settings in the `.terraformrc` CLI config file.
 // If the handler is found, tries to discover its API endpoints otherwise return the default registry URLs.
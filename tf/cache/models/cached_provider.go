@@ -0,0 +1,11 @@
+package models
+
+type CachedProviders []*CachedProvider
+
+// CachedProvider represents a provider that is already cached by the server, as it is returned to the Terragrunt processes sharing the server.
+type CachedProvider struct {
+	Address            string `json:"address"`
+	Version            string `json:"version"`
+	PackageDir         string `json:"package_dir"`
+	DocumentSHA256Sums []byte `json:"document_sha256_sums,omitempty"`
+}
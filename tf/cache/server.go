@@ -22,6 +22,7 @@ type Server struct {
 
 	services           []services.Service
 	ProviderController *controllers.ProviderController
+	CacheController    *controllers.CacheController
 }
 
 // NewServer returns a new Server instance.
@@ -45,23 +46,36 @@ func NewServer(opts ...Option) *Server {
 		Logger:                      cfg.logger,
 	}
 
+	cacheController := &controllers.CacheController{
+		AuthMiddleware:  authMiddleware,
+		ProviderService: cfg.providerService,
+	}
+
 	discoveryController := &controllers.DiscoveryController{
 		Endpointers: []controllers.Endpointer{providerController},
 	}
 
+	healthController := &controllers.HealthController{}
+
 	rootRouter := router.New()
 	rootRouter.Use(middleware.Logger(cfg.logger))
 	rootRouter.Use(middleware.Recover(cfg.logger))
-	rootRouter.Register(discoveryController, downloaderController)
+	rootRouter.Register(discoveryController, downloaderController, healthController)
 
 	v1Group := rootRouter.Group("v1")
-	v1Group.Register(providerController)
+	v1Group.Register(providerController, cacheController)
+
+	serverServices := []services.Service{cfg.providerService}
+	if cfg.sharedProviderService {
+		serverServices = nil
+	}
 
 	return &Server{
 		Router:             rootRouter,
 		Config:             cfg,
-		services:           []services.Service{cfg.providerService},
+		services:           serverServices,
 		ProviderController: providerController,
+		CacheController:    cacheController,
 	}
 }
 
@@ -104,10 +118,11 @@ func (server *Server) Run(ctx context.Context, ln net.Listener) error {
 		<-ctx.Done()
 		server.logger.Infof("Shutting down Terragrunt Cache server...")
 
-		ctx, cancel := context.WithTimeout(ctx, server.shutdownTimeout)
+		// The shutdown context is not derived from `ctx`, which is already done, so that the in-flight requests can complete.
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), server.shutdownTimeout)
 		defer cancel()
 
-		if err := server.Shutdown(ctx); err != nil {
+		if err := server.Shutdown(shutdownCtx); err != nil {
 			return errors.New(err)
 		}
 
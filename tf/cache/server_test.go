@@ -0,0 +1,113 @@
+package cache_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"github.com/gruntwork-io/terragrunt/tf/cache"
+	"github.com/gruntwork-io/terragrunt/tf/cache/services"
+)
+
+func TestServerReloadWithRequestInFlight(t *testing.T) {
+	t.Parallel()
+
+	logger := log.New()
+	providerService := services.NewProviderService(t.TempDir(), "", nil, logger)
+
+	serviceCtx, cancelService := context.WithCancel(context.Background())
+	serviceErrCh := make(chan error, 1)
+
+	go func() {
+		serviceErrCh <- providerService.Run(serviceCtx)
+	}()
+
+	server := cache.NewServer(cache.WithSharedProviderService(providerService), cache.WithLogger(logger))
+
+	started, release := make(chan struct{}), make(chan struct{})
+
+	server.GET("/slow", func(ctx echo.Context) error {
+		close(started)
+		<-release
+
+		return ctx.String(http.StatusOK, "done")
+	})
+
+	ln, err := server.Listen()
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	runErrCh := make(chan error, 1)
+
+	go func() {
+		runErrCh <- server.Run(ctx, ln)
+	}()
+
+	addr := ln.Addr().String()
+	bodyCh := make(chan string, 1)
+
+	go func() {
+		resp, err := http.Get("http://" + addr + "/slow") //nolint:noctx
+		if err != nil {
+			bodyCh <- err.Error()
+			return
+		}
+		defer resp.Body.Close()
+
+		body, _ := io.ReadAll(resp.Body)
+		bodyCh <- string(body)
+	}()
+
+	// The server is shut down, as on a reload, while the request is in flight.
+	<-started
+	cancel()
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+
+	require.NoError(t, <-runErrCh)
+	assert.Equal(t, "done", <-bodyCh)
+
+	// The next server shares the provider service, which is still running, and listens on the same address.
+	_, port, err := net.SplitHostPort(addr)
+	require.NoError(t, err)
+
+	portNum, err := net.LookupPort("tcp", port)
+	require.NoError(t, err)
+
+	nextServer := cache.NewServer(cache.WithSharedProviderService(providerService), cache.WithPort(portNum), cache.WithLogger(logger))
+
+	nextLn, err := nextServer.Listen()
+	require.NoError(t, err)
+
+	nextCtx, nextCancel := context.WithCancel(context.Background())
+	nextRunErrCh := make(chan error, 1)
+
+	go func() {
+		nextRunErrCh <- nextServer.Run(nextCtx, nextLn)
+	}()
+
+	resp, err := http.Get("http://" + addr + "/health") //nolint:noctx
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	select {
+	case err := <-serviceErrCh:
+		t.Fatalf("the provider service stopped with the server: %v", err)
+	default:
+	}
+
+	nextCancel()
+	require.NoError(t, <-nextRunErrCh)
+
+	cancelService()
+	require.NoError(t, <-serviceErrCh)
+}
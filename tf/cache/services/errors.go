@@ -0,0 +1,21 @@
+package services
+
+import (
+	"strings"
+
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+)
+
+// ErrProviderNotCached is returned in offline mode for a provider that is neither in the cache directory, nor in the user plugins directory, nor in a filesystem mirror.
+var ErrProviderNotCached = errors.New("provider is not available offline")
+
+// MissingProvidersError lists all providers that could not be served in offline mode.
+type MissingProvidersError struct {
+	Providers []string
+}
+
+func (err MissingProvidersError) Error() string {
+	return "the following providers are not available in the provider cache or filesystem mirrors:\n  " +
+		strings.Join(err.Providers, "\n  ") +
+		"\npopulate a filesystem mirror with `terragrunt provider-cache mirror` or disable the offline mode"
+}
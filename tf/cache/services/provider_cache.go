@@ -195,7 +195,8 @@ func (cache *ProviderCache) addRequestID(requestID string) {
 
 // warmUp checks if the required provider already exists in the cache directory, if not:
 // 1. Checks if the required provider exists in the user plugins directory, located at %APPDATA%\terraform.d\plugins on Windows and ~/.terraform.d/plugins on other systems. If so, creates a symlink to this folder. (Some providers are not available for darwin_arm64, in this case we can use https://github.com/kreuzwerker/m1-terraform-provider-helper which compiles and saves providers to the user plugins directory)
-// 2. Downloads the provider from the original registry, unpacks and saves it into the cache directory.
+// 2. Checks if the required provider is unpacked in a local filesystem mirror. If so, creates a symlink to this folder.
+// 3. Downloads the provider from the original registry, or takes its archive from a local filesystem mirror, unpacks and saves it into the cache directory.
 func (cache *ProviderCache) warmUp(ctx context.Context) error {
 	if util.FileExists(cache.packageDir) {
 		return nil
@@ -205,7 +206,7 @@ func (cache *ProviderCache) warmUp(ctx context.Context) error {
 		return errors.New(err)
 	}
 
-	if util.FileExists(cache.userProviderDir) {
+	if cache.userCacheDir != "" && util.FileExists(cache.userProviderDir) {
 		cache.logger.Debugf("Create symlink file %s to %s", cache.packageDir, cache.userProviderDir)
 
 		if err := os.Symlink(cache.userProviderDir, cache.packageDir); err != nil {
@@ -217,10 +218,28 @@ func (cache *ProviderCache) warmUp(ctx context.Context) error {
 		return nil
 	}
 
+	// In offline mode, the provider can only be taken from a local filesystem mirror.
+	if cache.offline && (cache.ResponseBody == nil || !util.FileExists(cache.DownloadURL)) {
+		return ErrProviderNotCached
+	}
+
 	if cache.DownloadURL == "" {
 		return errors.Errorf("not found provider download url")
 	}
 
+	// The filesystem mirrors with the unpacked layout, such as the provider cache directory of another machine, hold the provider already unpacked.
+	if util.IsDir(cache.DownloadURL) {
+		cache.logger.Debugf("Create symlink file %s to %s", cache.packageDir, cache.DownloadURL)
+
+		if err := os.Symlink(cache.DownloadURL, cache.packageDir); err != nil {
+			return errors.New(err)
+		}
+
+		cache.logger.Infof("Cached %s from filesystem mirror", cache.Provider)
+
+		return nil
+	}
+
 	if util.FileExists(cache.DownloadURL) {
 		cache.archivePath = cache.DownloadURL
 	} else {
@@ -259,12 +278,13 @@ func (cache *ProviderCache) newRequest(ctx context.Context, url string) (*http.R
 		return nil, errors.New(err)
 	}
 
-	if cache.credsSource == nil {
+	credsSource := cache.credentialsSource()
+	if credsSource == nil {
 		return req, nil
 	}
 
 	hostname := svchost.Hostname(req.URL.Hostname())
-	if creds := cache.credsSource.ForHost(hostname); creds != nil {
+	if creds := credsSource.ForHost(hostname); creds != nil {
 		creds.PrepareRequest(req)
 	}
 
@@ -314,8 +334,16 @@ type ProviderService struct {
 
 	cacheMu      sync.RWMutex
 	cacheReadyMu sync.RWMutex
+	// missingMu guards missingProviders. It is not cacheMu, which is held while a caching starts, and thus while cacheReadyMu is awaited.
+	missingMu sync.Mutex
 
 	credsSource *cliconfig.CredentialsSource
+	credsMu     sync.RWMutex
+
+	// offline prevents reaching out to the registries, only cached providers and filesystem mirrors are used.
+	offline bool
+	// missingProviders are the providers, by the request ID, that could not be found at all in offline mode.
+	missingProviders map[string][]*models.Provider
 
 	logger log.Logger
 }
@@ -326,10 +354,44 @@ func NewProviderService(cacheDir, userCacheDir string, credsSource *cliconfig.Cr
 		userCacheDir:          userCacheDir,
 		providerCacheWarmUpCh: make(chan *ProviderCache),
 		credsSource:           credsSource,
+		missingProviders:      make(map[string][]*models.Provider),
 		logger:                logger,
 	}
 }
 
+// SetCredentialsSource sets the credentials used to download the providers, such as when the CLI config is reloaded.
+func (service *ProviderService) SetCredentialsSource(credsSource *cliconfig.CredentialsSource) {
+	service.credsMu.Lock()
+	defer service.credsMu.Unlock()
+
+	service.credsSource = credsSource
+}
+
+func (service *ProviderService) credentialsSource() *cliconfig.CredentialsSource {
+	service.credsMu.RLock()
+	defer service.credsMu.RUnlock()
+
+	return service.credsSource
+}
+
+// SetOffline enables or disables the offline mode, in which the service serves only the providers that are already cached or available in filesystem mirrors.
+func (service *ProviderService) SetOffline(offline bool) {
+	service.offline = offline
+}
+
+// Offline returns true if the offline mode is enabled.
+func (service *ProviderService) Offline() bool {
+	return service.offline
+}
+
+// AddMissingProvider records the provider requested with the given `requestID` for which no versions are available in offline mode.
+func (service *ProviderService) AddMissingProvider(requestID string, provider *models.Provider) {
+	service.missingMu.Lock()
+	defer service.missingMu.Unlock()
+
+	service.missingProviders[requestID] = append(service.missingProviders[requestID], provider)
+}
+
 func (service *ProviderService) Logger() log.Logger {
 	return service.logger
 }
@@ -342,10 +404,25 @@ func (service *ProviderService) WaitForCacheReady(requestID string) ([]getprovid
 
 	var (
 		providers []getproviders.Provider
+		missing   []string
 		errs      = &errors.MultiError{}
 	)
 
+	// The missing providers are only reported once, so that the map does not grow for the whole life of a long-running server.
+	service.missingMu.Lock()
+	for _, provider := range service.missingProviders[requestID] {
+		missing = append(missing, provider.String())
+	}
+
+	delete(service.missingProviders, requestID)
+	service.missingMu.Unlock()
+
 	for _, provider := range service.providerCaches.FindByRequestID(requestID) {
+		if errors.Is(provider.err, ErrProviderNotCached) {
+			missing = append(missing, fmt.Sprintf("%s (%s)", provider.Provider, provider.Platform()))
+			continue
+		}
+
 		if provider.err != nil {
 			errs = errs.Append(fmt.Errorf("unable to cache provider: %s, err: %w", provider, provider.err))
 		}
@@ -355,6 +432,10 @@ func (service *ProviderService) WaitForCacheReady(requestID string) ([]getprovid
 		}
 	}
 
+	if len(missing) > 0 {
+		errs = errs.Append(errors.New(MissingProvidersError{Providers: missing}))
+	}
+
 	return providers, errs.ErrorOrNil()
 }
 
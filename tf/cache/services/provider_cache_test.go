@@ -0,0 +1,120 @@
+package services_test
+
+import (
+	"archive/zip"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"github.com/gruntwork-io/terragrunt/tf/cache/handlers"
+	"github.com/gruntwork-io/terragrunt/tf/cache/models"
+	"github.com/gruntwork-io/terragrunt/tf/cache/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+)
+
+func TestProviderServiceOfflineWarmUp(t *testing.T) {
+	t.Parallel()
+
+	mirrorDir := t.TempDir()
+
+	// The `null` provider is unpacked in the mirror, as in the provider cache directory.
+	unpackedDir := filepath.Join(mirrorDir, "registry.terraform.io/hashicorp/null/3.2.3/linux_amd64")
+	require.NoError(t, os.MkdirAll(unpackedDir, os.ModePerm))
+	require.NoError(t, os.WriteFile(filepath.Join(unpackedDir, "terraform-provider-null_v3.2.3_x5"), []byte("null"), os.ModePerm))
+
+	// The `random` provider is packed in the mirror, as in the directory written by `terraform providers mirror`.
+	archivePath := filepath.Join(mirrorDir, "terraform-provider-random_3.6.0_linux_amd64.zip")
+	writeZipArchive(t, archivePath, "terraform-provider-random_v3.6.0_x5", "random")
+
+	nullProvider := models.ParseProvider("registry.terraform.io/hashicorp/null")
+	nullProvider.Version, nullProvider.OS, nullProvider.Arch = "3.2.3", "linux", "amd64"
+
+	resp, err := handlers.NewCacheDirProviderHandler(log.New(), mirrorDir).GetPlatform(context.Background(), nullProvider)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+
+	nullProvider.ResponseBody = resp
+
+	randomProvider := models.ParseProvider("registry.terraform.io/hashicorp/random")
+	randomProvider.Version, randomProvider.OS, randomProvider.Arch = "3.6.0", "linux", "amd64"
+	randomProvider.ResponseBody = &models.ResponseBody{
+		Filename:    filepath.Base(archivePath),
+		DownloadURL: archivePath,
+	}
+
+	cacheDir := t.TempDir()
+
+	service := services.NewProviderService(cacheDir, "", nil, log.New())
+	service.SetOffline(true)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	errGroup, ctx := errgroup.WithContext(ctx)
+	errGroup.Go(func() error {
+		return service.Run(ctx)
+	})
+
+	requestID := uuid.New().String()
+
+	service.CacheProvider(ctx, requestID, nullProvider)
+	service.CacheProvider(ctx, requestID, randomProvider)
+
+	providers, err := service.WaitForCacheReady(requestID)
+	require.NoError(t, err)
+	assert.Len(t, providers, 2)
+
+	assert.FileExists(t, filepath.Join(cacheDir, "registry.terraform.io/hashicorp/null/3.2.3/linux_amd64/terraform-provider-null_v3.2.3_x5"))
+	assert.FileExists(t, filepath.Join(cacheDir, "registry.terraform.io/hashicorp/random/3.6.0/linux_amd64/terraform-provider-random_v3.6.0_x5"))
+
+	cancel()
+	require.NoError(t, errGroup.Wait())
+
+	// The mirror is left as is.
+	assert.FileExists(t, archivePath)
+	assert.DirExists(t, unpackedDir)
+}
+
+func TestProviderServiceMissingProvidersReportedOnce(t *testing.T) {
+	t.Parallel()
+
+	service := services.NewProviderService(t.TempDir(), "", nil, log.New())
+	service.SetOffline(true)
+
+	requestID := uuid.New().String()
+	service.AddMissingProvider(requestID, models.ParseProvider("registry.terraform.io/hashicorp/null"))
+
+	_, err := service.WaitForCacheReady(requestID)
+
+	var missingErr services.MissingProvidersError
+	require.ErrorAs(t, err, &missingErr)
+	assert.Len(t, missingErr.Providers, 1)
+
+	// The missing providers of the request are forgotten once reported.
+	_, err = service.WaitForCacheReady(requestID)
+	require.NoError(t, err)
+}
+
+func writeZipArchive(t *testing.T, archivePath, filename, content string) {
+	t.Helper()
+
+	file, err := os.Create(archivePath)
+	require.NoError(t, err)
+
+	defer file.Close()
+
+	zipWriter := zip.NewWriter(file)
+
+	writer, err := zipWriter.Create(filename)
+	require.NoError(t, err)
+
+	_, err = writer.Write([]byte(content))
+	require.NoError(t, err)
+
+	require.NoError(t, zipWriter.Close())
+}
@@ -64,7 +64,7 @@ func HashLegacyZipSHAFromSHA(sum [sha256.Size]byte) Hash {
 	return HashSchemeZip.New(hex.EncodeToString(sum[:]))
 }
 
-// PackageHashV1 computes a hash of the contents of the package at the given location using hash algorithm 1. The resulting Hash is guaranteed to have the scheme HashScheme1.
+// PackageHashV1 computes a hash of the contents of the package at the given location, either an unpacked directory or a zip archive, using hash algorithm 1. The resulting Hash is guaranteed to have the scheme HashScheme1.
 func PackageHashV1(path string) (Hash, error) {
 	// We'll first dereference a possible symlink at our PackageDir location, as would be created if this package were linked in from another cache.
 	packageDir, err := filepath.EvalSymlinks(path)
@@ -72,10 +72,20 @@ func PackageHashV1(path string) (Hash, error) {
 		return "", err
 	}
 
-	if fileInfo, err := os.Stat(packageDir); err != nil {
+	fileInfo, err := os.Stat(packageDir)
+	if err != nil {
 		return "", errors.New(err)
-	} else if !fileInfo.IsDir() {
-		return "", errors.Errorf("packageDir is not a directory %q", packageDir)
+	}
+
+	// A provider distribution archive is hashed by its contents, the same as if it were unpacked.
+	if !fileInfo.IsDir() {
+		if filepath.Ext(packageDir) != ".zip" {
+			return "", errors.Errorf("packageDir is neither a directory nor a zip archive %q", packageDir)
+		}
+
+		s, err := dirhash.HashZip(packageDir, dirhash.Hash1)
+
+		return Hash(s), err
 	}
 
 	s, err := dirhash.HashDir(packageDir, "", dirhash.Hash1)
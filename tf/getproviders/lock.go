@@ -22,8 +22,28 @@ import (
 	"github.com/zclconf/go-cty/cty"
 )
 
+// LockfileOption is a functional option for `UpdateLockfile`.
+type LockfileOption func(*lockfileConfig)
+
+type lockfileConfig struct {
+	platformHashes *PlatformHashes
+}
+
+// WithPlatformHashes adds the `h1:` hashes of the packages for other platforms to the lock file, which avoids lock file churn between teams using different platforms.
+func WithPlatformHashes(platformHashes *PlatformHashes) LockfileOption {
+	return func(cfg *lockfileConfig) {
+		cfg.platformHashes = platformHashes
+	}
+}
+
 // UpdateLockfile updates the dependency lock file. If `.terraform.lock.hcl` does not exist, it will be created, otherwise it will be updated.
-func UpdateLockfile(ctx context.Context, workingDir string, providers []Provider) error {
+func UpdateLockfile(ctx context.Context, workingDir string, providers []Provider, opts ...LockfileOption) error {
+	cfg := &lockfileConfig{}
+
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
 	var (
 		filename = filepath.Join(workingDir, tf.TerraformLockFile)
 		file     = hclwrite.NewFile()
@@ -43,7 +63,7 @@ func UpdateLockfile(ctx context.Context, workingDir string, providers []Provider
 		}
 	}
 
-	if err := updateLockfile(ctx, file, providers); err != nil {
+	if err := updateLockfile(ctx, file, providers, cfg); err != nil {
 		return err
 	}
 
@@ -55,7 +75,7 @@ func UpdateLockfile(ctx context.Context, workingDir string, providers []Provider
 	return nil
 }
 
-func updateLockfile(ctx context.Context, file *hclwrite.File, providers []Provider) error {
+func updateLockfile(ctx context.Context, file *hclwrite.File, providers []Provider, cfg *lockfileConfig) error {
 	sort.Slice(providers, func(i, j int) bool {
 		return providers[i].Address() < providers[j].Address()
 	})
@@ -64,7 +84,7 @@ func updateLockfile(ctx context.Context, file *hclwrite.File, providers []Provid
 		providerBlock := file.Body().FirstMatchingBlock("provider", []string{provider.Address()})
 		if providerBlock != nil {
 			// update the existing provider block
-			if err := updateProviderBlock(ctx, providerBlock, provider); err != nil {
+			if err := updateProviderBlock(ctx, providerBlock, provider, cfg); err != nil {
 				return err
 			}
 		} else {
@@ -72,7 +92,7 @@ func updateLockfile(ctx context.Context, file *hclwrite.File, providers []Provid
 			file.Body().AppendNewline()
 			providerBlock = file.Body().AppendNewBlock("provider", []string{provider.Address()})
 
-			if err := updateProviderBlock(ctx, providerBlock, provider); err != nil {
+			if err := updateProviderBlock(ctx, providerBlock, provider, cfg); err != nil {
 				return err
 			}
 		}
@@ -82,7 +102,7 @@ func updateLockfile(ctx context.Context, file *hclwrite.File, providers []Provid
 }
 
 // updateProviderBlock updates the provider block in the dependency lock file.
-func updateProviderBlock(ctx context.Context, providerBlock *hclwrite.Block, provider Provider) error {
+func updateProviderBlock(ctx context.Context, providerBlock *hclwrite.Block, provider Provider, cfg *lockfileConfig) error {
 	hashes, err := getExistingHashes(providerBlock, provider)
 	if err != nil {
 		return err
@@ -110,6 +130,15 @@ func updateProviderBlock(ctx context.Context, providerBlock *hclwrite.Block, pro
 		newHashes = append(newHashes, zipHashes...)
 	}
 
+	if cfg.platformHashes != nil {
+		platformHashes, err := cfg.platformHashes.Hashes(ctx, provider)
+		if err != nil {
+			return err
+		}
+
+		newHashes = append(newHashes, platformHashes...)
+	}
+
 	// merge with existing hashes
 	for _, newHashe := range newHashes {
 		if !util.ListContainsElement(hashes, newHashe) {
@@ -0,0 +1,168 @@
+package getproviders
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/util"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+)
+
+// LockedProviders is a list of providers recorded in one or more dependency lock files.
+type LockedProviders []*LockedProvider
+
+// Find returns the locked provider with the given address and version, if it exists.
+func (providers LockedProviders) Find(address, version string) *LockedProvider {
+	for _, provider := range providers {
+		if provider.Address == address && provider.Version == version {
+			return provider
+		}
+	}
+
+	return nil
+}
+
+// HasAddress returns true if any version of the provider with the given address is in the list.
+func (providers LockedProviders) HasAddress(address string) bool {
+	for _, provider := range providers {
+		if provider.Address == address {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Merge adds the given providers to the list, the hashes of the providers with the same address and version are merged.
+func (providers LockedProviders) Merge(others ...*LockedProvider) LockedProviders {
+	for _, other := range others {
+		provider := providers.Find(other.Address, other.Version)
+		if provider == nil {
+			provider = &LockedProvider{Address: other.Address, Version: other.Version}
+			providers = append(providers, provider)
+		}
+
+		for _, hash := range other.Hashes {
+			if !provider.HasHash(hash) {
+				provider.Hashes = append(provider.Hashes, hash)
+			}
+		}
+	}
+
+	return providers
+}
+
+// LockedProvider represents a `provider` block of the dependency lock file `.terraform.lock.hcl`.
+type LockedProvider struct {
+	// Address is a source address of the provider. e.g.: registry.terraform.io/hashicorp/aws
+	Address string
+
+	// Version is the selected version of the provider. e.g.: 5.36.0
+	Version string
+
+	// Hashes are the checksums of the provider packages, in the `h1:` and `zh:` schemes.
+	Hashes []Hash
+}
+
+// HasHash returns true if the given `hash` is recorded for the provider.
+func (provider *LockedProvider) HasHash(hash Hash) bool {
+	for _, existing := range provider.Hashes {
+		if existing == hash {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HashesByScheme returns the recorded hashes that have the given `scheme`.
+func (provider *LockedProvider) HashesByScheme(scheme HashScheme) []Hash {
+	var hashes []Hash
+
+	for _, hash := range provider.Hashes {
+		if len(hash) > len(scheme) && Hash(scheme) == hash[:len(scheme)] {
+			hashes = append(hashes, hash)
+		}
+	}
+
+	return hashes
+}
+
+// ParseLockfile reads the providers recorded in the given dependency lock file.
+func ParseLockfile(filename string) (LockedProviders, error) {
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, errors.New(err)
+	}
+
+	file, diags := hclwrite.ParseConfig(content, filename, hcl.Pos{Line: 1, Column: 1})
+	if diags.HasErrors() {
+		return nil, errors.New(diags)
+	}
+
+	var providers LockedProviders
+
+	for _, block := range file.Body().Blocks() {
+		if block.Type() != "provider" || len(block.Labels()) == 0 {
+			continue
+		}
+
+		provider := &LockedProvider{
+			Address: block.Labels()[0],
+		}
+
+		if attr := block.Body().GetAttribute("version"); attr != nil {
+			provider.Version = getAttributeValueAsUnquotedString(attr)
+		}
+
+		if attr := block.Body().GetAttribute("hashes"); attr != nil {
+			vals, err := getAttributeValueAsSlice(attr)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, val := range vals {
+				provider.Hashes = append(provider.Hashes, Hash(val))
+			}
+		}
+
+		providers = append(providers, provider)
+	}
+
+	return providers, nil
+}
+
+// FindLockfiles recursively looks for the dependency lock files in the given `dir`,
+// skipping the Terragrunt cache directories and the `.terraform` directories, which contain the lock files of downloaded modules.
+func FindLockfiles(dir string) ([]string, error) {
+	var filenames []string
+
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() {
+			switch entry.Name() {
+			case util.TerragruntCacheDir, ".terraform", ".git":
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if entry.Name() == util.TerraformLockFile {
+			filenames = append(filenames, path)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.New(err)
+	}
+
+	return filenames, nil
+}
@@ -0,0 +1,59 @@
+package getproviders_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/tf/getproviders"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLockfile(t *testing.T) {
+	t.Parallel()
+
+	content := `
+# This file is maintained automatically by "terraform init".
+
+provider "registry.terraform.io/hashicorp/aws" {
+  version     = "5.36.0"
+  constraints = "5.36.0"
+  hashes = [
+    "h1:54QgAU2vY65WZsiZ9FligQfIf7hQUvwse4ezMwVMwgg=",
+    "zh:0da8409db879b2c400a7d9ed1311ba6d9eb1374ea08779eaf0c5ad0af00ac558",
+  ]
+}
+
+provider "registry.terraform.io/hashicorp/template" {
+  version = "2.2.0"
+}
+`
+
+	filename := filepath.Join(t.TempDir(), ".terraform.lock.hcl")
+	require.NoError(t, os.WriteFile(filename, []byte(content), 0644))
+
+	providers, err := getproviders.ParseLockfile(filename)
+	require.NoError(t, err)
+	require.Len(t, providers, 2)
+
+	aws := providers.Find("registry.terraform.io/hashicorp/aws", "5.36.0")
+	require.NotNil(t, aws)
+	assert.Equal(t, []getproviders.Hash{"h1:54QgAU2vY65WZsiZ9FligQfIf7hQUvwse4ezMwVMwgg="}, aws.HashesByScheme(getproviders.HashScheme1))
+	assert.Equal(t, []getproviders.Hash{"zh:0da8409db879b2c400a7d9ed1311ba6d9eb1374ea08779eaf0c5ad0af00ac558"}, aws.HashesByScheme(getproviders.HashSchemeZip))
+
+	template := providers.Find("registry.terraform.io/hashicorp/template", "2.2.0")
+	require.NotNil(t, template)
+	assert.Empty(t, template.Hashes)
+
+	merged := providers.Merge(&getproviders.LockedProvider{
+		Address: "registry.terraform.io/hashicorp/template",
+		Version: "2.2.0",
+		Hashes:  []getproviders.Hash{"h1:abc="},
+	}, &getproviders.LockedProvider{
+		Address: "registry.terraform.io/hashicorp/aws",
+		Version: "5.37.0",
+	})
+	require.Len(t, merged, 3)
+	assert.Equal(t, []getproviders.Hash{"h1:abc="}, merged.Find("registry.terraform.io/hashicorp/template", "2.2.0").Hashes)
+}
@@ -0,0 +1,134 @@
+package getproviders
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/puzpuzpuz/xsync/v3"
+)
+
+// PackageFetcher downloads provider distribution archives.
+type PackageFetcher interface {
+	// FetchPackage downloads the archive of the provider `address` of the given `version` for the `platform`, e.g. `linux_amd64`, into the `dst` file.
+	FetchPackage(ctx context.Context, address, version, platform, dst string) error
+}
+
+// PlatformHashes calculates the `h1:` hashes of the provider packages for the configured platforms, the same way `tofu providers lock -platform=...` does:
+// the distribution archive of each platform is downloaded, checked against the registry's `SHA256SUMS` document and hashed with `PackageHashV1`.
+// The hashes are kept in memory, so each archive is downloaded only once, no matter how many lock files are updated, while
+// the archives that could not be hashed are retried.
+type PlatformHashes struct {
+	fetcher   PackageFetcher
+	platforms []string
+	hashes    *xsync.MapOf[string, *platformHash]
+}
+
+// platformHash is the hash of a package, fetched by one caller at a time. Only the hash is kept, so a failed fetch,
+// e.g. because the context of the caller was cancelled, is retried by the next caller.
+type platformHash struct {
+	mu   sync.Mutex
+	hash Hash
+}
+
+func (result *platformHash) get(ctx context.Context, fetch func(ctx context.Context) (Hash, error)) (Hash, error) {
+	result.mu.Lock()
+	defer result.mu.Unlock()
+
+	if result.hash != "" {
+		return result.hash, nil
+	}
+
+	hash, err := fetch(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	result.hash = hash
+
+	return hash, nil
+}
+
+// NewPlatformHashes returns a new `PlatformHashes` instance for the given platforms in the `os_arch` format.
+func NewPlatformHashes(fetcher PackageFetcher, platforms ...string) *PlatformHashes {
+	return &PlatformHashes{
+		fetcher:   fetcher,
+		platforms: platforms,
+		hashes:    xsync.NewMapOf[string, *platformHash](),
+	}
+}
+
+// Hashes returns the `h1:` hashes of the given provider for all configured platforms, except the one the provider was downloaded for.
+// The platforms whose packages cannot be retrieved are skipped with a warning, as some providers are not built for every platform,
+// unless the context is cancelled.
+func (platformHashes *PlatformHashes) Hashes(ctx context.Context, provider Provider) ([]Hash, error) {
+	// The package directory has the same layout as the plugin cache dir, `<address>/<version>/<os_arch>`.
+	packagePlatform := filepath.Base(provider.PackageDir())
+
+	documentSHA256Sums, err := provider.DocumentSHA256Sums(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var hashes []Hash
+
+	for _, platform := range platformHashes.platforms {
+		if platform == packagePlatform {
+			continue
+		}
+
+		key := provider.Address() + "/" + provider.Version() + "/" + platform
+
+		result, _ := platformHashes.hashes.LoadOrStore(key, &platformHash{})
+
+		hash, err := result.get(ctx, func(ctx context.Context) (Hash, error) {
+			return platformHashes.packageHash(ctx, provider, platform, documentSHA256Sums)
+		})
+		if err != nil {
+			// The lock file must not be written without the hashes just because the run is being cancelled.
+			if ctx.Err() != nil {
+				return nil, errors.New(ctx.Err())
+			}
+
+			provider.Logger().Warnf("Unable to calculate the hash of %s %s for platform %s: %v", provider.Address(), provider.Version(), platform, err)
+
+			continue
+		}
+
+		hashes = append(hashes, hash)
+	}
+
+	return hashes, nil
+}
+
+func (platformHashes *PlatformHashes) packageHash(ctx context.Context, provider Provider, platform string, documentSHA256Sums []byte) (Hash, error) {
+	tempDir, err := os.MkdirTemp("", "terragrunt-provider-*")
+	if err != nil {
+		return "", errors.New(err)
+	}
+	defer os.RemoveAll(tempDir) //nolint:errcheck
+
+	archivePath := filepath.Join(tempDir, platform+".zip")
+
+	provider.Logger().Debugf("Fetching %s %s for platform %s to calculate its hash", provider.Address(), provider.Version(), platform)
+
+	if err := platformHashes.fetcher.FetchPackage(ctx, provider.Address(), provider.Version(), platform, archivePath); err != nil {
+		return "", err
+	}
+
+	if documentSHA256Sums != nil {
+		zipHash, err := PackageHashLegacyZipSHA(archivePath)
+		if err != nil {
+			return "", err
+		}
+
+		if !slices.Contains(DocumentHashes(documentSHA256Sums), zipHash) {
+			return "", errors.Errorf("archive checksum %s is not listed in the provider SHA256SUMS document", zipHash)
+		}
+	}
+
+	return PackageHashV1(archivePath)
+}
@@ -0,0 +1,152 @@
+package getproviders_test
+
+import (
+	"archive/zip"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"github.com/gruntwork-io/terragrunt/tf/getproviders"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeProvider struct {
+	packageDir         string
+	documentSHA256Sums []byte
+}
+
+func (provider *fakeProvider) Address() string { return "registry.terraform.io/hashicorp/null" }
+func (provider *fakeProvider) Version() string { return "3.2.3" }
+func (provider *fakeProvider) PackageDir() string {
+	return provider.packageDir
+}
+func (provider *fakeProvider) Logger() log.Logger { return log.New() }
+func (provider *fakeProvider) DocumentSHA256Sums(ctx context.Context) ([]byte, error) {
+	return provider.documentSHA256Sums, nil
+}
+
+type fakePackageFetcher struct {
+	archives map[string]string
+	calls    atomic.Int32
+}
+
+func (fetcher *fakePackageFetcher) FetchPackage(ctx context.Context, address, version, platform, dst string) error {
+	fetcher.calls.Add(1)
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	archivePath, ok := fetcher.archives[platform]
+	if !ok {
+		return fmt.Errorf("no package for %s", platform)
+	}
+
+	content, err := os.ReadFile(archivePath)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(dst, content, 0644)
+}
+
+func createProviderZipArchive(t *testing.T, content string) string {
+	t.Helper()
+
+	filename := filepath.Join(t.TempDir(), "provider.zip")
+
+	file, err := os.Create(filename)
+	require.NoError(t, err)
+	defer file.Close()
+
+	zipWriter := zip.NewWriter(file)
+
+	writer, err := zipWriter.Create("terraform-provider-null")
+	require.NoError(t, err)
+
+	_, err = writer.Write([]byte(content))
+	require.NoError(t, err)
+	require.NoError(t, zipWriter.Close())
+
+	return filename
+}
+
+func TestPlatformHashes(t *testing.T) {
+	t.Parallel()
+
+	var (
+		linuxArchive  = createProviderZipArchive(t, "linux")
+		darwinArchive = createProviderZipArchive(t, "darwin")
+		alienArchive  = createProviderZipArchive(t, "alien")
+	)
+
+	documentSHA256Sums := ""
+
+	for _, archive := range []string{linuxArchive, darwinArchive} {
+		content, err := os.ReadFile(archive)
+		require.NoError(t, err)
+
+		documentSHA256Sums += fmt.Sprintf("%x  %s\n", sha256.Sum256(content), filepath.Base(archive))
+	}
+
+	fetcher := &fakePackageFetcher{archives: map[string]string{
+		"linux_amd64":   linuxArchive,
+		"darwin_arm64":  darwinArchive,
+		"windows_amd64": alienArchive,
+	}}
+
+	provider := &fakeProvider{
+		packageDir:         filepath.Join(t.TempDir(), "registry.terraform.io/hashicorp/null/3.2.3/linux_amd64"),
+		documentSHA256Sums: []byte(documentSHA256Sums),
+	}
+
+	expectedHash, err := getproviders.PackageHashV1(darwinArchive)
+	require.NoError(t, err)
+
+	platformHashes := getproviders.NewPlatformHashes(fetcher, "linux_amd64", "darwin_arm64", "windows_amd64", "freebsd_amd64")
+
+	for range 2 {
+		hashes, err := platformHashes.Hashes(context.Background(), provider)
+		require.NoError(t, err)
+
+		// The current platform is skipped, the archive not listed in SHA256SUMS and the missing package are ignored.
+		assert.Equal(t, []getproviders.Hash{expectedHash}, hashes)
+	}
+
+	// The hashed package is fetched only once, the packages that could not be hashed are fetched again.
+	assert.Equal(t, int32(5), fetcher.calls.Load())
+}
+
+func TestPlatformHashesCancelled(t *testing.T) {
+	t.Parallel()
+
+	darwinArchive := createProviderZipArchive(t, "darwin")
+
+	fetcher := &fakePackageFetcher{archives: map[string]string{"darwin_arm64": darwinArchive}}
+
+	provider := &fakeProvider{
+		packageDir: filepath.Join(t.TempDir(), "registry.terraform.io/hashicorp/null/3.2.3/linux_amd64"),
+	}
+
+	platformHashes := getproviders.NewPlatformHashes(fetcher, "darwin_arm64")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := platformHashes.Hashes(ctx, provider)
+	require.ErrorIs(t, err, context.Canceled)
+
+	// The failure of the cancelled call is not kept.
+	expectedHash, err := getproviders.PackageHashV1(darwinArchive)
+	require.NoError(t, err)
+
+	hashes, err := platformHashes.Hashes(context.Background(), provider)
+	require.NoError(t, err)
+	assert.Equal(t, []getproviders.Hash{expectedHash}, hashes)
+}
@@ -0,0 +1,164 @@
+package getproviders
+
+import (
+	"io/fs"
+	"path/filepath"
+	"strings"
+
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/util"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// RequiredProvider represents an entry of the `required_providers` block of a module.
+type RequiredProvider struct {
+	// Address is a source address of the provider. e.g.: registry.terraform.io/hashicorp/aws
+	Address string
+
+	// Constraints is the version constraint of the provider. e.g.: ~> 5.36
+	Constraints string
+}
+
+// FindRequiredProviders recursively looks for the `required_providers` blocks in the `.tf` files in the given `dir`,
+// skipping the same directories as `FindLockfiles`. Addresses without a hostname are resolved against `defaultRegistry`.
+func FindRequiredProviders(dir, defaultRegistry string) ([]*RequiredProvider, error) {
+	var (
+		providers []*RequiredProvider
+		parser    = hclparse.NewParser()
+	)
+
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() {
+			switch entry.Name() {
+			case util.TerragruntCacheDir, ".terraform", ".git":
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if filepath.Ext(path) != util.TfFileExtension {
+			return nil
+		}
+
+		file, diags := parser.ParseHCLFile(path)
+		if diags.HasErrors() {
+			return errors.New(diags)
+		}
+
+		fileProviders, err := parseRequiredProviders(file.Body, defaultRegistry)
+		if err != nil {
+			return err
+		}
+
+		providers = append(providers, fileProviders...)
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.New(err)
+	}
+
+	return providers, nil
+}
+
+func parseRequiredProviders(body hcl.Body, defaultRegistry string) ([]*RequiredProvider, error) {
+	var providers []*RequiredProvider
+
+	content, _, diags := body.PartialContent(&hcl.BodySchema{
+		Blocks: []hcl.BlockHeaderSchema{{Type: "terraform"}},
+	})
+	if diags.HasErrors() {
+		return nil, errors.New(diags)
+	}
+
+	for _, terraformBlock := range content.Blocks {
+		terraformContent, _, diags := terraformBlock.Body.PartialContent(&hcl.BodySchema{
+			Blocks: []hcl.BlockHeaderSchema{{Type: "required_providers"}},
+		})
+		if diags.HasErrors() {
+			return nil, errors.New(diags)
+		}
+
+		for _, requiredProvidersBlock := range terraformContent.Blocks {
+			attrs, diags := requiredProvidersBlock.Body.JustAttributes()
+			if diags.HasErrors() {
+				return nil, errors.New(diags)
+			}
+
+			for name, attr := range attrs {
+				provider, err := parseRequiredProvider(name, attr)
+				if err != nil {
+					return nil, err
+				}
+
+				if strings.Count(provider.Address, "/") == 1 {
+					provider.Address = defaultRegistry + "/" + provider.Address
+				}
+
+				provider.Address = strings.ToLower(provider.Address)
+
+				providers = append(providers, provider)
+			}
+		}
+	}
+
+	return providers, nil
+}
+
+// parseRequiredProvider parses an entry of the `required_providers` block. The object syntax is decoded key by key,
+// since `configuration_aliases` holds references to providers, such as `[aws.east]`, that cannot be evaluated.
+func parseRequiredProvider(name string, attr *hcl.Attribute) (*RequiredProvider, error) {
+	provider := &RequiredProvider{Address: "hashicorp/" + name}
+
+	pairs, diags := hcl.ExprMap(attr.Expr)
+	if diags.HasErrors() {
+		// The legacy syntax `aws = "~> 5.0"`, only a version constraint.
+		val, diags := attr.Expr.Value(nil)
+		if diags.HasErrors() {
+			return nil, errors.New(diags)
+		}
+
+		if val.Type() == cty.String && !val.IsNull() {
+			provider.Constraints = val.AsString()
+		}
+
+		return provider, nil
+	}
+
+	for _, pair := range pairs {
+		keyVal, diags := pair.Key.Value(nil)
+		if diags.HasErrors() || keyVal.Type() != cty.String || keyVal.IsNull() {
+			continue
+		}
+
+		key := keyVal.AsString()
+		if key != "source" && key != "version" {
+			continue
+		}
+
+		val, diags := pair.Value.Value(nil)
+		if diags.HasErrors() {
+			return nil, errors.New(diags)
+		}
+
+		if val.Type() != cty.String || val.IsNull() {
+			continue
+		}
+
+		switch key {
+		case "source":
+			provider.Address = val.AsString()
+		case "version":
+			provider.Constraints = val.AsString()
+		}
+	}
+
+	return provider, nil
+}
@@ -0,0 +1,66 @@
+package getproviders_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/tf/getproviders"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindRequiredProviders(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	content := `
+terraform {
+  required_providers {
+    aws = {
+      source  = "hashicorp/aws"
+      version = "~> 5.36"
+    }
+    custom = {
+      source = "example.com/acme/custom"
+    }
+    template = "2.2.0"
+  }
+}
+`
+
+	// The providers passed to a module with aliases are referenced by `configuration_aliases`.
+	aliasesContent := `
+terraform {
+  required_providers {
+    google = {
+      source                = "hashicorp/google"
+      version               = ">= 6.0"
+      configuration_aliases = [google.west, google.east]
+    }
+  }
+}
+`
+
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "module", ".terraform"), os.ModePerm))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "aliases"), os.ModePerm))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "aliases", "versions.tf"), []byte(aliasesContent), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "module", "versions.tf"), []byte(content), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "module", ".terraform", "ignored.tf"), []byte(content), 0644))
+
+	providers, err := getproviders.FindRequiredProviders(dir, "registry.terraform.io")
+	require.NoError(t, err)
+
+	actual := make(map[string]string)
+	for _, provider := range providers {
+		actual[provider.Address] = provider.Constraints
+	}
+
+	assert.Equal(t, map[string]string{
+		"registry.terraform.io/hashicorp/aws":      "~> 5.36",
+		"example.com/acme/custom":                  "",
+		"registry.terraform.io/hashicorp/template": "2.2.0",
+		"registry.terraform.io/hashicorp/google":   ">= 6.0",
+	}, actual)
+}
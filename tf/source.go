@@ -15,6 +15,7 @@ import (
 	urlhelper "github.com/hashicorp/go-getter/helper/url"
 
 	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/options"
 	"github.com/gruntwork-io/terragrunt/util"
 )
 
@@ -82,6 +83,10 @@ func (src Source) EncodeSourceVersion() (string, error) {
 				if info.Name() == util.TerraformLockFile {
 					return nil
 				}
+				// avoid checking the error signals file since it is written next to the unit configuration on each run
+				if info.Name() == options.DefaultSignalsFile {
+					return nil
+				}
 
 				fileModified := info.ModTime().UnixMicro()
 				hashContents := fmt.Sprintf("%s:%d", path, fileModified)
@@ -108,6 +113,10 @@ func (src Source) EncodeSourceVersion() (string, error) {
 				if info.Name() == util.TerraformLockFile {
 					return nil
 				}
+				// avoid checking the error signals file since it is written next to the unit configuration on each run
+				if info.Name() == options.DefaultSignalsFile {
+					return nil
+				}
 
 				fileModified := info.ModTime().UnixMicro()
 				hashContents := fmt.Sprintf("%s:%d", path, fileModified)
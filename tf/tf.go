@@ -48,6 +48,7 @@ const (
 	FlagNameVersion          = "-version"
 	FlagNameJSON             = "-json"
 	FlagNameNoColor          = "-no-color"
+	FlagNameAutoApprove      = "-auto-approve"
 	// `apply -destroy` is alias for `destroy`
 	FlagNameDestroy = "-destroy"
 